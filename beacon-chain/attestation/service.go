@@ -30,7 +30,7 @@ type attestationStore struct {
 type Service struct {
 	ctx          context.Context
 	cancel       context.CancelFunc
-	beaconDB     *db.BeaconDB
+	beaconDB     db.Database
 	incomingFeed *event.Feed
 	incomingChan chan *pb.Attestation
 	// store is the mapping of individual
@@ -40,7 +40,7 @@ type Service struct {
 
 // Config options for the service.
 type Config struct {
-	BeaconDB *db.BeaconDB
+	BeaconDB db.Database
 }
 
 // NewAttestationService instantiates a new service instance that will
@@ -244,10 +244,15 @@ func (a *Service) UpdateLatestAttestation(ctx context.Context, attestation *pb.A
 }
 
 // InsertAttestationIntoStore locks the store, inserts the attestation, then
-// unlocks the store again. This method may be used by external services
-// in testing to populate the attestation store.
+// unlocks the store again. Like attestations received from the network, it only
+// replaces the stored attestation of the validator with one for a later slot.
+// This method may be used by external services in testing to populate the
+// attestation store.
 func (a *Service) InsertAttestationIntoStore(pubkey [48]byte, att *pb.Attestation) {
 	a.store.Lock()
 	defer a.store.Unlock()
+	if current, exists := a.store.m[pubkey]; exists && current.GetData().GetSlot() >= att.GetData().GetSlot() {
+		return
+	}
 	a.store.m[pubkey] = att
 }
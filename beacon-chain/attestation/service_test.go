@@ -128,6 +128,27 @@ func TestLatestAttestation_ReturnsLatestAttestation(t *testing.T) {
 	}
 }
 
+func TestInsertAttestationIntoStore_KeepsLatest(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+
+	service := NewAttestationService(context.Background(), &Config{BeaconDB: beaconDB})
+	pubKey := bytesutil.ToBytes48([]byte{'A'})
+	latest := &pb.Attestation{Data: &pb.AttestationData{Slot: 5}}
+	service.InsertAttestationIntoStore(pubKey, latest)
+	for _, slot := range []uint64{4, 5} {
+		service.InsertAttestationIntoStore(pubKey, &pb.Attestation{Data: &pb.AttestationData{Slot: slot}})
+		if service.store.m[pubKey] != latest {
+			t.Errorf("Expected attestation for slot %d not to replace the one for slot 5", slot)
+		}
+	}
+	newer := &pb.Attestation{Data: &pb.AttestationData{Slot: 6}}
+	service.InsertAttestationIntoStore(pubKey, newer)
+	if service.store.m[pubKey] != newer {
+		t.Error("Expected attestation for slot 6 to replace the one for slot 5")
+	}
+}
+
 func TestLatestAttestation_InvalidIndex(t *testing.T) {
 	beaconDB := internal.SetupDB(t)
 	defer internal.TeardownDB(t, beaconDB)
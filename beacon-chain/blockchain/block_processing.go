@@ -85,6 +85,7 @@ func (c *ChainService) ReceiveBlock(ctx context.Context, block *pb.BeaconBlock)
 			if err := c.beaconDB.DeleteBlock(block); err != nil {
 				return nil, fmt.Errorf("could not delete bad block from db: %v", err)
 			}
+			return beaconState, err
 		default:
 			return beaconState, fmt.Errorf("could not apply block state transition: %v", err)
 		}
@@ -105,10 +106,25 @@ func (c *ChainService) ReceiveBlock(ctx context.Context, block *pb.BeaconBlock)
 		}
 		beaconState.LatestBlock = block
 		if !bytes.Equal(block.StateRootHash32, stateRoot[:]) {
+			// The block was saved before the state transition, so it must be deleted for it
+			// not to become a fork choice candidate when the store is reloaded from the db.
+			if err := c.beaconDB.DeleteBlock(block); err != nil {
+				return nil, fmt.Errorf("could not delete bad block from db: %v", err)
+			}
 			return nil, fmt.Errorf("beacon state root is not equal to block state root: %#x != %#x", stateRoot, block.StateRootHash32)
 		}
 	}
 
+	// The block is only a fork choice candidate once it passed every validity check.
+	if err := c.forkChoiceStore.insertBlock(block); err != nil {
+		// Blocks which do not descend from the fork choice store root are not fork choice candidates.
+		if err != errUnknownParent {
+			return beaconState, fmt.Errorf("could not add block to fork choice store: %v", err)
+		}
+		log.WithField("slot", block.Slot-params.BeaconConfig().GenesisSlot).Debug(
+			"Block parent not in fork choice store, skipping it")
+	}
+
 	// We process the block's contained deposits, attestations, and other operations
 	// and that may need to be stored or deleted from the beacon node's persistent storage.
 	if err := c.CleanupBlockOperations(ctx, block); err != nil {
@@ -208,7 +224,7 @@ func (c *ChainService) SaveAndBroadcastBlock(ctx context.Context, block *pb.Beac
 }
 
 // CleanupBlockOperations processes and cleans up any block operations relevant to the beacon node
-// such as attestations, exits, and deposits. We update the latest seen attestation by validator
+// such as attestations, slashings, exits, and deposits. We update the latest seen attestation by validator
 // in the local node's runtime, cleanup and remove pending deposits which have been included in the block
 // from our node's local cache, and process validator exits and more.
 func (c *ChainService) CleanupBlockOperations(ctx context.Context, block *pb.BeaconBlock) error {
@@ -226,7 +242,9 @@ func (c *ChainService) CleanupBlockOperations(ctx context.Context, block *pb.Bea
 
 	// Remove pending deposits from the deposit queue.
 	for _, dep := range block.Body.Deposits {
-		c.beaconDB.RemovePendingDeposit(ctx, dep)
+		if err := c.beaconDB.RemovePendingDeposit(ctx, dep); err != nil {
+			return fmt.Errorf("could not remove pending deposit: %v", err)
+		}
 	}
 	return nil
 }
@@ -246,8 +264,8 @@ func (c *ChainService) runStateTransition(
 		block,
 		headRoot,
 		&state.TransitionConfig{
-			VerifySignatures: false, // We disable signature verification for now.
-			Logging:          true,  // We enable logging in this state transition call.
+			VerifySignatures: featureconfig.FeatureConfig().EnableBlockSigVerification,
+			Logging:          true, // We enable logging in this state transition call.
 		},
 	)
 	if err != nil {
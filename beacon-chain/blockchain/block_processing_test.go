@@ -10,11 +10,14 @@ import (
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/attestation"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	v "github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -222,6 +225,78 @@ func TestReceiveBlock_DeletesBadBlock(t *testing.T) {
 	}
 }
 
+func TestReceiveBlock_VerifiesSignatures(t *testing.T) {
+	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{
+		EnableBlockSigVerification: true,
+	})
+	defer featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{
+		EnableCheckBlockStateRoot: true,
+	})
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	chainService := setupBeaconChain(t, db, nil)
+	deposits, privKeys := setupInitialDeposits(t, 100)
+	eth1Data := &pb.Eth1Data{
+		DepositRootHash32: []byte{},
+		BlockHash32:       []byte{},
+	}
+	beaconState, err := state.GenesisBeaconState(deposits, 0, eth1Data)
+	if err != nil {
+		t.Fatalf("Can't generate genesis state: %v", err)
+	}
+	if err := db.SaveFinalizedState(beaconState); err != nil {
+		t.Fatal(err)
+	}
+	parentHash, genesisBlock := setupGenesisBlock(t, chainService, beaconState)
+	if err := chainService.beaconDB.UpdateChainHead(ctx, genesisBlock, beaconState); err != nil {
+		t.Fatal(err)
+	}
+
+	beaconState.Slot++
+	proposerIdx, err := helpers.BeaconProposerIndex(beaconState, beaconState.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := forkutil.DomainVersion(beaconState.Fork, helpers.CurrentEpoch(beaconState), params.BeaconConfig().DomainProposal)
+	newBlock := func(signer uint64) *pb.BeaconBlock {
+		block := &pb.BeaconBlock{
+			Slot:             beaconState.Slot,
+			ParentRootHash32: parentHash[:],
+			RandaoReveal:     createRandaoReveal(t, beaconState, privKeys),
+			Eth1Data: &pb.Eth1Data{
+				DepositRootHash32: []byte("a"),
+				BlockHash32:       []byte("b"),
+			},
+			Body: &pb.BeaconBlockBody{},
+		}
+		proposalRoot, err := hashutil.HashProposal(block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		block.Signature = privKeys[signer].Sign(proposalRoot[:], domain).Marshal()
+		return block
+	}
+
+	forged := newBlock((proposerIdx + 1) % uint64(len(privKeys)))
+	_, err = chainService.ReceiveBlock(ctx, forged)
+	if _, ok := err.(*BlockFailedProcessingErr); !ok || !strings.Contains(err.Error(), "signature") {
+		t.Fatalf("Expected the block with a forged signature to fail processing, received %v", err)
+	}
+	forgedRoot, err := hashutil.HashBeaconBlock(forged)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if db.HasBlock(forgedRoot) {
+		t.Error("Expected the block with a forged signature to be deleted")
+	}
+
+	if _, err := chainService.ReceiveBlock(ctx, newBlock(proposerIdx)); err != nil {
+		t.Errorf("Expected the block signed by its proposer to be processed, received %v", err)
+	}
+}
+
 func TestReceiveBlock_CheckBlockStateRoot_GoodState(t *testing.T) {
 	hook := logTest.NewGlobal()
 	db := internal.SetupDB(t)
@@ -261,12 +336,22 @@ func TestReceiveBlock_CheckBlockStateRoot_GoodState(t *testing.T) {
 	if err := chainService.beaconDB.SaveBlock(goodStateBlock); err != nil {
 		t.Fatal(err)
 	}
+	if err := chainService.forkChoiceStore.reset(genesisBlock); err != nil {
+		t.Fatal(err)
+	}
 
 	_, err = chainService.ReceiveBlock(context.Background(), goodStateBlock)
 	if err != nil {
 		t.Fatalf("error exists for good block %v", err)
 	}
 	testutil.AssertLogsContain(t, hook, "Executing state transition")
+	root, err := hashutil.HashBeaconBlock(goodStateBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !chainService.forkChoiceStore.hasBlock(root) {
+		t.Error("Expected block with a good state root to be in the fork choice store")
+	}
 }
 
 func TestReceiveBlock_CheckBlockStateRoot_BadState(t *testing.T) {
@@ -298,6 +383,9 @@ func TestReceiveBlock_CheckBlockStateRoot_BadState(t *testing.T) {
 		Body:             &pb.BeaconBlockBody{},
 	}
 	beaconState.Slot--
+	if err := chainService.forkChoiceStore.reset(genesisBlock); err != nil {
+		t.Fatal(err)
+	}
 
 	_, err = chainService.ReceiveBlock(context.Background(), invalidStateBlock)
 	if err == nil {
@@ -306,6 +394,23 @@ func TestReceiveBlock_CheckBlockStateRoot_BadState(t *testing.T) {
 	if !strings.Contains(err.Error(), "beacon state root is not equal to block state root: ") {
 		t.Fatal(err)
 	}
+	root, err := hashutil.HashBeaconBlock(invalidStateBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if chainService.forkChoiceStore.hasBlock(root) {
+		t.Error("Expected block with a bad state root to not be in the fork choice store")
+	}
+	if chainService.beaconDB.HasBlock(root) {
+		t.Error("Expected block with a bad state root to be deleted from the db")
+	}
+	// The store is reloaded from the db after a restart.
+	if err := chainService.loadForkChoiceStore(ctx, genesisBlock); err != nil {
+		t.Fatal(err)
+	}
+	if chainService.forkChoiceStore.hasBlock(root) {
+		t.Error("Expected block with a bad state root to not be loaded in the fork choice store")
+	}
 }
 
 func TestReceiveBlock_RemovesPendingDeposits(t *testing.T) {
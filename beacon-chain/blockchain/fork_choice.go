@@ -3,11 +3,9 @@ package blockchain
 import (
 	"context"
 	"fmt"
+	"sort"
 
-	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
-	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
@@ -15,13 +13,6 @@ import (
 	"go.opencensus.io/trace"
 )
 
-var (
-	reorgCount = promauto.NewCounter(prometheus.CounterOpts{
-		Name: "reorg_counter",
-		Help: "The number of chain reorganization events that have happened in the fork choice rule",
-	})
-)
-
 // ForkChoice interface defines the methods for applying fork choice rule
 // operations to the blockchain.
 type ForkChoice interface {
@@ -109,6 +100,11 @@ func (c *ChainService) updateFFGCheckPts(ctx context.Context, state *pb.BeaconSt
 		if err := c.beaconDB.SaveFinalizedState(newFinalizedState); err != nil {
 			return err
 		}
+		finalizedRoot, err := hashutil.HashBeaconBlock(newFinalizedBlock)
+		if err != nil {
+			return err
+		}
+		c.forkChoiceStore.prune(finalizedRoot)
 	}
 	return nil
 }
@@ -126,6 +122,13 @@ func (c *ChainService) ApplyForkChoiceRule(
 	defer span.End()
 	log.Info("Applying LMD-GHOST Fork Choice Rule")
 
+	if err := c.forkChoiceStore.insertBlock(block); err != nil && err != errUnknownParent {
+		return fmt.Errorf("could not add block to fork choice store: %v", err)
+	}
+	oldHead, err := c.beaconDB.ChainHead()
+	if err != nil {
+		return fmt.Errorf("could not retrieve chain head: %v", err)
+	}
 	justifiedState, err := c.beaconDB.JustifiedState()
 	if err != nil {
 		return fmt.Errorf("could not retrieve justified state: %v", err)
@@ -146,45 +149,61 @@ func (c *ChainService) ApplyForkChoiceRule(
 	if err != nil {
 		return fmt.Errorf("could not hash head block: %v", err)
 	}
+	reorg, orphaned, err := c.updateChainHead(ctx, block, postState, oldHead, head, headRoot)
+	if err != nil {
+		return err
+	}
+	// The reorg is only published once the new head is saved and the canonical
+	// blocks lock is released.
+	if reorg != nil {
+		c.sendReorg(reorg, orphaned)
+	}
+	log.WithField("headRoot", fmt.Sprintf("0x%x", headRoot)).Info("Chain head block and state updated")
+	return nil
+}
+
+// updateChainHead makes the head chosen by the fork choice rule the head of the
+// main chain, along with its state. It returns the reorg event and orphaned blocks
+// of handleReorg, which are left to the caller to publish.
+func (c *ChainService) updateChainHead(
+	ctx context.Context,
+	block *pb.BeaconBlock,
+	postState *pb.BeaconState,
+	oldHead *pb.BeaconBlock,
+	head *pb.BeaconBlock,
+	headRoot [32]byte,
+) (*ReorgEvent, []*pb.BeaconBlock, error) {
 	c.canonicalBlocksLock.Lock()
 	defer c.canonicalBlocksLock.Unlock()
+	reorg, orphaned, err := c.handleReorg(ctx, oldHead, head)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not handle chain reorganization: %v", err)
+	}
 	c.canonicalBlocks[head.Slot] = headRoot[:]
 
 	newState := postState
 	if head.Slot != block.Slot {
-		log.Warnf("Reorg happened, last processed block at slot %d, new head block at slot %d",
+		log.Debugf("Processed block at slot %d is not the new head at slot %d, regenerating head state",
 			block.Slot-params.BeaconConfig().GenesisSlot, head.Slot-params.BeaconConfig().GenesisSlot)
 
-		// Only regenerate head state if there was a reorg.
+		// Only regenerate head state if the processed block is not the head.
 		newState, err = c.beaconDB.HistoricalStateFromSlot(ctx, head.Slot)
 		if err != nil {
-			return fmt.Errorf("could not gen state: %v", err)
-		}
-
-		if newState.Slot != postState.Slot {
-			log.Warnf("Reorg happened, post state slot at %d, new head state at slot %d",
-				postState.Slot-params.BeaconConfig().GenesisSlot, newState.Slot-params.BeaconConfig().GenesisSlot)
-		}
-
-		for revertedSlot := block.Slot; revertedSlot > head.Slot; revertedSlot-- {
-			delete(c.canonicalBlocks, revertedSlot)
+			return nil, nil, fmt.Errorf("could not gen state: %v", err)
 		}
-		reorgCount.Inc()
 	}
 
 	if err := c.beaconDB.UpdateChainHead(ctx, head, newState); err != nil {
-		return fmt.Errorf("failed to update chain: %v", err)
+		return nil, nil, fmt.Errorf("failed to update chain: %v", err)
 	}
-	h, err := hashutil.HashBeaconBlock(head)
-	if err != nil {
-		return fmt.Errorf("could not hash head: %v", err)
-	}
-	log.WithField("headRoot", fmt.Sprintf("0x%x", h)).Info("Chain head block and state updated")
-	return nil
+	return reorg, orphaned, nil
 }
 
 // lmdGhost applies the Latest Message Driven, Greediest Heaviest Observed Sub-Tree
 // fork-choice rule defined in the Ethereum Serenity specification for the beacon chain.
+// The block tree is kept in the fork choice store, which is loaded from the db the
+// first time the start block is seen. Only the votes which changed since the last
+// call are applied to the tree weights.
 //
 // Spec pseudocode definition:
 //	def lmd_ghost(store: Store, start_state: BeaconState, start_block: BeaconBlock) -> BeaconBlock:
@@ -215,40 +234,46 @@ func (c *ChainService) lmdGhost(
 	ctx context.Context,
 	startBlock *pb.BeaconBlock,
 	startState *pb.BeaconState,
-	voteTargets map[uint64]*pb.BeaconBlock,
+	voteTargets map[uint64][32]byte,
 ) (*pb.BeaconBlock, error) {
-	highestSlot := c.beaconDB.HighestBlockSlot()
-
-	head := startBlock
-	for {
-		children, err := c.blockChildren(ctx, head, highestSlot)
-		if err != nil {
-			return nil, fmt.Errorf("could not fetch block children: %v", err)
-		}
-		if len(children) == 0 {
-			return head, nil
+	startRoot, err := hashutil.HashBeaconBlock(startBlock)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash start block: %v", err)
+	}
+	if !c.forkChoiceStore.hasBlock(startRoot) {
+		if err := c.loadForkChoiceStore(ctx, startBlock); err != nil {
+			return nil, fmt.Errorf("could not load fork choice store: %v", err)
 		}
-		maxChild := children[0]
+	}
+	c.forkChoiceStore.updateVotes(voteTargets, startState)
+	return c.forkChoiceStore.head(startRoot)
+}
 
-		maxChildVotes, err := VoteCount(maxChild, startState, voteTargets, c.beaconDB)
+// loadForkChoiceStore resets the fork choice store to the tree of blocks in the db
+// descending from the given block.
+func (c *ChainService) loadForkChoiceStore(ctx context.Context, root *pb.BeaconBlock) error {
+	if err := c.forkChoiceStore.reset(root); err != nil {
+		return err
+	}
+	queue := []*pb.BeaconBlock{root}
+	for len(queue) > 0 {
+		children, err := c.blockChildren(ctx, queue[0])
 		if err != nil {
-			return nil, fmt.Errorf("unable to determine vote count for block: %v", err)
+			return fmt.Errorf("could not fetch block children: %v", err)
 		}
-		for i := 0; i < len(children); i++ {
-			candidateChildVotes, err := VoteCount(children[i], startState, voteTargets, c.beaconDB)
-			if err != nil {
-				return nil, fmt.Errorf("unable to determine vote count for block: %v", err)
-			}
-			if candidateChildVotes > maxChildVotes {
-				maxChild = children[i]
+		for _, child := range children {
+			if err := c.forkChoiceStore.insertBlock(child); err != nil {
+				return err
 			}
 		}
-		head = maxChild
+		queue = append(queue[1:], children...)
 	}
+	log.WithField("blocks", c.forkChoiceStore.size()).Debug("Loaded fork choice store from db")
+	return nil
 }
 
-// blockChildren returns the child blocks of the given block up to a given
-// highest slot.
+// blockChildren returns every known child block of the given block, sorted by
+// slot, whichever chain currently owns their slots.
 //
 // ex:
 //       /- C - E
@@ -259,118 +284,36 @@ func (c *ChainService) lmdGhost(
 // Spec pseudocode definition:
 //	get_children(store: Store, block: BeaconBlock) -> List[BeaconBlock]
 //		returns the child blocks of the given block.
-func (c *ChainService) blockChildren(ctx context.Context, block *pb.BeaconBlock, highestSlot uint64) ([]*pb.BeaconBlock, error) {
-	var children []*pb.BeaconBlock
-
+func (c *ChainService) blockChildren(ctx context.Context, block *pb.BeaconBlock) ([]*pb.BeaconBlock, error) {
 	currentRoot, err := hashutil.HashBeaconBlock(block)
 	if err != nil {
 		return nil, fmt.Errorf("could not tree hash incoming block: %v", err)
 	}
-	startSlot := block.Slot + 1
-	for i := startSlot; i <= highestSlot; i++ {
-		block, err := c.beaconDB.BlockBySlot(ctx, i)
-		if err != nil {
-			return nil, fmt.Errorf("could not get block by slot: %v", err)
-		}
-		// Continue if there's a skip block.
-		if block == nil {
-			continue
-		}
-
-		parentRoot := bytesutil.ToBytes32(block.ParentRootHash32)
-		if currentRoot == parentRoot {
-			children = append(children, block)
-		}
+	children, err := c.beaconDB.BlocksByParent(ctx, currentRoot)
+	if err != nil {
+		return nil, fmt.Errorf("could not get blocks by parent: %v", err)
 	}
+	sort.SliceStable(children, func(i, j int) bool {
+		return children[i].Slot < children[j].Slot
+	})
 	return children, nil
 }
 
 // attestationTargets retrieves the list of attestation targets since last finalized epoch,
-// each attestation target consists of validator index and its attestation target (i.e. the block
-// which the validator attested to)
-func (c *ChainService) attestationTargets(ctx context.Context, state *pb.BeaconState) (map[uint64]*pb.BeaconBlock, error) {
+// each attestation target consists of validator index and the root of the block
+// which the validator attested to.
+func (c *ChainService) attestationTargets(ctx context.Context, state *pb.BeaconState) (map[uint64][32]byte, error) {
 	indices := helpers.ActiveValidatorIndices(state.ValidatorRegistry, helpers.CurrentEpoch(state))
-	attestationTargets := make(map[uint64]*pb.BeaconBlock)
-	for i, index := range indices {
-		block, err := c.attsService.LatestAttestationTarget(ctx, index)
+	attestationTargets := make(map[uint64][32]byte)
+	for _, index := range indices {
+		att, err := c.attsService.LatestAttestation(ctx, index)
 		if err != nil {
 			return nil, fmt.Errorf("could not retrieve attestation target: %v", err)
 		}
-		if block == nil {
+		if att == nil {
 			continue
 		}
-		attestationTargets[uint64(i)] = block
+		attestationTargets[index] = bytesutil.ToBytes32(att.Data.BeaconBlockRootHash32)
 	}
 	return attestationTargets, nil
 }
-
-// VoteCount determines the number of votes on a beacon block by counting the number
-// of target blocks that have such beacon block as a common ancestor.
-//
-// Spec pseudocode definition:
-//  def get_vote_count(block: BeaconBlock) -> int:
-//        return sum(
-//            get_effective_balance(start_state.validator_balances[validator_index]) // FORK_CHOICE_BALANCE_INCREMENT
-//            for validator_index, target in attestation_targets
-//            if get_ancestor(store, target, block.slot) == block
-//        )
-func VoteCount(block *pb.BeaconBlock, state *pb.BeaconState, targets map[uint64]*pb.BeaconBlock, beaconDB *db.BeaconDB) (int, error) {
-	balances := 0
-	for validatorIndex, targetBlock := range targets {
-		ancestor, err := BlockAncestor(targetBlock, block.Slot, beaconDB)
-		if err != nil {
-			return 0, err
-		}
-		// This covers the following case, we start at B5, and want to process B6 and B7
-		// B6 can be processed, B7 can not be processed because it's pointed to the
-		// block older than current block 5.
-		// B4 - B5 - B6
-		//   \ - - - - - B7
-		if ancestor == nil {
-			continue
-		}
-		ancestorRoot, err := hashutil.HashBeaconBlock(ancestor)
-		if err != nil {
-			return 0, err
-		}
-		blockRoot, err := hashutil.HashBeaconBlock(block)
-		if err != nil {
-			return 0, err
-		}
-		if blockRoot == ancestorRoot {
-			balances += int(helpers.EffectiveBalance(state, validatorIndex))
-		}
-	}
-	return balances, nil
-}
-
-// BlockAncestor obtains the ancestor at of a block at a certain slot.
-//
-// Spec pseudocode definition:
-//  def get_ancestor(store: Store, block: BeaconBlock, slot: Slot) -> BeaconBlock:
-//    """
-//    Get the ancestor of ``block`` with slot number ``slot``; return ``None`` if not found.
-//    """
-//    if block.slot == slot:
-//        return block
-//    elif block.slot < slot:
-//        return None
-//    else:
-//        return get_ancestor(store, store.get_parent(block), slot)
-func BlockAncestor(block *pb.BeaconBlock, slot uint64, beaconDB *db.BeaconDB) (*pb.BeaconBlock, error) {
-	if block.Slot == slot {
-		return block, nil
-	}
-	if block.Slot < slot {
-		return nil, nil
-	}
-	parentHash := bytesutil.ToBytes32(block.ParentRootHash32)
-	parent, err := beaconDB.Block(parentHash)
-	if err != nil {
-		return nil, fmt.Errorf("could not get parent block: %v", err)
-	}
-	if parent == nil {
-		return nil, fmt.Errorf("parent block does not exist: %v", err)
-	}
-	return BlockAncestor(parent, slot, beaconDB)
-}
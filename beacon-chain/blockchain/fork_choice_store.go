@@ -0,0 +1,236 @@
+package blockchain
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+// errUnknownParent is returned when a block is inserted into the fork choice store
+// before its parent.
+var errUnknownParent = errors.New("parent block is not in the fork choice store")
+
+// blockNode is a block of the fork choice store. The weight of a node is the
+// balance of the validators whose latest vote is the node or one of its descendants.
+type blockNode struct {
+	block  *pb.BeaconBlock
+	root   [32]byte
+	parent int
+	weight uint64
+	// bestChild and bestDescendant are indices of the child leading to the head
+	// and of the head itself, when starting from this node. They are -1 for leaves.
+	bestChild      int
+	bestDescendant int
+}
+
+// vote is the latest message of a validator as applied to the store weights.
+type vote struct {
+	root    [32]byte
+	balance uint64
+}
+
+// forkChoiceStore keeps the block tree above the last finalized block in memory.
+// Nodes are stored in an array where a parent always precedes its children, so
+// vote deltas can be propagated to the ancestors and the head found with a
+// single backwards pass over the array.
+type forkChoiceStore struct {
+	lock    sync.RWMutex
+	nodes   []*blockNode
+	indices map[[32]byte]int
+	votes   map[uint64]*vote
+}
+
+func newForkChoiceStore() *forkChoiceStore {
+	return &forkChoiceStore{
+		indices: make(map[[32]byte]int),
+		votes:   make(map[uint64]*vote),
+	}
+}
+
+// reset empties the store and makes the block the root of the tree.
+func (s *forkChoiceStore) reset(block *pb.BeaconBlock) error {
+	root, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return fmt.Errorf("could not hash block: %v", err)
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.nodes = []*blockNode{{block: block, root: root, parent: -1, bestChild: -1, bestDescendant: -1}}
+	s.indices = map[[32]byte]int{root: 0}
+	s.votes = make(map[uint64]*vote)
+	return nil
+}
+
+// hasBlock returns true if the block root is in the store.
+func (s *forkChoiceStore) hasBlock(root [32]byte) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	_, ok := s.indices[root]
+	return ok
+}
+
+// size returns the number of blocks in the store.
+func (s *forkChoiceStore) size() int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return len(s.nodes)
+}
+
+// insertBlock adds a block whose parent is already in the store. Inserting a
+// known block is a no-op.
+func (s *forkChoiceStore) insertBlock(block *pb.BeaconBlock) error {
+	root, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return fmt.Errorf("could not hash block: %v", err)
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.indices[root]; ok {
+		return nil
+	}
+	parent, ok := s.indices[bytesutil.ToBytes32(block.ParentRootHash32)]
+	if !ok {
+		return errUnknownParent
+	}
+	s.indices[root] = len(s.nodes)
+	s.nodes = append(s.nodes, &blockNode{block: block, root: root, parent: parent, bestChild: -1, bestDescendant: -1})
+	return nil
+}
+
+// updateVotes applies the difference between the latest votes and the votes
+// applied so far to the weights of the tree. Votes are weighted by the effective
+// balance of the validators in the given state, and votes for blocks outside of
+// the store are ignored.
+func (s *forkChoiceStore) updateVotes(targets map[uint64][32]byte, state *pb.BeaconState) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	deltas := make([]int64, len(s.nodes))
+	for index, old := range s.votes {
+		if _, ok := targets[index]; !ok {
+			deltas[s.indices[old.root]] -= int64(old.balance)
+			delete(s.votes, index)
+		}
+	}
+	for index, root := range targets {
+		old, hasOld := s.votes[index]
+		newIdx, isKnown := s.indices[root]
+		var balance uint64
+		if isKnown {
+			balance = helpers.EffectiveBalance(state, index)
+		}
+		if hasOld && isKnown && old.root == root && old.balance == balance {
+			continue
+		}
+		if hasOld {
+			deltas[s.indices[old.root]] -= int64(old.balance)
+			delete(s.votes, index)
+		}
+		if isKnown {
+			deltas[newIdx] += int64(balance)
+			s.votes[index] = &vote{root: root, balance: balance}
+		}
+	}
+
+	// Children come after their parents, so walking the nodes backwards applies
+	// every delta to a node before handing it to the parent.
+	for i := len(s.nodes) - 1; i >= 0; i-- {
+		node := s.nodes[i]
+		node.weight = uint64(int64(node.weight) + deltas[i])
+		if node.parent >= 0 {
+			deltas[node.parent] += deltas[i]
+		}
+	}
+	s.updateBestDescendants()
+}
+
+// updateBestDescendants recomputes the best child and descendant of every node.
+// The best child is the heaviest child, ties being broken in favor of the
+// lexicographically highest block root.
+func (s *forkChoiceStore) updateBestDescendants() {
+	for _, node := range s.nodes {
+		node.bestChild = -1
+		node.bestDescendant = -1
+	}
+	for i := len(s.nodes) - 1; i >= 0; i-- {
+		node := s.nodes[i]
+		// All the children of the node were visited already.
+		if node.bestChild >= 0 {
+			node.bestDescendant = s.nodes[node.bestChild].bestDescendant
+		} else {
+			node.bestDescendant = i
+		}
+		if node.parent < 0 {
+			continue
+		}
+		parent := s.nodes[node.parent]
+		if parent.bestChild < 0 {
+			parent.bestChild = i
+			continue
+		}
+		best := s.nodes[parent.bestChild]
+		if node.weight > best.weight ||
+			(node.weight == best.weight && bytes.Compare(node.root[:], best.root[:]) > 0) {
+			parent.bestChild = i
+		}
+	}
+}
+
+// head returns the head of the chain starting from the given justified block root.
+func (s *forkChoiceStore) head(justifiedRoot [32]byte) (*pb.BeaconBlock, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	i, ok := s.indices[justifiedRoot]
+	if !ok {
+		return nil, fmt.Errorf("justified block %#x is not in the fork choice store", justifiedRoot)
+	}
+	if best := s.nodes[i].bestDescendant; best >= 0 {
+		return s.nodes[best].block, nil
+	}
+	return s.nodes[i].block, nil
+}
+
+// prune removes the blocks which do not descend from the finalized block, which
+// becomes the new root of the tree. It is a no-op if the finalized block is not in
+// the store or is already its root.
+func (s *forkChoiceStore) prune(finalizedRoot [32]byte) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	finalizedIdx, ok := s.indices[finalizedRoot]
+	if !ok || finalizedIdx == 0 {
+		return
+	}
+
+	newIndices := make(map[int]int)
+	var nodes []*blockNode
+	for i := finalizedIdx; i < len(s.nodes); i++ {
+		node := s.nodes[i]
+		if i == finalizedIdx {
+			node.parent = -1
+		} else if parent, ok := newIndices[node.parent]; ok {
+			node.parent = parent
+		} else {
+			continue
+		}
+		newIndices[i] = len(nodes)
+		nodes = append(nodes, node)
+	}
+	s.nodes = nodes
+	s.indices = make(map[[32]byte]int, len(nodes))
+	for i, node := range nodes {
+		s.indices[node.root] = i
+	}
+	// The weight of the votes for pruned blocks left the tree along with them.
+	for index, v := range s.votes {
+		if _, ok := s.indices[v.root]; !ok {
+			delete(s.votes, index)
+		}
+	}
+	s.updateBestDescendants()
+}
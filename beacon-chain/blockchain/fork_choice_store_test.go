@@ -0,0 +1,173 @@
+package blockchain
+
+import (
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// buildForkChoiceTree constructs the following tree in a new fork choice store:
+//
+//      /- B1 - B3
+//  B0
+//      \- B2 - B4
+func buildForkChoiceTree(t *testing.T) (*forkChoiceStore, []*pb.BeaconBlock, [][32]byte) {
+	store := newForkChoiceStore()
+	blocks := []*pb.BeaconBlock{{Slot: 0}}
+	if err := store.reset(blocks[0]); err != nil {
+		t.Fatal(err)
+	}
+	parents := []int{0, 0, 1, 2}
+	for i, parent := range parents {
+		parentRoot, err := hashutil.HashBeaconBlock(blocks[parent])
+		if err != nil {
+			t.Fatal(err)
+		}
+		block := &pb.BeaconBlock{Slot: uint64(i + 1), ParentRootHash32: parentRoot[:]}
+		if err := store.insertBlock(block); err != nil {
+			t.Fatalf("Could not insert block: %v", err)
+		}
+		blocks = append(blocks, block)
+	}
+	roots := make([][32]byte, len(blocks))
+	for i, block := range blocks {
+		root, err := hashutil.HashBeaconBlock(block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		roots[i] = root
+	}
+	return store, blocks, roots
+}
+
+func balancesState(count int) *pb.BeaconState {
+	balances := make([]uint64, count)
+	for i := range balances {
+		balances[i] = params.BeaconConfig().MaxDepositAmount
+	}
+	return &pb.BeaconState{ValidatorBalances: balances}
+}
+
+func TestForkChoiceStore_InsertBlockUnknownParent(t *testing.T) {
+	store, _, _ := buildForkChoiceTree(t)
+	block := &pb.BeaconBlock{Slot: 10, ParentRootHash32: []byte{'A'}}
+	if err := store.insertBlock(block); err != errUnknownParent {
+		t.Errorf("Expected %v, received %v", errUnknownParent, err)
+	}
+	if store.size() != 5 {
+		t.Errorf("Expected 5 blocks in the store, received %d", store.size())
+	}
+}
+
+func TestForkChoiceStore_VoteDeltas(t *testing.T) {
+	store, blocks, roots := buildForkChoiceTree(t)
+	state := balancesState(3)
+
+	targets := map[uint64][32]byte{0: roots[3], 1: roots[4], 2: roots[4]}
+	store.updateVotes(targets, state)
+	head, err := store.head(roots[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != blocks[4] {
+		t.Errorf("Expected head at slot %d, received slot %d", blocks[4].Slot, head.Slot)
+	}
+	if weight := store.nodes[store.indices[roots[2]]].weight; weight != 2*params.BeaconConfig().MaxDepositAmount {
+		t.Errorf("Expected B2 weight %d, received %d", 2*params.BeaconConfig().MaxDepositAmount, weight)
+	}
+
+	// Validators 1 and 2 move their votes, validator 1 votes for B1 which is an ancestor of B3.
+	targets = map[uint64][32]byte{0: roots[3], 1: roots[1], 2: roots[3]}
+	store.updateVotes(targets, state)
+	head, err = store.head(roots[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != blocks[3] {
+		t.Errorf("Expected head at slot %d, received slot %d", blocks[3].Slot, head.Slot)
+	}
+	if weight := store.nodes[store.indices[roots[1]]].weight; weight != 3*params.BeaconConfig().MaxDepositAmount {
+		t.Errorf("Expected B1 weight %d, received %d", 3*params.BeaconConfig().MaxDepositAmount, weight)
+	}
+	if weight := store.nodes[store.indices[roots[2]]].weight; weight != 0 {
+		t.Errorf("Expected B2 weight 0, received %d", weight)
+	}
+
+	// Validators without a latest vote have their weight removed, and balance changes are applied.
+	state.ValidatorBalances[0] = params.BeaconConfig().MaxDepositAmount / 2
+	store.updateVotes(map[uint64][32]byte{0: roots[3]}, state)
+	if weight := store.nodes[0].weight; weight != params.BeaconConfig().MaxDepositAmount/2 {
+		t.Errorf("Expected B0 weight %d, received %d", params.BeaconConfig().MaxDepositAmount/2, weight)
+	}
+}
+
+func TestForkChoiceStore_UnknownVoteTargetIgnored(t *testing.T) {
+	store, blocks, roots := buildForkChoiceTree(t)
+	state := balancesState(2)
+
+	store.updateVotes(map[uint64][32]byte{0: {'A'}, 1: roots[3]}, state)
+	head, err := store.head(roots[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != blocks[3] {
+		t.Errorf("Expected head at slot %d, received slot %d", blocks[3].Slot, head.Slot)
+	}
+	if weight := store.nodes[0].weight; weight != params.BeaconConfig().MaxDepositAmount {
+		t.Errorf("Expected B0 weight %d, received %d", params.BeaconConfig().MaxDepositAmount, weight)
+	}
+}
+
+func TestForkChoiceStore_TieBreaksOnHigherRoot(t *testing.T) {
+	store, blocks, roots := buildForkChoiceTree(t)
+	store.updateVotes(map[uint64][32]byte{}, balancesState(0))
+
+	want := blocks[3]
+	if string(roots[2][:]) > string(roots[1][:]) {
+		want = blocks[4]
+	}
+	head, err := store.head(roots[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != want {
+		t.Errorf("Expected head at slot %d, received slot %d", want.Slot, head.Slot)
+	}
+}
+
+func TestForkChoiceStore_Prune(t *testing.T) {
+	store, blocks, roots := buildForkChoiceTree(t)
+	state := balancesState(2)
+	store.updateVotes(map[uint64][32]byte{0: roots[3], 1: roots[4]}, state)
+
+	store.prune(roots[2])
+	if store.size() != 2 {
+		t.Fatalf("Expected 2 blocks after pruning, received %d", store.size())
+	}
+	for _, root := range [][32]byte{roots[0], roots[1], roots[3]} {
+		if store.hasBlock(root) {
+			t.Errorf("Expected block %#x to be pruned", root)
+		}
+	}
+	if _, ok := store.votes[0]; ok {
+		t.Error("Expected vote for a pruned block to be removed")
+	}
+	if weight := store.nodes[0].weight; weight != params.BeaconConfig().MaxDepositAmount {
+		t.Errorf("Expected B2 weight %d, received %d", params.BeaconConfig().MaxDepositAmount, weight)
+	}
+	head, err := store.head(roots[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != blocks[4] {
+		t.Errorf("Expected head at slot %d, received slot %d", blocks[4].Slot, head.Slot)
+	}
+
+	// Blocks building on the pruned branch are no longer accepted.
+	block := &pb.BeaconBlock{Slot: 5, ParentRootHash32: roots[3][:]}
+	if err := store.insertBlock(block); err != errUnknownParent {
+		t.Errorf("Expected %v, received %v", errUnknownParent, err)
+	}
+}
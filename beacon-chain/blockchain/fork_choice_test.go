@@ -125,71 +125,6 @@ func TestApplyForkChoice_SetsCanonicalHead(t *testing.T) {
 	}
 }
 
-func TestVoteCount_ParentDoesNotExistNoVoteCount(t *testing.T) {
-	beaconDB := internal.SetupDB(t)
-	defer internal.TeardownDB(t, beaconDB)
-	genesisBlock := b.NewGenesisBlock([]byte("stateroot"))
-	if err := beaconDB.SaveBlock(genesisBlock); err != nil {
-		t.Fatal(err)
-	}
-	potentialHead := &pb.BeaconBlock{
-		ParentRootHash32: []byte{'A'}, // We give a bogus parent root hash.
-	}
-	if err := beaconDB.SaveBlock(potentialHead); err != nil {
-		t.Fatal(err)
-	}
-
-	voteTargets := make(map[uint64]*pb.BeaconBlock)
-	voteTargets[0] = potentialHead
-	count, err := VoteCount(genesisBlock, &pb.BeaconState{}, voteTargets, beaconDB)
-	if err != nil {
-		t.Fatalf("Could not get vote count: %v", err)
-	}
-	if count != 0 {
-		t.Errorf("Wanted vote count 0, got: %d", count)
-	}
-}
-
-func TestVoteCount_IncreaseCountCorrectly(t *testing.T) {
-	beaconDB := internal.SetupDB(t)
-	defer internal.TeardownDB(t, beaconDB)
-	genesisBlock := b.NewGenesisBlock([]byte("stateroot"))
-	genesisRoot, err := hashutil.HashBeaconBlock(genesisBlock)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if err := beaconDB.SaveBlock(genesisBlock); err != nil {
-		t.Fatal(err)
-	}
-
-	potentialHead := &pb.BeaconBlock{
-		Slot:             params.BeaconConfig().GenesisSlot + 5,
-		ParentRootHash32: genesisRoot[:],
-	}
-	potentialHead2 := &pb.BeaconBlock{
-		Slot:             params.BeaconConfig().GenesisSlot + 6,
-		ParentRootHash32: genesisRoot[:],
-	}
-	// We store these potential heads in the DB.
-	if err := beaconDB.SaveBlock(potentialHead); err != nil {
-		t.Fatal(err)
-	}
-	if err := beaconDB.SaveBlock(potentialHead2); err != nil {
-		t.Fatal(err)
-	}
-	beaconState := &pb.BeaconState{ValidatorBalances: []uint64{1e9, 1e9}}
-	voteTargets := make(map[uint64]*pb.BeaconBlock)
-	voteTargets[0] = potentialHead
-	voteTargets[1] = potentialHead2
-	count, err := VoteCount(genesisBlock, beaconState, voteTargets, beaconDB)
-	if err != nil {
-		t.Fatalf("Could not fetch vote balances: %v", err)
-	}
-	if count != 2e9 {
-		t.Errorf("Expected total balances 2e9, received %d", count)
-	}
-}
-
 func TestAttestationTargets_RetrieveWorks(t *testing.T) {
 	beaconDB := internal.SetupDB(t)
 	defer internal.TeardownDB(t, beaconDB)
@@ -231,8 +166,8 @@ func TestAttestationTargets_RetrieveWorks(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Could not get attestation targets: %v", err)
 	}
-	if attestationTargets[0].Slot != block.Slot {
-		t.Errorf("Wanted attested slot %d, got %d", block.Slot, attestationTargets[0].Slot)
+	if attestationTargets[0] != blockRoot {
+		t.Errorf("Wanted attested block %#x, got %#x", blockRoot, attestationTargets[0])
 	}
 }
 
@@ -290,7 +225,7 @@ func TestBlockChildren_2InARow(t *testing.T) {
 		t.Fatalf("Could update chain head: %v", err)
 	}
 
-	childrenBlock, err := chainService.blockChildren(ctx, block1, state.Slot)
+	childrenBlock, err := chainService.blockChildren(ctx, block1)
 	if err != nil {
 		t.Fatalf("Could not get block children: %v", err)
 	}
@@ -365,7 +300,7 @@ func TestBlockChildren_ChainSplits(t *testing.T) {
 		t.Fatalf("Could update chain head: %v", err)
 	}
 
-	childrenBlock, err := chainService.blockChildren(ctx, block1, state.Slot)
+	childrenBlock, err := chainService.blockChildren(ctx, block1)
 	if err != nil {
 		t.Fatalf("Could not get block children: %v", err)
 	}
@@ -431,7 +366,7 @@ func TestBlockChildren_SkipSlots(t *testing.T) {
 		t.Fatalf("Could update chain head: %v", err)
 	}
 
-	childrenBlock, err := chainService.blockChildren(ctx, block1, state.Slot)
+	childrenBlock, err := chainService.blockChildren(ctx, block1)
 	if err != nil {
 		t.Fatalf("Could not get block children: %v", err)
 	}
@@ -443,6 +378,61 @@ func TestBlockChildren_SkipSlots(t *testing.T) {
 	}
 }
 
+func TestBlockChildren_SameSlotForks(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+
+	chainService := setupBeaconChain(t, beaconDB, nil)
+
+	// Construct the following chain, where B2' is saved last and owns slot 2
+	// in the main chain:
+	//     /- B2
+	// B1 <- B2'
+	block1 := &pb.BeaconBlock{
+		Slot:             1,
+		ParentRootHash32: []byte{'A'},
+	}
+	root1, err := hashutil.HashBeaconBlock(block1)
+	if err != nil {
+		t.Fatalf("Could not hash block: %v", err)
+	}
+	block2 := &pb.BeaconBlock{
+		Slot:             2,
+		ParentRootHash32: root1[:],
+		RandaoReveal:     []byte{'B'},
+	}
+	forkBlock2 := &pb.BeaconBlock{
+		Slot:             2,
+		ParentRootHash32: root1[:],
+		RandaoReveal:     []byte{'C'},
+	}
+	for _, block := range []*pb.BeaconBlock{block1, block2, forkBlock2} {
+		if err := chainService.beaconDB.SaveBlock(block); err != nil {
+			t.Fatalf("Could not save block: %v", err)
+		}
+	}
+
+	childrenBlock, err := chainService.blockChildren(ctx, block1)
+	if err != nil {
+		t.Fatalf("Could not get block children: %v", err)
+	}
+	if len(childrenBlock) != 2 {
+		t.Fatalf("Expected 2 children, received %d", len(childrenBlock))
+	}
+	for _, wanted := range []*pb.BeaconBlock{block2, forkBlock2} {
+		found := false
+		for _, child := range childrenBlock {
+			if reflect.DeepEqual(child, wanted) {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("Expected child %v in %v", wanted, childrenBlock)
+		}
+	}
+}
+
 func TestLMDGhost_TrivialHeadUpdate(t *testing.T) {
 	beaconDB := internal.SetupDB(t)
 	defer internal.TeardownDB(t, beaconDB)
@@ -484,9 +474,14 @@ func TestLMDGhost_TrivialHeadUpdate(t *testing.T) {
 		t.Fatalf("Could update chain head: %v", err)
 	}
 
+	root2, err := hashutil.HashBeaconBlock(block2)
+	if err != nil {
+		t.Fatalf("Could not hash block: %v", err)
+	}
+
 	// The only vote is on block 2.
-	voteTargets := make(map[uint64]*pb.BeaconBlock)
-	voteTargets[0] = block2
+	voteTargets := make(map[uint64][32]byte)
+	voteTargets[0] = root2
 
 	// LMDGhost should pick block 2.
 	head, err := chainService.lmdGhost(ctx, block1, state, voteTargets)
@@ -567,12 +562,25 @@ func TestLMDGhost_3WayChainSplitsSameHeight(t *testing.T) {
 		t.Fatalf("Could update chain head: %v", err)
 	}
 
+	root2, err := hashutil.HashBeaconBlock(block2)
+	if err != nil {
+		t.Fatalf("Could not hash block: %v", err)
+	}
+	root3, err := hashutil.HashBeaconBlock(block3)
+	if err != nil {
+		t.Fatalf("Could not hash block: %v", err)
+	}
+	root4, err := hashutil.HashBeaconBlock(block4)
+	if err != nil {
+		t.Fatalf("Could not hash block: %v", err)
+	}
+
 	// Give block 4 the most votes (2).
-	voteTargets := make(map[uint64]*pb.BeaconBlock)
-	voteTargets[0] = block2
-	voteTargets[1] = block3
-	voteTargets[2] = block4
-	voteTargets[3] = block4
+	voteTargets := make(map[uint64][32]byte)
+	voteTargets[0] = root2
+	voteTargets[1] = root3
+	voteTargets[2] = root4
+	voteTargets[3] = root4
 	// LMDGhost should pick block 4.
 	head, err := chainService.lmdGhost(ctx, block1, state, voteTargets)
 	if err != nil {
@@ -685,11 +693,20 @@ func TestLMDGhost_2WayChainSplitsDiffHeight(t *testing.T) {
 		t.Fatalf("Could update chain head: %v", err)
 	}
 
+	root5, err := hashutil.HashBeaconBlock(block5)
+	if err != nil {
+		t.Fatalf("Could not hash block: %v", err)
+	}
+	root6, err := hashutil.HashBeaconBlock(block6)
+	if err != nil {
+		t.Fatalf("Could not hash block: %v", err)
+	}
+
 	// Give block 5 the most votes (2).
-	voteTargets := make(map[uint64]*pb.BeaconBlock)
-	voteTargets[0] = block6
-	voteTargets[1] = block5
-	voteTargets[2] = block5
+	voteTargets := make(map[uint64][32]byte)
+	voteTargets[0] = root6
+	voteTargets[1] = root5
+	voteTargets[2] = root5
 	// LMDGhost should pick block 5.
 	head, err := chainService.lmdGhost(ctx, block1, state, voteTargets)
 	if err != nil {
@@ -756,9 +773,9 @@ func BenchmarkLMDGhost_8Slots_8Validators(b *testing.B) {
 		}
 	}
 
-	voteTargets := make(map[uint64]*pb.BeaconBlock)
+	voteTargets := make(map[uint64][32]byte)
 	for i := 0; i < validatorCount; i++ {
-		voteTargets[uint64(i)] = block
+		voteTargets[uint64(i)] = root
 	}
 
 	for i := 0; i < b.N; i++ {
@@ -827,9 +844,9 @@ func BenchmarkLMDGhost_32Slots_8Validators(b *testing.B) {
 		}
 	}
 
-	voteTargets := make(map[uint64]*pb.BeaconBlock)
+	voteTargets := make(map[uint64][32]byte)
 	for i := 0; i < validatorCount; i++ {
-		voteTargets[uint64(i)] = block
+		voteTargets[uint64(i)] = root
 	}
 
 	for i := 0; i < b.N; i++ {
@@ -896,9 +913,9 @@ func BenchmarkLMDGhost_32Slots_64Validators(b *testing.B) {
 		}
 	}
 
-	voteTargets := make(map[uint64]*pb.BeaconBlock)
+	voteTargets := make(map[uint64][32]byte)
 	for i := 0; i < validatorCount; i++ {
-		voteTargets[uint64(i)] = block
+		voteTargets[uint64(i)] = root
 	}
 
 	for i := 0; i < b.N; i++ {
@@ -965,9 +982,9 @@ func BenchmarkLMDGhost_64Slots_16384Validators(b *testing.B) {
 		}
 	}
 
-	voteTargets := make(map[uint64]*pb.BeaconBlock)
+	voteTargets := make(map[uint64][32]byte)
 	for i := 0; i < validatorCount; i++ {
-		voteTargets[uint64(i)] = block
+		voteTargets[uint64(i)] = root
 	}
 
 	for i := 0; i < b.N; i++ {
@@ -0,0 +1,129 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	reorgCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "reorg_counter",
+		Help: "The number of chain reorganization events that have happened in the fork choice rule",
+	})
+	reorgDepthGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "reorg_depth",
+		Help: "The number of blocks orphaned by the last chain reorganization",
+	})
+)
+
+// ReorgEvent is sent on the reorg feed when the fork choice rule moves the chain
+// head to a block which does not descend from the previous head.
+type ReorgEvent struct {
+	OldHead        *pb.BeaconBlock
+	NewHead        *pb.BeaconBlock
+	CommonAncestor *pb.BeaconBlock
+	// Depth is the number of blocks of the old chain which were orphaned.
+	Depth uint64
+}
+
+// handleReorg includes the blocks between the common ancestor of the old and new
+// heads and the new head in the main chain. If the new head does not descend from
+// the old head, the orphaned blocks are removed from the main chain, and the reorg
+// event is returned along with the orphaned blocks so they can be published by
+// sendReorg. The event is nil if there was no reorg. The canonical blocks lock
+// must be held by the caller.
+func (c *ChainService) handleReorg(ctx context.Context, oldHead *pb.BeaconBlock, newHead *pb.BeaconBlock) (*ReorgEvent, []*pb.BeaconBlock, error) {
+	ancestor, orphaned, canonical, err := c.commonAncestor(oldHead, newHead)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not find common ancestor of old and new head: %v", err)
+	}
+	if ancestor == nil {
+		log.Warn("Old and new chain heads have no common ancestor in db, skipping reorg handling")
+		return nil, nil, nil
+	}
+	if err := c.beaconDB.ReorgMainChain(ctx, orphaned, canonical); err != nil {
+		return nil, nil, fmt.Errorf("could not update main chain: %v", err)
+	}
+	for _, block := range orphaned {
+		delete(c.canonicalBlocks, block.Slot)
+	}
+	for _, block := range canonical {
+		root, err := hashutil.HashBeaconBlock(block)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not hash block: %v", err)
+		}
+		c.canonicalBlocks[block.Slot] = root[:]
+	}
+	if len(orphaned) == 0 {
+		return nil, nil, nil
+	}
+
+	depth := uint64(len(orphaned))
+	reorgCount.Inc()
+	reorgDepthGauge.Set(float64(depth))
+	log.WithFields(logrus.Fields{
+		"oldHeadSlot":        oldHead.Slot - params.BeaconConfig().GenesisSlot,
+		"newHeadSlot":        newHead.Slot - params.BeaconConfig().GenesisSlot,
+		"commonAncestorSlot": ancestor.Slot - params.BeaconConfig().GenesisSlot,
+		"depth":              depth,
+	}).Warn("Chain reorganization")
+	return &ReorgEvent{
+		OldHead:        oldHead,
+		NewHead:        newHead,
+		CommonAncestor: ancestor,
+		Depth:          depth,
+	}, orphaned, nil
+}
+
+// sendReorg hands the orphaned blocks back to the operations pool, so their
+// attestations can be included again, and publishes the reorg event. It must be
+// called without holding the canonical blocks lock, as feed subscribers may call
+// back into the chain service.
+func (c *ChainService) sendReorg(event *ReorgEvent, orphaned []*pb.BeaconBlock) {
+	for _, block := range orphaned {
+		c.opsPoolService.IncomingOrphanedBlockFeed().Send(block)
+	}
+	c.reorgFeed.Send(event)
+}
+
+// commonAncestor walks back the chains of the two blocks until they meet. It returns
+// the common ancestor along with the blocks above it on the chain of each block,
+// highest slot first. The ancestor is nil if one of the chains ends before they meet.
+func (c *ChainService) commonAncestor(a *pb.BeaconBlock, b *pb.BeaconBlock) (*pb.BeaconBlock, []*pb.BeaconBlock, []*pb.BeaconBlock, error) {
+	rootA, err := hashutil.HashBeaconBlock(a)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("could not hash block: %v", err)
+	}
+	rootB, err := hashutil.HashBeaconBlock(b)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("could not hash block: %v", err)
+	}
+	var branchA, branchB []*pb.BeaconBlock
+	for rootA != rootB {
+		if a == nil || b == nil {
+			return nil, nil, nil, nil
+		}
+		if a.Slot >= b.Slot {
+			branchA = append(branchA, a)
+			rootA = bytesutil.ToBytes32(a.ParentRootHash32)
+			if a, err = c.beaconDB.Block(rootA); err != nil {
+				return nil, nil, nil, fmt.Errorf("could not get block %#x: %v", rootA, err)
+			}
+		} else {
+			branchB = append(branchB, b)
+			rootB = bytesutil.ToBytes32(b.ParentRootHash32)
+			if b, err = c.beaconDB.Block(rootB); err != nil {
+				return nil, nil, nil, fmt.Errorf("could not get block %#x: %v", rootB, err)
+			}
+		}
+	}
+	return a, branchA, branchB, nil
+}
@@ -0,0 +1,244 @@
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/attestation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// saveChain saves blocks building on each other from the parent at the given slots,
+// and returns them along with their roots.
+func saveChain(t *testing.T, c *ChainService, parent *pb.BeaconBlock, reveal byte, slots ...uint64) ([]*pb.BeaconBlock, [][32]byte) {
+	var blocks []*pb.BeaconBlock
+	var roots [][32]byte
+	for _, slot := range slots {
+		parentRoot, err := hashutil.HashBeaconBlock(parent)
+		if err != nil {
+			t.Fatal(err)
+		}
+		block := &pb.BeaconBlock{
+			Slot:             params.BeaconConfig().GenesisSlot + slot,
+			ParentRootHash32: parentRoot[:],
+			RandaoReveal:     []byte{reveal},
+		}
+		if err := c.beaconDB.SaveBlock(block); err != nil {
+			t.Fatalf("Could not save block: %v", err)
+		}
+		root, err := hashutil.HashBeaconBlock(block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		blocks = append(blocks, block)
+		roots = append(roots, root)
+		parent = block
+	}
+	return blocks, roots
+}
+
+func TestHandleReorg_OrphansOldChain(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	chainService := setupBeaconChain(t, beaconDB, nil)
+
+	genesis := &pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot}
+	if err := beaconDB.SaveBlock(genesis); err != nil {
+		t.Fatal(err)
+	}
+	// Construct the following chain, where A3 is the old head:
+	//    /- A1 - A2 - A3
+	//  G
+	//    \- B1 - B2
+	oldChain, oldRoots := saveChain(t, chainService, genesis, 'A', 1, 2, 3)
+	newChain, newRoots := saveChain(t, chainService, genesis, 'B', 1, 2)
+	for i, block := range oldChain {
+		chainService.InsertsCanonical(block.Slot, oldRoots[i][:])
+	}
+
+	chainService.canonicalBlocksLock.Lock()
+	event, orphaned, err := chainService.handleReorg(ctx, oldChain[2], newChain[1])
+	chainService.canonicalBlocksLock.Unlock()
+	if err != nil {
+		t.Fatalf("Could not handle reorg: %v", err)
+	}
+
+	want := &ReorgEvent{OldHead: oldChain[2], NewHead: newChain[1], CommonAncestor: genesis, Depth: 3}
+	if !reflect.DeepEqual(event, want) {
+		t.Errorf("Expected reorg event %v, received %v", want, event)
+	}
+	wantOrphaned := []*pb.BeaconBlock{oldChain[2], oldChain[1], oldChain[0]}
+	if !reflect.DeepEqual(orphaned, wantOrphaned) {
+		t.Errorf("Expected orphaned blocks %v, received %v", wantOrphaned, orphaned)
+	}
+	for i, block := range newChain {
+		if !chainService.IsCanonical(block.Slot, newRoots[i][:]) {
+			t.Errorf("Expected block at slot %d to be canonical", block.Slot)
+		}
+		mainBlock, err := beaconDB.BlockBySlot(ctx, block.Slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(mainBlock, block) {
+			t.Errorf("Expected block %v in the main chain, received %v", block, mainBlock)
+		}
+	}
+	if chainService.IsCanonical(oldChain[2].Slot, oldRoots[2][:]) {
+		t.Error("Expected orphaned block to no longer be canonical")
+	}
+	mainBlock, err := beaconDB.BlockBySlot(ctx, oldChain[2].Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mainBlock != nil {
+		t.Errorf("Expected orphaned slot to be removed from the main chain, received %v", mainBlock)
+	}
+}
+
+func TestHandleReorg_NewHeadDescendsFromOldHead(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	chainService := setupBeaconChain(t, beaconDB, nil)
+
+	genesis := &pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot}
+	if err := beaconDB.SaveBlock(genesis); err != nil {
+		t.Fatal(err)
+	}
+	chain, roots := saveChain(t, chainService, genesis, 'A', 1, 2, 3)
+
+	chainService.canonicalBlocksLock.Lock()
+	event, orphaned, err := chainService.handleReorg(context.Background(), chain[0], chain[2])
+	chainService.canonicalBlocksLock.Unlock()
+	if err != nil {
+		t.Fatalf("Could not handle reorg: %v", err)
+	}
+	if event != nil || len(orphaned) != 0 {
+		t.Errorf("Expected no reorg, received event %v orphaning %v", event, orphaned)
+	}
+	// The blocks between the old and new heads join the main chain.
+	for i, block := range chain[1:] {
+		if !chainService.IsCanonical(block.Slot, roots[i+1][:]) {
+			t.Errorf("Expected block at slot %d to be canonical", block.Slot)
+		}
+		mainBlock, err := beaconDB.BlockBySlot(context.Background(), block.Slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(mainBlock, block) {
+			t.Errorf("Expected block %v in the main chain, received %v", block, mainBlock)
+		}
+	}
+}
+
+// setupForkChoiceReorg saves two sibling blocks on top of a justified genesis block,
+// with the one the fork choice rule does not pick as the chain head. It returns the
+// chain head state along with the old and new heads.
+func setupForkChoiceReorg(t *testing.T, chainService *ChainService, beaconDB *db.BeaconDB) (*pb.BeaconState, *pb.BeaconBlock, *pb.BeaconBlock) {
+	deposits, _ := setupInitialDeposits(t, 5)
+	beaconState, err := state.GenesisBeaconState(deposits, 0, nil)
+	if err != nil {
+		t.Fatalf("Cannot create genesis beacon state: %v", err)
+	}
+	genesis := &pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot}
+	if err := beaconDB.SaveBlock(genesis); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveJustifiedBlock(genesis); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveJustifiedState(beaconState); err != nil {
+		t.Fatal(err)
+	}
+	// Without any votes, the fork choice rule picks the sibling with the highest root,
+	// so the old head is the sibling with the lowest root.
+	chainA, rootsA := saveChain(t, chainService, genesis, 'A', 1)
+	chainB, rootsB := saveChain(t, chainService, genesis, 'B', 1)
+	oldHead, oldRoot, newHead := chainA[0], rootsA[0], chainB[0]
+	if bytes.Compare(rootsA[0][:], rootsB[0][:]) > 0 {
+		oldHead, oldRoot, newHead = chainB[0], rootsB[0], chainA[0]
+	}
+	if err := beaconDB.UpdateChainHead(context.Background(), oldHead, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	chainService.InsertsCanonical(oldHead.Slot, oldRoot[:])
+	return beaconState, oldHead, newHead
+}
+
+func TestApplyForkChoiceRule_SendsReorgAfterUnlock(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	attsService := attestation.NewAttestationService(ctx, &attestation.Config{BeaconDB: beaconDB})
+	chainService := setupBeaconChain(t, beaconDB, attsService)
+	beaconState, _, newHead := setupForkChoiceReorg(t, chainService, beaconDB)
+
+	orphanedFeed := new(event.Feed)
+	chainService.opsPoolService = &mockOperationService{orphanedBlockFeed: orphanedFeed}
+	orphanedBlocks := make(chan *pb.BeaconBlock)
+	orphanedSub := orphanedFeed.Subscribe(orphanedBlocks)
+	defer orphanedSub.Unsubscribe()
+	events := make(chan *ReorgEvent)
+	sub := chainService.ReorgFeed().Subscribe(events)
+	defer sub.Unsubscribe()
+	unlocked := make(chan bool, 1)
+	go func() {
+		<-orphanedBlocks
+		// The reorg event is not received yet, so the fork choice rule is still
+		// blocked sending it while the lock is checked.
+		if chainService.canonicalBlocksLock.TryLock() {
+			chainService.canonicalBlocksLock.Unlock()
+			unlocked <- true
+		} else {
+			unlocked <- false
+		}
+		<-events
+	}()
+
+	if err := chainService.ApplyForkChoiceRule(ctx, newHead, beaconState); err != nil {
+		t.Fatalf("Could not apply fork choice rule: %v", err)
+	}
+	if !<-unlocked {
+		t.Error("Expected the reorg event to be sent after releasing the canonical blocks lock")
+	}
+}
+
+// failingHeadDB fails to update the chain head.
+type failingHeadDB struct {
+	db.Database
+}
+
+func (f *failingHeadDB) UpdateChainHead(_ context.Context, _ *pb.BeaconBlock, _ *pb.BeaconState) error {
+	return errors.New("could not write to db")
+}
+
+func TestApplyForkChoiceRule_NoReorgOnFailedHeadUpdate(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+	attsService := attestation.NewAttestationService(ctx, &attestation.Config{BeaconDB: beaconDB})
+	chainService := setupBeaconChain(t, beaconDB, attsService)
+	beaconState, _, newHead := setupForkChoiceReorg(t, chainService, beaconDB)
+	chainService.beaconDB = &failingHeadDB{beaconDB}
+
+	events := make(chan *ReorgEvent, 1)
+	sub := chainService.ReorgFeed().Subscribe(events)
+	defer sub.Unsubscribe()
+	if err := chainService.ApplyForkChoiceRule(ctx, newHead, beaconState); err == nil {
+		t.Fatal("Expected the fork choice rule to fail updating the chain head")
+	}
+	select {
+	case event := <-events:
+		t.Errorf("Expected no reorg event for a head which was not saved, received %v", event)
+	default:
+	}
+}
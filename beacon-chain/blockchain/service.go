@@ -36,7 +36,7 @@ type ChainFeeds interface {
 type ChainService struct {
 	ctx                  context.Context
 	cancel               context.CancelFunc
-	beaconDB             *db.BeaconDB
+	beaconDB             db.Database
 	web3Service          *powchain.Web3Service
 	attsService          *attestation.Service
 	opsPoolService       operations.OperationFeeds
@@ -48,6 +48,8 @@ type ChainService struct {
 	p2p                  p2p.Broadcaster
 	canonicalBlocks      map[uint64][]byte
 	canonicalBlocksLock  sync.RWMutex
+	forkChoiceStore      *forkChoiceStore
+	reorgFeed            *event.Feed
 }
 
 // Config options for the service.
@@ -55,7 +57,7 @@ type Config struct {
 	BeaconBlockBuf int
 	Web3Service    *powchain.Web3Service
 	AttsService    *attestation.Service
-	BeaconDB       *db.BeaconDB
+	BeaconDB       db.Database
 	OpsPoolService operations.OperationFeeds
 	DevMode        bool
 	P2p            p2p.Broadcaster
@@ -77,6 +79,8 @@ func NewChainService(ctx context.Context, cfg *Config) (*ChainService, error) {
 		stateInitializedFeed: new(event.Feed),
 		p2p:                  cfg.P2p,
 		canonicalBlocks:      make(map[uint64][]byte),
+		forkChoiceStore:      newForkChoiceStore(),
+		reorgFeed:            new(event.Feed),
 	}, nil
 }
 
@@ -197,6 +201,13 @@ func (c *ChainService) StateInitializedFeed() *event.Feed {
 	return c.stateInitializedFeed
 }
 
+// ReorgFeed returns a feed that is written to with a *ReorgEvent whenever the
+// fork choice rule moves the head to a block which does not descend from the
+// previous head.
+func (c *ChainService) ReorgFeed() *event.Feed {
+	return c.reorgFeed
+}
+
 // ChainHeadRoot returns the hash root of the last beacon block processed by the
 // block chain service.
 func (c *ChainService) ChainHeadRoot() ([32]byte, error) {
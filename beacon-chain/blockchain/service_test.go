@@ -40,17 +40,25 @@ func init() {
 	logrus.SetLevel(logrus.DebugLevel)
 	logrus.SetOutput(ioutil.Discard)
 	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{
-		EnableCrosslinks:          true,
 		EnableCheckBlockStateRoot: true,
 	})
 }
 
-type mockOperationService struct{}
+type mockOperationService struct {
+	orphanedBlockFeed *event.Feed
+}
 
 func (ms *mockOperationService) IncomingProcessedBlockFeed() *event.Feed {
 	return new(event.Feed)
 }
 
+func (ms *mockOperationService) IncomingOrphanedBlockFeed() *event.Feed {
+	if ms.orphanedBlockFeed == nil {
+		return new(event.Feed)
+	}
+	return ms.orphanedBlockFeed
+}
+
 func (ms *mockOperationService) IncomingAttFeed() *event.Feed {
 	return nil
 }
@@ -59,6 +67,14 @@ func (ms *mockOperationService) IncomingExitFeed() *event.Feed {
 	return nil
 }
 
+func (ms *mockOperationService) IncomingProposerSlashingFeed() *event.Feed {
+	return nil
+}
+
+func (ms *mockOperationService) IncomingAttesterSlashingFeed() *event.Feed {
+	return nil
+}
+
 type mockClient struct{}
 
 func (m *mockClient) SubscribeNewHead(ctx context.Context, ch chan<- *gethTypes.Header) (ethereum.Subscription, error) {
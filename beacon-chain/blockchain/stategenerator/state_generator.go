@@ -16,13 +16,16 @@ import (
 
 var log = logrus.WithField("prefix", "stategenerator")
 
-// GenerateStateFromBlock generates state from the last finalized state to the input slot.
+// GenerateStateFromBlock generates state from the closest historical state kept in the
+// db at or before the input slot, by replaying the blocks since then. Depending on the
+// historical state retention of the db, this is the last finalized state or an older
+// state kept at an epoch interval.
 // Ex:
 // 	1A - 2B(finalized) - 3C - 4 - 5D - 6 - 7F  (letters mean there's a block).
 //  Input: slot 6.
 //	Output: resulting state of state transition function after applying block C and D.
 //  	along with skipped slot 4 and 6.
-func GenerateStateFromBlock(ctx context.Context, db *db.BeaconDB, slot uint64) (*pb.BeaconState, error) {
+func GenerateStateFromBlock(ctx context.Context, db db.Database, slot uint64) (*pb.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.blockchain.stategenerator.GenerateStateFromBlock")
 	defer span.End()
 	fState, err := db.HistoricalStateFromSlot(ctx, slot)
@@ -72,10 +75,18 @@ func GenerateStateFromBlock(ctx context.Context, db *db.BeaconDB, slot uint64) (
 		}
 	}
 
-	// retrieve the block list to recompute state of the input slot.
-	blocks, err := blocksSinceFinalized(ctx, db, mostRecentBlock, fRoot)
+	// retrieve the block list to recompute state of the input slot. If the historical
+	// state is the post state of the most recent block, only skipped slots are left.
+	mostRecentRoot, err := hashutil.HashBeaconBlock(mostRecentBlock)
 	if err != nil {
-		return nil, fmt.Errorf("unable to look up block ancestors %v", err)
+		return nil, fmt.Errorf("unable to get block root %v", err)
+	}
+	var blocks []*pb.BeaconBlock
+	if mostRecentRoot != fRoot {
+		blocks, err = blocksSinceFinalized(ctx, db, mostRecentBlock, fRoot)
+		if err != nil {
+			return nil, fmt.Errorf("unable to look up block ancestors %v", err)
+		}
 	}
 
 	log.Infof("Recompute state starting last finalized slot %d and ending slot %d",
@@ -91,7 +102,7 @@ func GenerateStateFromBlock(ctx context.Context, db *db.BeaconDB, slot uint64) (
 			continue
 		}
 		// running state transitions for skipped slots.
-		for block.Slot != fState.Slot+1 {
+		for block.Slot != postState.Slot+1 {
 			postState, err = state.ExecuteStateTransition(
 				ctx,
 				postState,
@@ -157,7 +168,7 @@ func GenerateStateFromBlock(ctx context.Context, db *db.BeaconDB, slot uint64) (
 // Ex:
 // 	A -> B(finalized) -> C -> D -> E -> D.
 // 	Input: E, output: [E, D, C, B].
-func blocksSinceFinalized(ctx context.Context, db *db.BeaconDB, block *pb.BeaconBlock,
+func blocksSinceFinalized(ctx context.Context, db db.Database, block *pb.BeaconBlock,
 	finalizedBlockRoot [32]byte) ([]*pb.BeaconBlock, error) {
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.blockchain.stategenerator.blocksSinceFinalized")
 	defer span.End()
@@ -170,6 +181,9 @@ func blocksSinceFinalized(ctx context.Context, db *db.BeaconDB, block *pb.Beacon
 		if err != nil {
 			return nil, err
 		}
+		if retblock == nil {
+			return nil, fmt.Errorf("ancestor block %#x is not saved in the db", parentRoot)
+		}
 		blockAncestors = append(blockAncestors, retblock)
 		parentRoot = bytesutil.ToBytes32(retblock.ParentRootHash32)
 	}
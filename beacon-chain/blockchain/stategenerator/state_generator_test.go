@@ -29,7 +29,6 @@ func TestGenerateState_OK(t *testing.T) {
 	}
 	beaconDb := b.DB()
 	defer b.Shutdown()
-	defer db.TeardownDB(beaconDb)
 	ctx := context.Background()
 
 	slotLimit := uint64(30)
@@ -97,7 +96,6 @@ func TestGenerateState_WithNilBlocksOK(t *testing.T) {
 	}
 	beaconDb := b.DB()
 	defer b.Shutdown()
-	defer db.TeardownDB(beaconDb)
 	ctx := context.Background()
 
 	slotLimit := uint64(30)
@@ -162,6 +160,102 @@ func TestGenerateState_WithNilBlocksOK(t *testing.T) {
 	}
 }
 
+func TestGenerateState_FromRetainedState(t *testing.T) {
+	b, err := backend.NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	privKeys, err := b.SetupBackend(100)
+	if err != nil {
+		t.Fatalf("Could not set up backend %v", err)
+	}
+	beaconDb := b.DB()
+	defer b.Shutdown()
+	ctx := context.Background()
+	beaconDb.SetHistoricalStateRetention(db.HistoricalStateRetention{EpochInterval: 1})
+
+	slotLimit := uint64(30)
+	slotToGenerate := params.BeaconConfig().GenesisSlot + slotLimit/2
+	var wanted *pb.BeaconState
+	for i := uint64(0); i < slotLimit; i++ {
+		if err := b.GenerateBlockAndAdvanceChain(&backend.SimulatedObjects{}, privKeys); err != nil {
+			t.Fatalf("Could not generate block and transition state successfully %v for slot %d", err, b.State().Slot+1)
+		}
+		inMemBlocks := b.InMemoryBlocks()
+		if err := beaconDb.SaveBlock(inMemBlocks[len(inMemBlocks)-1]); err != nil {
+			t.Fatalf("Unable to save block %v", err)
+		}
+		if err := beaconDb.UpdateChainHead(ctx, inMemBlocks[len(inMemBlocks)-1], b.State()); err != nil {
+			t.Fatalf("Unable to save block %v", err)
+		}
+		if b.State().Slot == slotToGenerate {
+			wanted = proto.Clone(b.State()).(*pb.BeaconState)
+		}
+	}
+
+	// Finalizing prunes every state of the first epoch but the earliest one.
+	if err := beaconDb.SaveFinalizedState(b.State()); err != nil {
+		t.Fatalf("Unable to save finalized state: %v", err)
+	}
+	retained, err := beaconDb.HistoricalStateFromSlot(ctx, slotToGenerate)
+	if err != nil {
+		t.Fatalf("Unable to retrieve historical state %v", err)
+	}
+	if retained.Slot >= slotToGenerate {
+		t.Fatalf("Expected the state of slot %d to be pruned", slotToGenerate-params.BeaconConfig().GenesisSlot)
+	}
+
+	newState, err := stategenerator.GenerateStateFromBlock(ctx, beaconDb, slotToGenerate)
+	if err != nil {
+		t.Fatalf("Unable to regenerate pruned state %v", err)
+	}
+	if !proto.Equal(newState, wanted) {
+		t.Error("Regenerated and saved states are unequal")
+	}
+}
+
+func TestGenerateState_SkippedSlotsAfterHistoricalState(t *testing.T) {
+	b, err := backend.NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	privKeys, err := b.SetupBackend(100)
+	if err != nil {
+		t.Fatalf("Could not set up backend %v", err)
+	}
+	beaconDb := b.DB()
+	defer b.Shutdown()
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if err := b.GenerateBlockAndAdvanceChain(&backend.SimulatedObjects{}, privKeys); err != nil {
+			t.Fatalf("Could not generate block and transition state successfully %v for slot %d", err, b.State().Slot+1)
+		}
+		inMemBlocks := b.InMemoryBlocks()
+		if err := beaconDb.SaveBlock(inMemBlocks[len(inMemBlocks)-1]); err != nil {
+			t.Fatalf("Unable to save block %v", err)
+		}
+		if err := beaconDb.UpdateChainHead(ctx, inMemBlocks[len(inMemBlocks)-1], b.State()); err != nil {
+			t.Fatalf("Unable to save block %v", err)
+		}
+	}
+	// The closest historical state is the post state of the head block, which
+	// is followed by skipped slots only.
+	for i := 0; i < 2; i++ {
+		if err := b.GenerateNilBlockAndAdvanceChain(); err != nil {
+			t.Fatalf("Could not transition state through a skipped slot %v", err)
+		}
+	}
+
+	newState, err := stategenerator.GenerateStateFromBlock(ctx, beaconDb, b.State().Slot)
+	if err != nil {
+		t.Fatalf("Unable to generate state after skipped slots %v", err)
+	}
+	if !proto.Equal(newState, b.State()) {
+		t.Error("Generated and expected states are unequal")
+	}
+}
+
 func TestGenerateState_NilLatestFinalizedBlock(t *testing.T) {
 	b, err := backend.NewSimulatedBackend()
 	if err != nil {
@@ -169,7 +263,6 @@ func TestGenerateState_NilLatestFinalizedBlock(t *testing.T) {
 	}
 	beaconDB := b.DB()
 	defer b.Shutdown()
-	defer db.TeardownDB(beaconDB)
 	beaconState := &pb.BeaconState{
 		Slot: params.BeaconConfig().GenesisSlot + params.BeaconConfig().SlotsPerEpoch*4,
 	}
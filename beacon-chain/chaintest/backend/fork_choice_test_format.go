@@ -39,7 +39,7 @@ type ForkChoiceTestResult struct {
 
 // TestBlock --
 type TestBlock struct {
-	ID     string `yaml:"ID"`
+	ID     string `yaml:"id"`
 	Parent string `yaml:"parent"`
 }
 
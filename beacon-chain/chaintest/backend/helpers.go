@@ -4,6 +4,8 @@ import (
 	"crypto/rand"
 	"encoding/binary"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
@@ -168,3 +170,34 @@ func generateInitialSimulatedDeposits(numDeposits uint64) ([]*pb.Deposit, []*bls
 	}
 	return deposits, privKeys, nil
 }
+
+// parseValidatorIndices parses the validators of a fork choice test attestation, given as a
+// comma separated list of indices and inclusive ranges such as "0-3, 5".
+func parseValidatorIndices(validators string) ([]uint64, error) {
+	var indices []uint64
+	for _, item := range strings.Split(validators, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		bounds := strings.SplitN(item, "-", 2)
+		start, err := strconv.ParseUint(strings.TrimSpace(bounds[0]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid validator index %q: %v", bounds[0], err)
+		}
+		end := start
+		if len(bounds) == 2 {
+			end, err = strconv.ParseUint(strings.TrimSpace(bounds[1]), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid validator index %q: %v", bounds[1], err)
+			}
+		}
+		if end < start {
+			return nil, fmt.Errorf("invalid validator range %q", item)
+		}
+		for i := start; i <= end; i++ {
+			indices = append(indices, i)
+		}
+	}
+	return indices, nil
+}
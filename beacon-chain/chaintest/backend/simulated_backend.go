@@ -10,13 +10,17 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/attestation"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/utils"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/sliceutil"
@@ -28,7 +32,7 @@ import (
 // and other e2e use cases.
 type SimulatedBackend struct {
 	chainService       *blockchain.ChainService
-	beaconDB           *db.BeaconDB
+	beaconDB           db.Database
 	state              *pb.BeaconState
 	prevBlockRoots     [][32]byte
 	inMemoryBlocks     []*pb.BeaconBlock
@@ -46,22 +50,19 @@ type SimulatedObjects struct {
 }
 
 // NewSimulatedBackend creates an instance by initializing a chain service
-// utilizing a mockDB which will act according to test run parameters specified
-// in the common ETH 2.0 client test YAML format.
+// utilizing an in-memory db which will act according to test run parameters
+// specified in the common ETH 2.0 client test YAML format.
 func NewSimulatedBackend() (*SimulatedBackend, error) {
-	db, err := db.SetupDB()
-	if err != nil {
-		return nil, fmt.Errorf("could not setup simulated backend db: %v", err)
-	}
+	beaconDB := db.NewInMemoryDB()
 	cs, err := blockchain.NewChainService(context.Background(), &blockchain.Config{
-		BeaconDB: db,
+		BeaconDB: beaconDB,
 	})
 	if err != nil {
 		return nil, err
 	}
 	return &SimulatedBackend{
 		chainService:       cs,
-		beaconDB:           db,
+		beaconDB:           beaconDB,
 		inMemoryBlocks:     make([]*pb.BeaconBlock, 0),
 		historicalDeposits: make([]*pb.Deposit, 0),
 	}, nil
@@ -82,7 +83,7 @@ func (sb *SimulatedBackend) SetupBackend(numOfDeposits uint64) ([]*bls.SecretKey
 
 // DB returns the underlying db instance in the simulated
 // backend.
-func (sb *SimulatedBackend) DB() *db.BeaconDB {
+func (sb *SimulatedBackend) DB() db.Database {
 	return sb.beaconDB
 }
 
@@ -160,40 +161,187 @@ func (sb *SimulatedBackend) InMemoryBlocks() []*pb.BeaconBlock {
 
 // RunForkChoiceTest uses a parsed set of chaintests from a YAML file
 // according to the ETH 2.0 client chain test specification and runs them
-// against the simulated backend.
+// against a fresh chain service. The blocks of every slot are saved and the
+// listed attestations recorded as the latest attestations of their validators,
+// before the fork choice rule is applied. The resulting head, justified and
+// finalized blocks are then compared with the expected results.
 func (sb *SimulatedBackend) RunForkChoiceTest(testCase *ForkChoiceTestCase) error {
-	defer db.TeardownDB(sb.beaconDB)
 	// Utilize the config parameters in the test case to setup
 	// the DB and set global config parameters accordingly.
-	// Config parameters include: ValidatorCount, ShardCount,
-	// CycleLength, MinCommitteeSize, and more based on the YAML
-	// test language specification.
+	defaultConfig := *params.BeaconConfig()
+	defer params.OverrideBeaconConfig(&defaultConfig)
 	c := params.BeaconConfig()
 	c.ShardCount = testCase.Config.ShardCount
 	c.SlotsPerEpoch = testCase.Config.CycleLength
+	c.GenesisEpoch = c.GenesisSlot / c.SlotsPerEpoch
 	c.TargetCommitteeSize = testCase.Config.MinCommitteeSize
 	params.OverrideBeaconConfig(c)
 
-	// Then, we create the validators based on the custom test config.
-	validators := make([]*pb.Validator, testCase.Config.ValidatorCount)
-	for i := uint64(0); i < testCase.Config.ValidatorCount; i++ {
-		validators[i] = &pb.Validator{
-			ExitEpoch: params.BeaconConfig().ActivationExitDelay,
-			Pubkey:    []byte{},
+	ctx := context.Background()
+	beaconDB := db.NewInMemoryDB()
+	attsService := attestation.NewAttestationService(ctx, &attestation.Config{BeaconDB: beaconDB})
+	chainService, err := blockchain.NewChainService(ctx, &blockchain.Config{
+		BeaconDB:       beaconDB,
+		AttsService:    attsService,
+		OpsPoolService: operations.NewOpsPoolService(ctx, &operations.Config{BeaconDB: beaconDB}),
+	})
+	if err != nil {
+		return fmt.Errorf("could not setup chain service: %v", err)
+	}
+
+	deposits, _, err := generateInitialSimulatedDeposits(testCase.Config.ValidatorCount)
+	if err != nil {
+		return fmt.Errorf("could not simulate initial validator deposits: %v", err)
+	}
+	genesisState, genesisBlock, err := initializeForkChoiceDB(ctx, beaconDB, deposits)
+	if err != nil {
+		return fmt.Errorf("could not initialize fork choice test db: %v", err)
+	}
+	// Blocks are referred to by their ID in the test case, "*" being the genesis block.
+	blocks := map[string]*pb.BeaconBlock{"*": genesisBlock}
+	roots := make(map[string][32]byte)
+	if roots["*"], err = hashutil.HashBeaconBlock(genesisBlock); err != nil {
+		return fmt.Errorf("could not tree hash genesis block: %v", err)
+	}
+
+	// Fork choice is also applied on slots without a new block, to the last block
+	// received, as their attestations may change the head.
+	block := genesisBlock
+	postState := proto.Clone(genesisState).(*pb.BeaconState)
+	for _, slot := range testCase.Slots {
+		if slot.NewBlock != nil {
+			parentRoot, ok := roots[slot.NewBlock.Parent]
+			if !ok {
+				return fmt.Errorf("block %s has unknown parent %s", slot.NewBlock.ID, slot.NewBlock.Parent)
+			}
+			block = &pb.BeaconBlock{
+				Slot:             params.BeaconConfig().GenesisSlot + slot.SlotNumber,
+				ParentRootHash32: parentRoot[:],
+				RandaoReveal:     []byte(slot.NewBlock.ID),
+				Body:             &pb.BeaconBlockBody{},
+			}
+			if err := beaconDB.SaveBlock(block); err != nil {
+				return fmt.Errorf("could not save block %s: %v", slot.NewBlock.ID, err)
+			}
+			if roots[slot.NewBlock.ID], err = hashutil.HashBeaconBlock(block); err != nil {
+				return fmt.Errorf("could not tree hash block %s: %v", slot.NewBlock.ID, err)
+			}
+			blocks[slot.NewBlock.ID] = block
+			postState = proto.Clone(genesisState).(*pb.BeaconState)
+			postState.Slot = block.Slot
+		}
+
+		for _, att := range slot.Attestations {
+			root, ok := roots[att.Block]
+			if !ok {
+				return fmt.Errorf("attestation at slot %d is for unknown block %s", slot.SlotNumber, att.Block)
+			}
+			indices, err := parseValidatorIndices(att.ValidatorRegistry)
+			if err != nil {
+				return fmt.Errorf("could not parse validators of attestation at slot %d: %v", slot.SlotNumber, err)
+			}
+			committeeSlot := att.CommitteeSlot
+			if committeeSlot == 0 {
+				committeeSlot = slot.SlotNumber
+			}
+			for _, index := range indices {
+				if index >= uint64(len(genesisState.ValidatorRegistry)) {
+					return fmt.Errorf("attestation at slot %d has unknown validator %d", slot.SlotNumber, index)
+				}
+				attsService.InsertAttestationIntoStore(
+					bytesutil.ToBytes48(genesisState.ValidatorRegistry[index].Pubkey),
+					&pb.Attestation{
+						Data: &pb.AttestationData{
+							Slot:                  params.BeaconConfig().GenesisSlot + committeeSlot,
+							BeaconBlockRootHash32: root[:],
+						},
+					},
+				)
+			}
+		}
+
+		if err := chainService.ApplyForkChoiceRule(ctx, block, postState); err != nil {
+			return fmt.Errorf("could not apply fork choice rule at slot %d: %v", slot.SlotNumber, err)
+		}
+	}
+
+	head, err := beaconDB.ChainHead()
+	if err != nil {
+		return fmt.Errorf("could not retrieve chain head: %v", err)
+	}
+	justified, err := beaconDB.JustifiedBlock()
+	if err != nil {
+		return fmt.Errorf("could not retrieve justified block: %v", err)
+	}
+	finalized, err := beaconDB.FinalizedBlock()
+	if err != nil {
+		return fmt.Errorf("could not retrieve finalized block: %v", err)
+	}
+	results := []struct {
+		name   string
+		wanted string
+		block  *pb.BeaconBlock
+	}{
+		{name: "head", wanted: testCase.Results.Head, block: head},
+		{name: "last justified block", wanted: testCase.Results.LastJustifiedBlock, block: justified},
+		{name: "last finalized block", wanted: testCase.Results.LastFinalizedBlock, block: finalized},
+	}
+	for _, result := range results {
+		if result.wanted == "" {
+			continue
+		}
+		wanted, ok := blocks[result.wanted]
+		if !ok {
+			return fmt.Errorf("expected %s %s is not a block of the test case", result.name, result.wanted)
+		}
+		if !proto.Equal(wanted, result.block) {
+			return fmt.Errorf("expected %s %s, received block at slot %d",
+				result.name, result.wanted, result.block.Slot-params.BeaconConfig().GenesisSlot)
 		}
 	}
-	// TODO(#718): Next step is to update and save the blocks specified
-	// in the case case into the DB.
-	//
-	// Then, we call the updateHead routine and confirm the
-	// chain's head is the expected result from the test case.
 	return nil
 }
 
+// initializeForkChoiceDB saves the genesis state and block of the given deposits as the head,
+// justified and finalized state and block, the same way the chain service does at chain start.
+func initializeForkChoiceDB(ctx context.Context, beaconDB db.Database, deposits []*pb.Deposit) (*pb.BeaconState, *pb.BeaconBlock, error) {
+	genesisTime := time.Date(2018, 9, 0, 0, 0, 0, 0, time.UTC).Unix()
+	if err := beaconDB.InitializeState(ctx, uint64(genesisTime), deposits, &pb.Eth1Data{}); err != nil {
+		return nil, nil, fmt.Errorf("could not initialize beacon state to disk: %v", err)
+	}
+	genesisState, err := beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not fetch beacon state: %v", err)
+	}
+	stateRoot, err := hashutil.HashProto(genesisState)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not tree hash state: %v", err)
+	}
+	genesisBlock := b.NewGenesisBlock(stateRoot[:])
+	if err := beaconDB.SaveBlock(genesisBlock); err != nil {
+		return nil, nil, fmt.Errorf("could not save genesis block: %v", err)
+	}
+	if err := beaconDB.UpdateChainHead(ctx, genesisBlock, genesisState); err != nil {
+		return nil, nil, fmt.Errorf("could not set chain head: %v", err)
+	}
+	if err := beaconDB.SaveJustifiedBlock(genesisBlock); err != nil {
+		return nil, nil, fmt.Errorf("could not save genesis block as justified block: %v", err)
+	}
+	if err := beaconDB.SaveFinalizedBlock(genesisBlock); err != nil {
+		return nil, nil, fmt.Errorf("could not save genesis block as finalized block: %v", err)
+	}
+	if err := beaconDB.SaveJustifiedState(genesisState); err != nil {
+		return nil, nil, fmt.Errorf("could not save genesis state as justified state: %v", err)
+	}
+	if err := beaconDB.SaveFinalizedState(genesisState); err != nil {
+		return nil, nil, fmt.Errorf("could not save genesis state as finalized state: %v", err)
+	}
+	return genesisState, genesisBlock, nil
+}
+
 // RunShuffleTest uses validator set specified from a YAML file, runs the validator shuffle
 // algorithm, then compare the output with the expected output from the YAML file.
 func (sb *SimulatedBackend) RunShuffleTest(testCase *ShuffleTestCase) error {
-	defer db.TeardownDB(sb.beaconDB)
 	seed := common.BytesToHash([]byte(testCase.Seed))
 	output, err := utils.ShuffleIndices(seed, testCase.Input)
 	if err != nil {
@@ -209,7 +357,8 @@ func (sb *SimulatedBackend) RunShuffleTest(testCase *ShuffleTestCase) error {
 // slots from a genesis state, with a block being processed at every iteration
 // of the state transition function.
 func (sb *SimulatedBackend) RunStateTransitionTest(testCase *StateTestCase) error {
-	defer db.TeardownDB(sb.beaconDB)
+	defaultConfig := *params.BeaconConfig()
+	defer params.OverrideBeaconConfig(&defaultConfig)
 	setTestConfig(testCase)
 
 	privKeys, err := sb.initializeStateTest(testCase)
@@ -364,6 +513,27 @@ func (sb *SimulatedBackend) compareTestCase(testCase *StateTestCase) error {
 			)
 		}
 	}
+	// A validator is penalized for being slashed by at least the minimum penalty,
+	// on top of the whistleblower reward it already lost.
+	maxPenalizedBalance := params.BeaconConfig().MaxDepositAmount -
+		params.BeaconConfig().MaxDepositAmount/params.BeaconConfig().MinPenaltyQuotient
+	for _, penalized := range testCase.Results.PenalizedValidators {
+		if sb.state.ValidatorBalances[penalized] > maxPenalizedBalance {
+			return fmt.Errorf(
+				"expected validator at index %d to have been penalized, balance %d",
+				penalized,
+				sb.state.ValidatorBalances[penalized],
+			)
+		}
+	}
+	for _, withdrawable := range testCase.Results.WithdrawableValidators {
+		if sb.state.ValidatorRegistry[withdrawable].StatusFlags&pb.Validator_WITHDRAWABLE == 0 {
+			return fmt.Errorf(
+				"expected validator at index %d to be withdrawable",
+				withdrawable,
+			)
+		}
+	}
 	for _, exited := range testCase.Results.ExitedValidators {
 		if sb.state.ValidatorRegistry[exited].StatusFlags != pb.Validator_INITIATED_EXIT {
 			return fmt.Errorf(
@@ -381,6 +551,9 @@ func setTestConfig(testCase *StateTestCase) {
 	c := params.BeaconConfig()
 	c.SlotsPerEpoch = testCase.Config.SlotsPerEpoch
 	c.DepositsForChainStart = testCase.Config.DepositsForChainStart
+	if testCase.Config.LatestSlashedExitLength != 0 {
+		c.LatestSlashedExitLength = testCase.Config.LatestSlashedExitLength
+	}
 	params.OverrideBeaconConfig(c)
 }
 
@@ -1,17 +1,15 @@
 package backend
 
 import (
+	"reflect"
 	"testing"
 
-	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
 func init() {
-	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{
-		EnableCrosslinks: true,
-	})
+	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{})
 }
 
 func TestSimulatedBackendStop_ShutsDown(t *testing.T) {
@@ -24,7 +22,6 @@ func TestSimulatedBackendStop_ShutsDown(t *testing.T) {
 		t.Errorf("Could not successfully shutdown simulated backend %v", err)
 	}
 
-	db.TeardownDB(backend.beaconDB)
 }
 
 func TestGenerateBlockAndAdvanceChain_IncreasesSlot(t *testing.T) {
@@ -38,7 +35,6 @@ func TestGenerateBlockAndAdvanceChain_IncreasesSlot(t *testing.T) {
 		t.Fatalf("Could not set up backend %v", err)
 	}
 	defer backend.Shutdown()
-	defer db.TeardownDB(backend.beaconDB)
 
 	slotLimit := params.BeaconConfig().SlotsPerEpoch + uint64(1)
 
@@ -68,7 +64,6 @@ func TestGenerateNilBlockAndAdvanceChain_IncreasesSlot(t *testing.T) {
 		t.Fatalf("Could not set up backend %v", err)
 	}
 	defer backend.Shutdown()
-	defer db.TeardownDB(backend.beaconDB)
 
 	slotLimit := params.BeaconConfig().SlotsPerEpoch + uint64(1)
 
@@ -83,3 +78,109 @@ func TestGenerateNilBlockAndAdvanceChain_IncreasesSlot(t *testing.T) {
 	}
 
 }
+
+func forkChoiceTestCase(head string) *ForkChoiceTestCase {
+	// Construct the following block tree, where C and D get the most votes:
+	//     /- B
+	// A
+	//     \- C - D
+	return &ForkChoiceTestCase{
+		Config: &ForkChoiceTestConfig{
+			ValidatorCount:   64,
+			CycleLength:      8,
+			ShardCount:       64,
+			MinCommitteeSize: 8,
+		},
+		Slots: []*ForkChoiceTestSlot{
+			{
+				SlotNumber:   1,
+				NewBlock:     &TestBlock{ID: "A", Parent: "*"},
+				Attestations: []*TestAttestation{{Block: "A", ValidatorRegistry: "0-5"}},
+			},
+			{
+				SlotNumber:   2,
+				NewBlock:     &TestBlock{ID: "B", Parent: "A"},
+				Attestations: []*TestAttestation{{Block: "B", ValidatorRegistry: "0-5"}},
+			},
+			{
+				SlotNumber:   3,
+				NewBlock:     &TestBlock{ID: "C", Parent: "A"},
+				Attestations: []*TestAttestation{{Block: "C", ValidatorRegistry: "1-4"}},
+			},
+			{
+				SlotNumber:   4,
+				NewBlock:     &TestBlock{ID: "D", Parent: "C"},
+				Attestations: []*TestAttestation{{Block: "D", ValidatorRegistry: "5, 6"}},
+			},
+		},
+		Results: &ForkChoiceTestResult{
+			Head:               head,
+			LastJustifiedBlock: "*",
+			LastFinalizedBlock: "*",
+		},
+	}
+}
+
+func TestRunForkChoiceTest_OK(t *testing.T) {
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	defer backend.Shutdown()
+
+	if err := backend.RunForkChoiceTest(forkChoiceTestCase("D")); err != nil {
+		t.Errorf("Fork choice test failed: %v", err)
+	}
+}
+
+func TestRunForkChoiceTest_HeadMismatch(t *testing.T) {
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	defer backend.Shutdown()
+
+	want := "expected head B, received block at slot 4"
+	if err := backend.RunForkChoiceTest(forkChoiceTestCase("B")); err == nil || err.Error() != want {
+		t.Errorf("Expected error %q, received %v", want, err)
+	}
+}
+
+func TestRunForkChoiceTest_AttestationsWithoutBlock(t *testing.T) {
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	defer backend.Shutdown()
+
+	// Votes at a slot without a block move the head back to B, while stale votes
+	// for C at an earlier committee slot do not override them.
+	testCase := forkChoiceTestCase("B")
+	testCase.Slots = append(testCase.Slots,
+		&ForkChoiceTestSlot{
+			SlotNumber:   5,
+			Attestations: []*TestAttestation{{Block: "B", ValidatorRegistry: "0-7"}},
+		},
+		&ForkChoiceTestSlot{
+			SlotNumber:   6,
+			Attestations: []*TestAttestation{{Block: "C", ValidatorRegistry: "0-7", CommitteeSlot: 4}},
+		},
+	)
+	if err := backend.RunForkChoiceTest(testCase); err != nil {
+		t.Errorf("Fork choice test failed: %v", err)
+	}
+}
+
+func TestParseValidatorIndices(t *testing.T) {
+	indices, err := parseValidatorIndices("0-2, 5,7 - 8")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []uint64{0, 1, 2, 5, 7, 8}
+	if !reflect.DeepEqual(indices, want) {
+		t.Errorf("Expected indices %v, received %v", want, indices)
+	}
+	if _, err := parseValidatorIndices("3-1"); err == nil {
+		t.Error("Expected invalid range to fail")
+	}
+}
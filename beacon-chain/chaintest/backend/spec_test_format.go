@@ -0,0 +1,62 @@
+package backend
+
+import (
+	"encoding/json"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// SpecTest is a suite of test vectors in the official eth2 spec test format. The
+// runner and handler determine how the test cases are decoded and run.
+type SpecTest struct {
+	// File is the path the suite was loaded from.
+	File          string                   `yaml:"-"`
+	Title         string                   `yaml:"title"`
+	Summary       string                   `yaml:"summary"`
+	ForksTimeline string                   `yaml:"forks_timeline"`
+	Forks         []string                 `yaml:"forks"`
+	Config        string                   `yaml:"config"`
+	Runner        string                   `yaml:"runner"`
+	Handler       string                   `yaml:"handler"`
+	TestCases     []map[string]interface{} `yaml:"test_cases"`
+}
+
+// SpecShufflingTestCase --
+type SpecShufflingTestCase struct {
+	Seed     []byte   `json:"seed"`
+	Count    uint64   `json:"count"`
+	Shuffled []uint64 `json:"shuffled"`
+}
+
+// SpecSSZUintTestCase --
+type SpecSSZUintTestCase struct {
+	Type  string      `json:"type"`
+	Valid bool        `json:"valid"`
+	Value json.Number `json:"value"`
+	SSZ   []byte      `json:"ssz"`
+	Tags  []string    `json:"tags"`
+}
+
+// SpecSSZStaticTestCase holds the expected serialization and root of a container.
+// Its value is decoded separately into the protobuf type of the container.
+type SpecSSZStaticTestCase struct {
+	TypeName   string `json:"type_name"`
+	Serialized []byte `json:"serialized"`
+	Root       []byte `json:"root"`
+}
+
+// SpecStateTestCase is shared by the operations, epoch processing and sanity
+// runners. The operation of operations test cases is keyed by the handler name.
+type SpecStateTestCase struct {
+	Description      string               `json:"description"`
+	BLSSetting       uint64               `json:"bls_setting"`
+	Pre              *pb.BeaconState      `json:"pre"`
+	Post             *pb.BeaconState      `json:"post"`
+	Slots            uint64               `json:"slots"`
+	Blocks           []*pb.BeaconBlock    `json:"blocks"`
+	Deposit          *pb.Deposit          `json:"deposit"`
+	Attestation      *pb.Attestation      `json:"attestation"`
+	AttesterSlashing *pb.AttesterSlashing `json:"attester_slashing"`
+	ProposerSlashing *pb.ProposerSlashing `json:"proposer_slashing"`
+	VoluntaryExit    *pb.VoluntaryExit    `json:"voluntary_exit"`
+}
@@ -0,0 +1,650 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-yaml/yaml"
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/utils"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/ssz"
+)
+
+// errSkipSpecCase is returned by the spec test runners for test cases which
+// cannot be run against this implementation.
+var errSkipSpecCase = errors.New("test case is not supported")
+
+// sszStaticTypes maps the container names used by the ssz_static test vectors to
+// their protobuf types.
+var sszStaticTypes = map[string]func() proto.Message{
+	"Attestation":                  func() proto.Message { return &pb.Attestation{} },
+	"AttestationData":              func() proto.Message { return &pb.AttestationData{} },
+	"AttestationDataAndCustodyBit": func() proto.Message { return &pb.AttestationDataAndCustodyBit{} },
+	"AttesterSlashing":             func() proto.Message { return &pb.AttesterSlashing{} },
+	"BeaconBlock":                  func() proto.Message { return &pb.BeaconBlock{} },
+	"BeaconBlockBody":              func() proto.Message { return &pb.BeaconBlockBody{} },
+	"BeaconState":                  func() proto.Message { return &pb.BeaconState{} },
+	"Crosslink":                    func() proto.Message { return &pb.Crosslink{} },
+	"Deposit":                      func() proto.Message { return &pb.Deposit{} },
+	"DepositData":                  func() proto.Message { return &pb.DepositData{} },
+	"DepositInput":                 func() proto.Message { return &pb.DepositInput{} },
+	"Eth1Data":                     func() proto.Message { return &pb.Eth1Data{} },
+	"Eth1DataVote":                 func() proto.Message { return &pb.Eth1DataVote{} },
+	"Fork":                         func() proto.Message { return &pb.Fork{} },
+	"PendingAttestation":           func() proto.Message { return &pb.PendingAttestation{} },
+	"ProposerSlashing":             func() proto.Message { return &pb.ProposerSlashing{} },
+	"SlashableAttestation":         func() proto.Message { return &pb.SlashableAttestation{} },
+	"Validator":                    func() proto.Message { return &pb.Validator{} },
+	"VoluntaryExit":                func() proto.Message { return &pb.VoluntaryExit{} },
+}
+
+// specFieldNames maps the field names of spec containers to the JSON names of the
+// protobuf fields they are decoded into, where the two differ. Spec fields mapped
+// to an empty name have no counterpart in the protobuf types, so test cases using
+// them are skipped.
+var specFieldNames = map[reflect.Type]map[string]string{
+	reflect.TypeOf(pb.BeaconState{}): {
+		"previous_shuffling_seed":     "previous_shuffling_seed_hash32",
+		"current_shuffling_seed":      "current_shuffling_seed_hash32",
+		"latest_block_roots":          "latest_block_root_hash32s",
+		"latest_index_roots":          "latest_index_root_hash32s",
+		"latest_active_index_roots":   "latest_index_root_hash32s",
+		"batched_block_roots":         "batched_block_root_hash32s",
+		"latest_block_header":         "",
+		"latest_state_roots":          "",
+		"historical_roots":            "",
+		"previous_epoch_attestations": "",
+		"current_epoch_attestations":  "",
+	},
+	reflect.TypeOf(pb.BeaconBlock{}): {
+		"parent_root": "parent_root_hash32",
+		"state_root":  "state_root_hash32",
+	},
+	reflect.TypeOf(pb.BeaconBlockBody{}): {
+		"randao_reveal": "",
+		"eth1_data":     "",
+		"transfers":     "",
+	},
+	reflect.TypeOf(pb.Eth1Data{}): {
+		"deposit_root": "deposit_root_hash32",
+		"block_hash":   "block_hash32",
+	},
+	reflect.TypeOf(pb.AttestationData{}): {
+		"beacon_block_root":    "beacon_block_root_hash32",
+		"epoch_boundary_root":  "epoch_boundary_root_hash32",
+		"crosslink_data_root":  "crosslink_data_root_hash32",
+		"justified_block_root": "justified_block_root_hash32",
+		"source_epoch":         "",
+		"source_root":          "",
+		"target_root":          "",
+		"previous_crosslink":   "",
+	},
+	reflect.TypeOf(pb.Crosslink{}): {
+		"crosslink_data_root": "crosslink_data_root_hash32",
+	},
+	reflect.TypeOf(pb.Validator{}): {
+		"withdrawal_credentials": "withdrawal_credentials_hash32",
+		"withdrawable_epoch":     "withdrawal_epoch",
+		"initiated_exit":         "",
+		"slashed":                "",
+	},
+	reflect.TypeOf(pb.DepositInput{}): {
+		"withdrawal_credentials": "withdrawal_credentials_hash32",
+	},
+	reflect.TypeOf(pb.Deposit{}): {
+		"branch": "merkle_proof_hash32s",
+		"proof":  "merkle_proof_hash32s",
+		"index":  "merkle_tree_index",
+	},
+	reflect.TypeOf(pb.ProposerSlashing{}): {
+		"header_1": "",
+		"header_2": "",
+	},
+}
+
+// SpecTestResult counts the outcome of the test cases of a spec test runner and handler.
+type SpecTestResult struct {
+	Runner   string
+	Handler  string
+	Passed   int
+	Failed   int
+	Skipped  int
+	Failures []string
+}
+
+// LoadSpecTests reads every YAML file under the directory as a spec test suite.
+func LoadSpecTests(dir string) ([]*SpecTest, error) {
+	var tests []*SpecTest
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || (filepath.Ext(path) != ".yaml" && filepath.Ext(path) != ".yml") {
+			return nil
+		}
+		// #nosec G304
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("could not read YAML file: %v", err)
+		}
+		test := &SpecTest{}
+		if err := yaml.Unmarshal(data, test); err != nil {
+			return fmt.Errorf("could not unmarshal YAML file %s into spec test: %v", path, err)
+		}
+		test.File = path
+		tests = append(tests, test)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not load spec tests: %v", err)
+	}
+	return tests, nil
+}
+
+// RunSpecTest runs every test case of the suite and reports how many passed,
+// failed, or were skipped because the runner, handler or config is not supported.
+// Suites of the minimal config fail as a whole, as there is no beacon config
+// matching its constants to run them against.
+func RunSpecTest(test *SpecTest) *SpecTestResult {
+	result := &SpecTestResult{Runner: test.Runner, Handler: test.Handler}
+
+	switch test.Config {
+	case "", "mainnet":
+	case "minimal":
+		result.Failed = len(test.TestCases)
+		result.Failures = append(result.Failures, fmt.Sprintf("%s: the minimal config is not supported, only mainnet test vectors can be run", test.File))
+		return result
+	default:
+		result.Skipped = len(test.TestCases)
+		return result
+	}
+
+	for i, testCase := range test.TestCases {
+		err := runSpecCase(test.Runner, test.Handler, testCase)
+		switch err {
+		case nil:
+			result.Passed++
+		case errSkipSpecCase:
+			result.Skipped++
+		default:
+			result.Failed++
+			name := strconv.Itoa(i)
+			if description, ok := testCase["description"].(string); ok {
+				name = description
+			}
+			result.Failures = append(result.Failures, fmt.Sprintf("%s: case %s: %v", test.File, name, err))
+		}
+	}
+	return result
+}
+
+// MergeSpecTestResults adds up the results of the same runner and handler, sorted
+// by runner and handler.
+func MergeSpecTestResults(results []*SpecTestResult) []*SpecTestResult {
+	merged := make(map[string]*SpecTestResult)
+	for _, result := range results {
+		key := result.Runner + "/" + result.Handler
+		total, ok := merged[key]
+		if !ok {
+			total = &SpecTestResult{Runner: result.Runner, Handler: result.Handler}
+			merged[key] = total
+		}
+		total.Passed += result.Passed
+		total.Failed += result.Failed
+		total.Skipped += result.Skipped
+		total.Failures = append(total.Failures, result.Failures...)
+	}
+	totals := make([]*SpecTestResult, 0, len(merged))
+	for _, total := range merged {
+		totals = append(totals, total)
+	}
+	sort.Slice(totals, func(i, j int) bool {
+		if totals[i].Runner != totals[j].Runner {
+			return totals[i].Runner < totals[j].Runner
+		}
+		return totals[i].Handler < totals[j].Handler
+	})
+	return totals
+}
+
+// runSpecCase dispatches the test case to the runner for its runner and handler.
+// Malformed vectors can make the state transition functions panic, which is
+// reported as a failure of the test case.
+func runSpecCase(runner string, handler string, testCase map[string]interface{}) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	switch {
+	case runner == "shuffling" && handler == "core":
+		return runShufflingCase(testCase)
+	case runner == "ssz_generic" && handler == "uint":
+		return runSSZUintCase(testCase)
+	case runner == "ssz_static" && handler == "core":
+		return runSSZStaticCase(testCase)
+	case runner == "operations":
+		return runOperationCase(handler, testCase)
+	case runner == "epoch_processing":
+		return runEpochProcessingCase(handler, testCase)
+	case runner == "sanity":
+		return runSanityCase(handler, testCase)
+	default:
+		return errSkipSpecCase
+	}
+}
+
+func runShufflingCase(testCase map[string]interface{}) error {
+	c := &SpecShufflingTestCase{}
+	if err := decodeSpecValue(testCase, c); err != nil {
+		return err
+	}
+	indices := make([]uint64, c.Count)
+	for i := range indices {
+		indices[i] = uint64(i)
+	}
+	shuffled, err := utils.ShuffleIndices(common.BytesToHash(c.Seed), indices)
+	if err != nil {
+		return fmt.Errorf("could not shuffle indices: %v", err)
+	}
+	if c.Count == 0 && len(c.Shuffled) == 0 {
+		return nil
+	}
+	if !reflect.DeepEqual(shuffled, c.Shuffled) {
+		return fmt.Errorf("expected shuffled indices %v, received %v", c.Shuffled, shuffled)
+	}
+	return nil
+}
+
+func runSSZUintCase(testCase map[string]interface{}) error {
+	c := &SpecSSZUintTestCase{}
+	if err := decodeSpecValue(testCase, c); err != nil {
+		return err
+	}
+	var typ reflect.Type
+	switch c.Type {
+	case "uint8":
+		typ = reflect.TypeOf(uint8(0))
+	case "uint16":
+		typ = reflect.TypeOf(uint16(0))
+	case "uint32":
+		typ = reflect.TypeOf(uint32(0))
+	case "uint64":
+		typ = reflect.TypeOf(uint64(0))
+	default:
+		return errSkipSpecCase
+	}
+
+	decoded := reflect.New(typ)
+	r := bytes.NewReader(c.SSZ)
+	decodeErr := ssz.Decode(r, decoded.Interface())
+	if decodeErr == nil && r.Len() > 0 {
+		decodeErr = fmt.Errorf("%d trailing bytes", r.Len())
+	}
+	if !c.Valid {
+		if decodeErr == nil {
+			return fmt.Errorf("expected decoding %#x as %s to fail", c.SSZ, c.Type)
+		}
+		return nil
+	}
+	if decodeErr != nil {
+		return fmt.Errorf("could not decode %#x as %s: %v", c.SSZ, c.Type, decodeErr)
+	}
+
+	value, err := strconv.ParseUint(c.Value.String(), 10, typ.Bits())
+	if err != nil {
+		return fmt.Errorf("could not parse %s value %s: %v", c.Type, c.Value, err)
+	}
+	if decoded.Elem().Uint() != value {
+		return fmt.Errorf("expected decoded value %d, received %d", value, decoded.Elem().Uint())
+	}
+	typed := reflect.New(typ).Elem()
+	typed.SetUint(value)
+	encoded := new(bytes.Buffer)
+	if err := ssz.Encode(encoded, typed.Interface()); err != nil {
+		return fmt.Errorf("could not encode %s value %d: %v", c.Type, value, err)
+	}
+	if !bytes.Equal(encoded.Bytes(), c.SSZ) {
+		return fmt.Errorf("expected encoding %#x, received %#x", c.SSZ, encoded.Bytes())
+	}
+	return nil
+}
+
+// runSSZStaticCase checks the serialization and tree hash root of a container.
+// Test cases either name the container in a type_name field, or are keyed by it.
+func runSSZStaticCase(testCase map[string]interface{}) error {
+	fields := testCase
+	if _, ok := testCase["type_name"]; !ok {
+		if len(testCase) != 1 {
+			return errSkipSpecCase
+		}
+		for name, value := range testCase {
+			nested, ok := value.(map[interface{}]interface{})
+			if !ok {
+				return fmt.Errorf("expected fields for type %s, received %T", name, value)
+			}
+			fields = map[string]interface{}{"type_name": name}
+			for key, value := range nested {
+				fields[fmt.Sprint(key)] = value
+			}
+		}
+	}
+	expected := make(map[string]interface{}, len(fields))
+	for key, value := range fields {
+		if key != "value" {
+			expected[key] = value
+		}
+	}
+	c := &SpecSSZStaticTestCase{}
+	if err := decodeSpecValue(expected, c); err != nil {
+		return err
+	}
+	newMessage, ok := sszStaticTypes[c.TypeName]
+	if !ok {
+		return errSkipSpecCase
+	}
+	typeName := c.TypeName
+
+	message := newMessage()
+	if err := decodeSpecValue(fields["value"], message); err != nil {
+		return err
+	}
+	encoded := new(bytes.Buffer)
+	if err := ssz.Encode(encoded, message); err != nil {
+		return fmt.Errorf("could not encode %s: %v", typeName, err)
+	}
+	if !bytes.Equal(encoded.Bytes(), c.Serialized) {
+		return fmt.Errorf("expected %s encoding %#x, received %#x", typeName, c.Serialized, encoded.Bytes())
+	}
+	root, err := ssz.TreeHash(message)
+	if err != nil {
+		return fmt.Errorf("could not tree hash %s: %v", typeName, err)
+	}
+	if !bytes.Equal(root[:], c.Root) {
+		return fmt.Errorf("expected %s root %#x, received %#x", typeName, c.Root, root)
+	}
+	return nil
+}
+
+// The bls_setting of a test case tells whether its signatures are valid and must
+// be verified, or are stubs which must not be.
+const (
+	blsOptional = 0
+	blsRequired = 1
+	blsIgnored  = 2
+)
+
+// verifySignatures returns whether the signatures of the test case are verified
+// to meet its bls_setting. Deposits are processed without verifying their proof
+// of possession, so test cases requiring it to be verified are skipped.
+func verifySignatures(blsSetting uint64, deposits int) (bool, error) {
+	switch blsSetting {
+	case blsOptional:
+		return true, nil
+	case blsRequired:
+		if deposits > 0 {
+			return false, errSkipSpecCase
+		}
+		return true, nil
+	case blsIgnored:
+		return false, nil
+	default:
+		return false, fmt.Errorf("unknown bls_setting %d", blsSetting)
+	}
+}
+
+// runOperationCase processes the single operation of the test case, which is
+// keyed by the handler name, on the pre state.
+func runOperationCase(handler string, testCase map[string]interface{}) error {
+	switch handler {
+	case "deposit", "attestation", "attester_slashing", "proposer_slashing", "voluntary_exit":
+	default:
+		return errSkipSpecCase
+	}
+	c := &SpecStateTestCase{}
+	if err := decodeSpecValue(testCase, c); err != nil {
+		return err
+	}
+	if c.Pre == nil {
+		return errors.New("test case has no pre state")
+	}
+	if testCase[handler] == nil {
+		return fmt.Errorf("test case has no %s", handler)
+	}
+	deposits := 0
+	if handler == "deposit" {
+		deposits = 1
+	}
+	verify, err := verifySignatures(c.BLSSetting, deposits)
+	if err != nil {
+		return err
+	}
+	body := &pb.BeaconBlockBody{}
+	var process func(*pb.BeaconState, *pb.BeaconBlock) (*pb.BeaconState, error)
+	switch handler {
+	case "deposit":
+		body.Deposits = []*pb.Deposit{c.Deposit}
+		process = blocks.ProcessValidatorDeposits
+	case "attestation":
+		body.Attestations = []*pb.Attestation{c.Attestation}
+		process = func(s *pb.BeaconState, b *pb.BeaconBlock) (*pb.BeaconState, error) {
+			return blocks.ProcessBlockAttestations(s, b, verify)
+		}
+	case "attester_slashing":
+		body.AttesterSlashings = []*pb.AttesterSlashing{c.AttesterSlashing}
+		process = func(s *pb.BeaconState, b *pb.BeaconBlock) (*pb.BeaconState, error) {
+			return blocks.ProcessAttesterSlashings(s, b, verify)
+		}
+	case "proposer_slashing":
+		body.ProposerSlashings = []*pb.ProposerSlashing{c.ProposerSlashing}
+		process = func(s *pb.BeaconState, b *pb.BeaconBlock) (*pb.BeaconState, error) {
+			return blocks.ProcessProposerSlashings(s, b, verify)
+		}
+	case "voluntary_exit":
+		body.VoluntaryExits = []*pb.VoluntaryExit{c.VoluntaryExit}
+		process = func(s *pb.BeaconState, b *pb.BeaconBlock) (*pb.BeaconState, error) {
+			return blocks.ProcessValidatorExits(s, b, verify)
+		}
+	}
+	block := &pb.BeaconBlock{Slot: c.Pre.Slot, Body: body}
+	post, err := process(c.Pre, block)
+	return comparePostState(c.Post, post, err)
+}
+
+func runEpochProcessingCase(handler string, testCase map[string]interface{}) error {
+	if handler != "crosslinks" {
+		return errSkipSpecCase
+	}
+	c := &SpecStateTestCase{}
+	if err := decodeSpecValue(testCase, c); err != nil {
+		return err
+	}
+	if c.Pre == nil {
+		return errors.New("test case has no pre state")
+	}
+	post, err := epoch.ProcessCrosslinks(c.Pre, epoch.CurrentAttestations(c.Pre), epoch.PrevAttestations(c.Pre))
+	return comparePostState(c.Post, post, err)
+}
+
+// runSanityCase runs full state transitions, either over a number of empty slots
+// or over a list of blocks, skipping the slots in between them.
+func runSanityCase(handler string, testCase map[string]interface{}) error {
+	if handler != "slots" && handler != "blocks" {
+		return errSkipSpecCase
+	}
+	c := &SpecStateTestCase{}
+	if err := decodeSpecValue(testCase, c); err != nil {
+		return err
+	}
+	if c.Pre == nil {
+		return errors.New("test case has no pre state")
+	}
+	ctx := context.Background()
+	deposits := 0
+	for _, block := range c.Blocks {
+		deposits += len(block.GetBody().GetDeposits())
+	}
+	verify, err := verifySignatures(c.BLSSetting, deposits)
+	if err != nil {
+		return err
+	}
+	config := &state.TransitionConfig{VerifySignatures: verify}
+	beaconState := c.Pre
+	switch handler {
+	case "slots":
+		var headRoot [32]byte
+		if beaconState.LatestBlock != nil {
+			if headRoot, err = hashutil.HashBeaconBlock(beaconState.LatestBlock); err != nil {
+				return fmt.Errorf("could not hash latest block: %v", err)
+			}
+		}
+		for i := uint64(0); i < c.Slots && err == nil; i++ {
+			beaconState, err = state.ExecuteStateTransition(ctx, beaconState, nil, headRoot, config)
+		}
+	case "blocks":
+		for _, block := range c.Blocks {
+			headRoot := bytesutil.ToBytes32(block.ParentRootHash32)
+			for beaconState.Slot+1 < block.Slot && err == nil {
+				beaconState, err = state.ExecuteStateTransition(ctx, beaconState, nil, headRoot, config)
+			}
+			if err != nil {
+				break
+			}
+			beaconState, err = state.ExecuteStateTransition(ctx, beaconState, block, headRoot, config)
+			if err != nil {
+				break
+			}
+		}
+	}
+	return comparePostState(c.Post, beaconState, err)
+}
+
+// comparePostState checks the outcome of a state transition. Test cases without a
+// post state expect the transition to fail.
+func comparePostState(want *pb.BeaconState, received *pb.BeaconState, err error) error {
+	if want == nil {
+		if err == nil {
+			return errors.New("expected state transition to fail")
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not process state transition: %v", err)
+	}
+	if !proto.Equal(want, received) {
+		return errors.New("post state does not match the expected state")
+	}
+	return nil
+}
+
+// decodeSpecValue decodes a YAML value into the struct, matching the snake case
+// keys of the spec to the JSON tags of the struct through specFieldNames. Hex
+// strings are decoded into bytes. Keys which do not match a field of the struct
+// fail the decoding, and keys of spec fields this implementation does not have
+// skip the test case.
+func decodeSpecValue(in interface{}, out interface{}) error {
+	normalized, err := normalizeSpecValue(in, reflect.TypeOf(out))
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(normalized)
+	if err != nil {
+		return fmt.Errorf("could not encode test value: %v", err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(out); err != nil {
+		return fmt.Errorf("could not decode test value into %T: %v", out, err)
+	}
+	return nil
+}
+
+// normalizeSpecValue converts a YAML value into one which encodes to the JSON of
+// the given type, renaming the spec fields of structs on the way.
+func normalizeSpecValue(in interface{}, typ reflect.Type) (interface{}, error) {
+	for typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	switch v := in.(type) {
+	case map[interface{}]interface{}:
+		fields := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			fields[fmt.Sprint(key)] = value
+		}
+		return normalizeSpecFields(fields, typ)
+	case map[string]interface{}:
+		return normalizeSpecFields(v, typ)
+	case []interface{}:
+		var elem reflect.Type
+		if typ != nil && (typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array) {
+			elem = typ.Elem()
+		}
+		normalized := make([]interface{}, len(v))
+		for i, value := range v {
+			n, err := normalizeSpecValue(value, elem)
+			if err != nil {
+				return nil, err
+			}
+			normalized[i] = n
+		}
+		return normalized, nil
+	case string:
+		isBytes := typ == nil || typ.Kind() == reflect.Slice && typ.Elem().Kind() == reflect.Uint8
+		if isBytes && strings.HasPrefix(v, "0x") {
+			if decoded, err := hex.DecodeString(v[2:]); err == nil {
+				return decoded, nil
+			}
+		}
+	}
+	return in, nil
+}
+
+func normalizeSpecFields(fields map[string]interface{}, typ reflect.Type) (map[string]interface{}, error) {
+	fieldTypes := make(map[string]reflect.Type)
+	if typ != nil && typ.Kind() == reflect.Struct {
+		for i := 0; i < typ.NumField(); i++ {
+			name := strings.Split(typ.Field(i).Tag.Get("json"), ",")[0]
+			if name != "" && name != "-" {
+				fieldTypes[name] = typ.Field(i).Type
+			}
+		}
+	}
+	normalized := make(map[string]interface{}, len(fields))
+	for key, value := range fields {
+		name := key
+		if mapped, ok := specFieldNames[typ][key]; ok {
+			if mapped == "" {
+				return nil, errSkipSpecCase
+			}
+			name = mapped
+		}
+		fieldType := fieldTypes[name]
+		if typ != nil && typ.Kind() == reflect.Map {
+			fieldType = typ.Elem()
+		}
+		n, err := normalizeSpecValue(value, fieldType)
+		if err != nil {
+			return nil, err
+		}
+		normalized[name] = n
+	}
+	return normalized, nil
+}
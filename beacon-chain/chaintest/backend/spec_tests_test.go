@@ -0,0 +1,324 @@
+package backend
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-yaml/yaml"
+	"github.com/prysmaticlabs/prysm/beacon-chain/utils"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/ssz"
+)
+
+func decodeSpecTest(t *testing.T, data string) *SpecTest {
+	test := &SpecTest{}
+	if err := yaml.Unmarshal([]byte(data), test); err != nil {
+		t.Fatalf("Could not unmarshal spec test: %v", err)
+	}
+	return test
+}
+
+func TestLoadSpecTests_WalksDirectory(t *testing.T) {
+	dir, err := ioutil.TempDir("", "spectests")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := os.MkdirAll(path.Join(dir, "ssz_generic", "uint"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	data := `
+title: UInt Wrong Length
+summary: Serialized integers that are too short or too long
+forks_timeline: mainnet
+forks: [phase0]
+config: mainnet
+runner: ssz_generic
+handler: uint
+test_cases:
+- {type: uint8, valid: false, ssz: '0x0102', tags: [atomic, uint, wrong_length]}
+`
+	if err := ioutil.WriteFile(path.Join(dir, "ssz_generic", "uint", "uint_wrong_length.yaml"), []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path.Join(dir, "README.md"), []byte("not a test"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests, err := LoadSpecTests(dir)
+	if err != nil {
+		t.Fatalf("Could not load spec tests: %v", err)
+	}
+	if len(tests) != 1 {
+		t.Fatalf("Expected 1 spec test, received %d", len(tests))
+	}
+	test := tests[0]
+	if test.Runner != "ssz_generic" || test.Handler != "uint" || test.Config != "mainnet" {
+		t.Errorf("Unexpected spec test header %+v", test)
+	}
+	if !reflect.DeepEqual(test.Forks, []string{"phase0"}) {
+		t.Errorf("Expected forks [phase0], received %v", test.Forks)
+	}
+	if len(test.TestCases) != 1 {
+		t.Errorf("Expected 1 test case, received %d", len(test.TestCases))
+	}
+}
+
+func TestRunSpecTest_SSZUint(t *testing.T) {
+	test := decodeSpecTest(t, `
+runner: ssz_generic
+handler: uint
+test_cases:
+- {type: uint16, valid: true, value: '258', ssz: '0x0201'}
+- {type: uint64, valid: true, value: 1, ssz: '0x0100000000000000'}
+- {type: uint8, valid: false, ssz: '0x0102'}
+- {type: uint16, valid: true, value: '258', ssz: '0x0102'}
+- {type: uint128, valid: true, value: '1', ssz: '0x01000000000000000000000000000000'}
+`)
+	result := RunSpecTest(test)
+	if result.Passed != 3 || result.Failed != 1 || result.Skipped != 1 {
+		t.Errorf("Expected 3 passed, 1 failed and 1 skipped, received %+v", result)
+	}
+}
+
+func TestRunSpecTest_Shuffling(t *testing.T) {
+	seed := []byte{'A'}
+	shuffled, err := utils.ShuffleIndices(common.BytesToHash(seed), []uint64{0, 1, 2, 3, 4, 5, 6, 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	reversed := make([]uint64, len(shuffled))
+	for i, index := range shuffled {
+		reversed[len(shuffled)-1-i] = index
+	}
+	test := decodeSpecTest(t, fmt.Sprintf(`
+runner: shuffling
+handler: core
+test_cases:
+- {seed: '0x%x', count: 8, shuffled: %v}
+- {seed: '0x%x', count: 8, shuffled: %v}
+`, seed, strings.Join(strings.Fields(fmt.Sprint(shuffled)), ","), seed, strings.Join(strings.Fields(fmt.Sprint(reversed)), ",")))
+	result := RunSpecTest(test)
+	if result.Passed != 1 || result.Failed != 1 {
+		t.Errorf("Expected 1 passed and 1 failed, received %+v", result)
+	}
+}
+
+func TestRunSpecTest_SSZStatic(t *testing.T) {
+	fork := &pb.Fork{PreviousVersion: 1, CurrentVersion: 2, Epoch: 3}
+	encoded := new(bytes.Buffer)
+	if err := ssz.Encode(encoded, fork); err != nil {
+		t.Fatal(err)
+	}
+	root, err := ssz.TreeHash(fork)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test := decodeSpecTest(t, fmt.Sprintf(`
+runner: ssz_static
+handler: core
+test_cases:
+- Fork:
+    value: {previous_version: 1, current_version: 2, epoch: 3}
+    serialized: '0x%x'
+    root: '0x%x'
+- type_name: Fork
+  value: {previous_version: 1, current_version: 2, epoch: 4}
+  serialized: '0x%x'
+  root: '0x%x'
+- UnknownContainer: {value: {}, serialized: '0x', root: '0x'}
+`, encoded.Bytes(), root, encoded.Bytes(), root))
+	result := RunSpecTest(test)
+	if result.Passed != 1 || result.Failed != 1 || result.Skipped != 1 {
+		t.Errorf("Expected 1 passed, 1 failed and 1 skipped, received %+v", result)
+	}
+}
+
+func TestRunSpecTest_OperationWithoutPostStateMustFail(t *testing.T) {
+	test := decodeSpecTest(t, `
+runner: operations
+handler: voluntary_exit
+test_cases:
+- description: unknown validator
+  pre: {slot: 1}
+  voluntary_exit: {epoch: 0, validator_index: 5}
+  post: null
+- description: unknown validator with post state
+  pre: {slot: 1}
+  voluntary_exit: {epoch: 0, validator_index: 5}
+  post: {slot: 1}
+`)
+	test.File = "exits.yaml"
+	result := RunSpecTest(test)
+	if result.Passed != 1 || result.Failed != 1 {
+		t.Fatalf("Expected 1 passed and 1 failed, received %+v", result)
+	}
+	if !strings.HasPrefix(result.Failures[0], "exits.yaml: case unknown validator with post state:") {
+		t.Errorf("Unexpected failure message %q", result.Failures[0])
+	}
+}
+
+func TestRunSpecTest_UnsupportedSkipped(t *testing.T) {
+	for _, data := range []string{
+		"{runner: bls, handler: sign_msg, test_cases: [{}, {}]}",
+		"{config: custom, runner: shuffling, handler: core, test_cases: [{}, {}]}",
+	} {
+		result := RunSpecTest(decodeSpecTest(t, data))
+		if result.Skipped != 2 || result.Passed != 0 || result.Failed != 0 {
+			t.Errorf("Expected 2 skipped test cases, received %+v", result)
+		}
+	}
+}
+
+func TestRunSpecTest_MinimalConfigFails(t *testing.T) {
+	test := decodeSpecTest(t, "{config: minimal, runner: shuffling, handler: core, test_cases: [{}, {}]}")
+	test.File = "shuffle_minimal.yaml"
+	result := RunSpecTest(test)
+	if result.Failed != 2 || result.Passed != 0 || result.Skipped != 0 {
+		t.Fatalf("Expected 2 failed test cases, received %+v", result)
+	}
+	if len(result.Failures) != 1 || !strings.Contains(result.Failures[0], "minimal config is not supported") {
+		t.Errorf("Unexpected failures %v", result.Failures)
+	}
+}
+
+func TestRunSpecTest_BLSSetting(t *testing.T) {
+	test := decodeSpecTest(t, `
+runner: operations
+handler: deposit
+test_cases:
+- description: proof of possession must be verified
+  bls_setting: 1
+  pre: {slot: 1}
+  deposit: {index: 0}
+  post: {slot: 1}
+- description: unknown bls setting
+  bls_setting: 3
+  pre: {slot: 1}
+  deposit: {index: 0}
+  post: {slot: 1}
+`)
+	result := RunSpecTest(test)
+	if result.Skipped != 1 || result.Failed != 1 || result.Passed != 0 {
+		t.Errorf("Expected 1 skipped and 1 failed, received %+v", result)
+	}
+}
+
+func TestVerifySignatures(t *testing.T) {
+	tests := []struct {
+		blsSetting uint64
+		deposits   int
+		verify     bool
+		err        error
+	}{
+		{blsSetting: blsOptional, deposits: 1, verify: true},
+		{blsSetting: blsRequired, verify: true},
+		{blsSetting: blsRequired, deposits: 1, err: errSkipSpecCase},
+		{blsSetting: blsIgnored, deposits: 1, verify: false},
+	}
+	for _, tt := range tests {
+		verify, err := verifySignatures(tt.blsSetting, tt.deposits)
+		if verify != tt.verify || err != tt.err {
+			t.Errorf("verifySignatures(%d, %d) = %v, %v, want %v, %v", tt.blsSetting, tt.deposits, verify, err, tt.verify, tt.err)
+		}
+	}
+}
+
+func TestMergeSpecTestResults_SumsPerHandler(t *testing.T) {
+	results := []*SpecTestResult{
+		{Runner: "sanity", Handler: "slots", Passed: 1, Failed: 1, Failures: []string{"a"}},
+		{Runner: "operations", Handler: "deposit", Passed: 2},
+		{Runner: "sanity", Handler: "slots", Passed: 3, Skipped: 1, Failures: []string{"b"}},
+	}
+	want := []*SpecTestResult{
+		{Runner: "operations", Handler: "deposit", Passed: 2},
+		{Runner: "sanity", Handler: "slots", Passed: 4, Failed: 1, Skipped: 1, Failures: []string{"a", "b"}},
+	}
+	if merged := MergeSpecTestResults(results); !reflect.DeepEqual(merged, want) {
+		t.Errorf("Expected %+v, received %+v", want, merged)
+	}
+}
+
+func TestDecodeSpecValue_HexToBytes(t *testing.T) {
+	value := map[interface{}]interface{}{
+		"slot":               uint64(10),
+		"parent_root_hash32": "0xabcd",
+		"body": map[interface{}]interface{}{
+			"deposits": []interface{}{
+				map[interface{}]interface{}{"merkle_tree_index": 2, "merkle_proof_hash32s": []interface{}{"0x01"}},
+			},
+		},
+	}
+	block := &pb.BeaconBlock{}
+	if err := decodeSpecValue(value, block); err != nil {
+		t.Fatalf("Could not decode block: %v", err)
+	}
+	want := &pb.BeaconBlock{
+		Slot:             10,
+		ParentRootHash32: []byte{0xab, 0xcd},
+		Body: &pb.BeaconBlockBody{
+			Deposits: []*pb.Deposit{{MerkleTreeIndex: 2, MerkleProofHash32S: [][]byte{{0x01}}}},
+		},
+	}
+	if !reflect.DeepEqual(block, want) {
+		t.Errorf("Expected %v, received %v", want, block)
+	}
+}
+
+func TestDecodeSpecValue_RenamesSpecFields(t *testing.T) {
+	value := map[interface{}]interface{}{
+		"slot":        uint64(10),
+		"parent_root": "0xabcd",
+		"state_root":  "0x01",
+		"eth1_data":   map[interface{}]interface{}{"deposit_root": "0x02", "block_hash": "0x03"},
+	}
+	block := &pb.BeaconBlock{}
+	if err := decodeSpecValue(value, block); err != nil {
+		t.Fatalf("Could not decode block: %v", err)
+	}
+	want := &pb.BeaconBlock{
+		Slot:             10,
+		ParentRootHash32: []byte{0xab, 0xcd},
+		StateRootHash32:  []byte{0x01},
+		Eth1Data:         &pb.Eth1Data{DepositRootHash32: []byte{0x02}, BlockHash32: []byte{0x03}},
+	}
+	if !reflect.DeepEqual(block, want) {
+		t.Errorf("Expected %v, received %v", want, block)
+	}
+}
+
+func TestDecodeSpecValue_UnknownFieldFails(t *testing.T) {
+	value := map[interface{}]interface{}{
+		"slot":       uint64(10),
+		"parent_rot": "0xabcd",
+	}
+	err := decodeSpecValue(value, &pb.BeaconBlock{})
+	if err == nil || err == errSkipSpecCase || !strings.Contains(err.Error(), "parent_rot") {
+		t.Errorf("Expected decoding an unknown field to fail, received %v", err)
+	}
+}
+
+func TestRunSpecTest_UnsupportedFieldSkipped(t *testing.T) {
+	test := decodeSpecTest(t, `
+runner: epoch_processing
+handler: crosslinks
+test_cases:
+- description: block header in state
+  pre: {slot: 1, latest_block_header: {slot: 1}}
+  post: {slot: 1, latest_block_header: {slot: 1}}
+- description: unknown field in state
+  pre: {slot: 1, latest_block_headers: {slot: 1}}
+  post: {slot: 1}
+`)
+	result := RunSpecTest(test)
+	if result.Skipped != 1 || result.Failed != 1 || result.Passed != 0 {
+		t.Errorf("Expected 1 skipped and 1 failed, received %+v", result)
+	}
+}
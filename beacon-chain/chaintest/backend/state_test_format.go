@@ -18,16 +18,17 @@ type StateTestCase struct {
 
 // StateTestConfig --
 type StateTestConfig struct {
-	SkipSlots             []uint64                     `yaml:"skip_slots"`
-	DepositSlots          []uint64                     `yaml:"deposit_slots"`
-	Deposits              []*StateTestDeposit          `yaml:"deposits"`
-	ProposerSlashings     []*StateTestProposerSlashing `yaml:"proposer_slashings"`
-	AttesterSlashings     []*StateTestAttesterSlashing `yaml:"attester_slashings"`
-	ValidatorExits        []*StateTestValidatorExit    `yaml:"validator_exits"`
-	SlotsPerEpoch         uint64                       `yaml:"slots_per_epoch"`
-	ShardCount            uint64                       `yaml:"shard_count"`
-	DepositsForChainStart uint64                       `yaml:"deposits_for_chain_start"`
-	NumSlots              uint64                       `yaml:"num_slots"`
+	SkipSlots               []uint64                     `yaml:"skip_slots"`
+	DepositSlots            []uint64                     `yaml:"deposit_slots"`
+	Deposits                []*StateTestDeposit          `yaml:"deposits"`
+	ProposerSlashings       []*StateTestProposerSlashing `yaml:"proposer_slashings"`
+	AttesterSlashings       []*StateTestAttesterSlashing `yaml:"attester_slashings"`
+	ValidatorExits          []*StateTestValidatorExit    `yaml:"validator_exits"`
+	SlotsPerEpoch           uint64                       `yaml:"slots_per_epoch"`
+	ShardCount              uint64                       `yaml:"shard_count"`
+	DepositsForChainStart   uint64                       `yaml:"deposits_for_chain_start"`
+	NumSlots                uint64                       `yaml:"num_slots"`
+	LatestSlashedExitLength uint64                       `yaml:"latest_slashed_exit_length"`
 }
 
 // StateTestDeposit --
@@ -71,8 +72,10 @@ type StateTestValidatorExit struct {
 
 // StateTestResults --
 type StateTestResults struct {
-	Slot              uint64
-	NumValidators     int      `yaml:"num_validators"`
-	SlashedValidators []uint64 `yaml:"slashed_validators"`
-	ExitedValidators  []uint64 `yaml:"exited_validators"`
+	Slot                   uint64
+	NumValidators          int      `yaml:"num_validators"`
+	SlashedValidators      []uint64 `yaml:"slashed_validators"`
+	ExitedValidators       []uint64 `yaml:"exited_validators"`
+	PenalizedValidators    []uint64 `yaml:"penalized_validators"`
+	WithdrawableValidators []uint64 `yaml:"withdrawable_validators"`
 }
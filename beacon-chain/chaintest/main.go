@@ -4,6 +4,7 @@ import (
 	"flag"
 	"fmt"
 	"io/ioutil"
+	"os"
 	"path"
 	"time"
 
@@ -15,9 +16,7 @@ import (
 )
 
 func init() {
-	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{
-		EnableCrosslinks: false,
-	})
+	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{})
 }
 
 func readTestsFromYaml(yamlDir string) ([]interface{}, error) {
@@ -75,11 +74,17 @@ func runTests(tests []interface{}, sb *backend.SimulatedBackend) error {
 			log.Infof("Title: %v", typedTest.Title)
 			log.Infof("Summary: %v", typedTest.Summary)
 			log.Infof("Test Suite: %v", typedTest.TestSuite)
-			for _, testCase := range typedTest.TestCases {
+			// Every case is run so that all the mismatching ones get reported.
+			failed := 0
+			for i, testCase := range typedTest.TestCases {
 				if err := sb.RunForkChoiceTest(testCase); err != nil {
-					return fmt.Errorf("chain test failed: %v", err)
+					log.Errorf("Test case %d FAILED: %v", i, err)
+					failed++
 				}
 			}
+			if failed > 0 {
+				return fmt.Errorf("chain test failed: %d of %d fork choice test cases failed", failed, len(typedTest.TestCases))
+			}
 			log.Info("Test PASSED")
 		case *backend.ShuffleTest:
 			log.Infof("Title: %v", typedTest.Title)
@@ -113,15 +118,68 @@ func runTests(tests []interface{}, sb *backend.SimulatedBackend) error {
 	return nil
 }
 
-func main() {
-	var yamlDir = flag.String("tests-dir", "", "path to directory of yaml tests")
-	flag.Parse()
+// runSpecTests runs every spec test vector under the directory given to the spec
+// subcommand and logs a pass/fail summary per runner and handler.
+func runSpecTests(args []string) error {
+	specFlags := flag.NewFlagSet("spec", flag.ExitOnError)
+	testsDir := specFlags.String("tests-dir", "", "path to directory of spec test vectors")
+	verbose := specFlags.Bool("verbose", false, "log every failing test case")
+	if err := specFlags.Parse(args); err != nil {
+		return err
+	}
 
+	tests, err := backend.LoadSpecTests(*testsDir)
+	if err != nil {
+		return err
+	}
+	var results []*backend.SpecTestResult
+	for _, test := range tests {
+		results = append(results, backend.RunSpecTest(test))
+	}
+
+	var passed, failed, skipped int
+	for _, result := range backend.MergeSpecTestResults(results) {
+		if *verbose {
+			for _, failure := range result.Failures {
+				log.Error(failure)
+			}
+		}
+		log.WithFields(log.Fields{
+			"passed":  result.Passed,
+			"failed":  result.Failed,
+			"skipped": result.Skipped,
+		}).Infof("%s/%s", result.Runner, result.Handler)
+		passed += result.Passed
+		failed += result.Failed
+		skipped += result.Skipped
+	}
+	log.WithFields(log.Fields{
+		"passed":  passed,
+		"failed":  failed,
+		"skipped": skipped,
+	}).Infof("Ran %d spec test suites", len(tests))
+	if failed > 0 {
+		return fmt.Errorf("%d of %d spec test cases failed", failed, passed+failed)
+	}
+	return nil
+}
+
+func main() {
 	customFormatter := new(prefixed.TextFormatter)
 	customFormatter.TimestampFormat = "2006-01-02 15:04:05"
 	customFormatter.FullTimestamp = true
 	log.SetFormatter(customFormatter)
 
+	if len(os.Args) > 1 && os.Args[1] == "spec" {
+		if err := runSpecTests(os.Args[2:]); err != nil {
+			log.Fatalf("Spec tests failed: %v", err)
+		}
+		return
+	}
+
+	var yamlDir = flag.String("tests-dir", "", "path to directory of yaml tests")
+	flag.Parse()
+
 	tests, err := readTestsFromYaml(*yamlDir)
 	if err != nil {
 		log.Fatalf("Fail to load tests from yaml: %v", err)
@@ -331,6 +331,15 @@ func Crosslinks(
 	currentEpoch := helpers.CurrentEpoch(state)
 	startSlot := helpers.StartSlot(prevEpoch)
 	endSlot := helpers.StartSlot(currentEpoch)
+	// There are no previous epoch committees to reward during the genesis epoch.
+	if startSlot >= endSlot {
+		return state, nil
+	}
+
+	winners, err := epoch.WinningCrosslinks(state, thisEpochAttestations, prevEpochAttestations)
+	if err != nil {
+		return nil, fmt.Errorf("could not get winning crosslinks: %v", err)
+	}
 
 	for i := startSlot; i < endSlot; i++ {
 		// RegistryChange is a no-op when requesting slot in current and previous epoch.
@@ -341,31 +350,23 @@ func Crosslinks(
 				i-params.BeaconConfig().GenesisSlot, err)
 		}
 		for _, crosslinkCommittee := range crosslinkCommittees {
-			shard := crosslinkCommittee.Shard
-			committee := crosslinkCommittee.Committee
-			totalAttestingBalance, err :=
-				epoch.TotalAttestingBalance(state, shard, thisEpochAttestations, prevEpochAttestations)
-			if err != nil {
-				return nil,
-					fmt.Errorf("could not get attesting balance for shard committee %d: %v", shard, err)
+			winner, ok := winners[crosslinkCommittee.Shard]
+			if !ok {
+				winner = &epoch.WinningCrosslink{}
 			}
+			attesting := make(map[uint64]bool, len(winner.AttestingIndices))
+			for _, index := range winner.AttestingIndices {
+				attesting[index] = true
+			}
+			committee := crosslinkCommittee.Committee
 			totalBalance := epoch.TotalBalance(state, committee)
 			baseRewardQuotient := helpers.BaseRewardQuotient(totalBalance)
 
-			attestingIndices, err := epoch.AttestingValidators(
-				state,
-				shard,
-				thisEpochAttestations,
-				prevEpochAttestations)
-			if err != nil {
-				return nil,
-					fmt.Errorf("could not get attesting indices for shard committee %d: %v", shard, err)
-			}
 			for _, index := range committee {
 				baseReward := helpers.BaseReward(state, index, baseRewardQuotient)
-				if sliceutil.IsIn(index, attestingIndices) {
+				if attesting[index] {
 					state.ValidatorBalances[index] +=
-						baseReward * totalAttestingBalance / totalBalance
+						baseReward * winner.AttestingBalance / totalBalance
 				} else {
 					state.ValidatorBalances[index] -= baseReward
 				}
@@ -15,6 +15,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/stateutils"
 	v "github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bitutil"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/forkutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
@@ -27,11 +28,46 @@ import (
 // the correct proposer created an incoming beacon block during state
 // transition processing.
 //
-// WIP - this is stubbed out until BLS is integrated into Prysm.
+// Official spec definition for proposer signature verification:
+//   Let block_without_signature_root be the hash_tree_root of block where
+//     block.signature is set to EMPTY_SIGNATURE.
+//   Let proposal_root = hash_tree_root(ProposalSignedData(state.slot, BEACON_CHAIN_SHARD_NUMBER,
+//     block_without_signature_root)).
+//   Verify that bls_verify(pubkey=state.validator_registry[get_beacon_proposer_index(state, state.slot)].pubkey,
+//     message_hash=proposal_root, signature=block.signature,
+//     domain=get_domain(state.fork, get_current_epoch(state), DOMAIN_PROPOSAL)).
 func VerifyProposerSignature(
-	_ *pb.BeaconBlock,
+	beaconState *pb.BeaconState,
+	block *pb.BeaconBlock,
 ) error {
+	proposerIdx, err := helpers.BeaconProposerIndex(beaconState, beaconState.Slot)
+	if err != nil {
+		return fmt.Errorf("could not get beacon proposer index: %v", err)
+	}
+	proposalRoot, err := hashutil.HashProposal(block)
+	if err != nil {
+		return fmt.Errorf("could not hash proposal: %v", err)
+	}
+	domain := forkutil.DomainVersion(beaconState.Fork, helpers.CurrentEpoch(beaconState), params.BeaconConfig().DomainProposal)
+	if err := verifySignature(beaconState, proposerIdx, proposalRoot[:], block.Signature, domain); err != nil {
+		return &SignatureVerificationErr{Operation: ProposalOperation, Err: err}
+	}
+	return nil
+}
 
+// VerifyProposalSignature checks the signature of a block proposed by the given
+// validator against the registry of the state. Unlike VerifyProposerSignature, the
+// state does not need to be at the slot of the block, and the signature domain is
+// taken from the epoch of the block as when the proposal is used in a proposer slashing.
+func VerifyProposalSignature(beaconState *pb.BeaconState, proposerIdx uint64, block *pb.BeaconBlock) error {
+	proposalRoot, err := hashutil.HashProposal(block)
+	if err != nil {
+		return fmt.Errorf("could not hash proposal: %v", err)
+	}
+	domain := forkutil.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(block.Slot), params.BeaconConfig().DomainProposal)
+	if err := verifySignature(beaconState, proposerIdx, proposalRoot[:], block.Signature, domain); err != nil {
+		return &SignatureVerificationErr{Operation: ProposalOperation, Err: err}
+	}
 	return nil
 }
 
@@ -90,7 +126,7 @@ func ProcessBlockRandao(
 	}
 	if verifySignatures {
 		if err := verifyBlockRandao(beaconState, block, proposerIdx, enableLogging); err != nil {
-			return nil, fmt.Errorf("could not verify block randao: %v", err)
+			return nil, &SignatureVerificationErr{Operation: RandaoOperation, Err: err}
 		}
 	}
 	// If block randao passed verification, we XOR the state's latest randao mix with the block's
@@ -170,10 +206,23 @@ func ProcessProposerSlashings(
 		)
 	}
 	var err error
+	var sigBatch *signatureBatch
+	if verifySignatures {
+		sigBatch = newSignatureBatch(ProposerSlashingOperation)
+	}
 	for idx, slashing := range body.ProposerSlashings {
-		if err = verifyProposerSlashing(slashing, verifySignatures); err != nil {
+		if err = verifyProposerSlashing(slashing); err != nil {
 			return nil, fmt.Errorf("could not verify proposer slashing #%d: %v", idx, err)
 		}
+		if verifySignatures {
+			sets, err := proposerSlashingSignatureSets(beaconState, slashing)
+			if err != nil {
+				return nil, &SignatureVerificationErr{Operation: ProposerSlashingOperation, Index: idx, Err: err}
+			}
+			for i, set := range sets {
+				sigBatch.add(idx, fmt.Sprintf("proposal %d", i+1), set)
+			}
+		}
 		proposer := registry[slashing.ProposerIndex]
 		if proposer.SlashedEpoch > helpers.CurrentEpoch(beaconState) {
 			beaconState, err = v.SlashValidator(beaconState, slashing.ProposerIndex)
@@ -183,13 +232,15 @@ func ProcessProposerSlashings(
 			}
 		}
 	}
+	if verifySignatures {
+		if err := sigBatch.verify(); err != nil {
+			return nil, err
+		}
+	}
 	return beaconState, nil
 }
 
-func verifyProposerSlashing(
-	slashing *pb.ProposerSlashing,
-	verifySignatures bool,
-) error {
+func verifyProposerSlashing(slashing *pb.ProposerSlashing) error {
 	// section of block operations.
 	slot1 := slashing.ProposalData_1.Slot
 	slot2 := slashing.ProposalData_2.Slot
@@ -210,12 +261,60 @@ func verifyProposerSlashing(
 	if shard1 != shard2 {
 		return fmt.Errorf("slashing proposal data shards do not match: %d, %d", shard1, shard2)
 	}
-	if !bytes.Equal(root1, root2) {
-		return fmt.Errorf("slashing proposal data block roots do not match: %#x, %#x", root1, root2)
+	if bytes.Equal(root1, root2) {
+		return fmt.Errorf("slashing proposal data block roots are the same: %#x", root1)
 	}
-	if verifySignatures {
-		// TODO(#258): Verify BLS according to the specification in the "Proposer Slashings"
-		return nil
+	return nil
+}
+
+// proposerSlashingSignatureSets returns the signatures of both proposals in a
+// proposer slashing, which must have been made by the slashed proposer.
+func proposerSlashingSignatureSets(
+	beaconState *pb.BeaconState,
+	slashing *pb.ProposerSlashing,
+) ([]*bls.SignatureSet, error) {
+	proposals := []*pb.ProposalSignedData{slashing.ProposalData_1, slashing.ProposalData_2}
+	signatures := [][]byte{slashing.ProposalSignature_1, slashing.ProposalSignature_2}
+	sets := make([]*bls.SignatureSet, len(proposals))
+	for i, proposal := range proposals {
+		proposalRoot, err := hashutil.HashProto(proposal)
+		if err != nil {
+			return nil, fmt.Errorf("could not hash proposal data %d: %v", i+1, err)
+		}
+		domain := forkutil.DomainVersion(
+			beaconState.Fork,
+			helpers.SlotToEpoch(proposal.Slot),
+			params.BeaconConfig().DomainProposal,
+		)
+		sets[i], err = signatureSet(beaconState, slashing.ProposerIndex, proposalRoot[:], signatures[i], domain)
+		if err != nil {
+			return nil, fmt.Errorf("proposal %d: %v", i+1, err)
+		}
+	}
+	return sets, nil
+}
+
+// VerifyProposerSlashing checks whether a proposer slashing, including both of its
+// signatures, could be included in a block on top of the given state and would slash
+// a proposer who has not been slashed yet.
+func VerifyProposerSlashing(beaconState *pb.BeaconState, slashing *pb.ProposerSlashing) error {
+	if err := verifyProposerSlashing(slashing); err != nil {
+		return err
+	}
+	sets, err := proposerSlashingSignatureSets(beaconState, slashing)
+	if err != nil {
+		return err
+	}
+	for i, set := range sets {
+		if !set.Verify() {
+			return &SignatureVerificationErr{
+				Operation: ProposerSlashingOperation,
+				Err:       fmt.Errorf("signature of proposal %d did not verify", i+1),
+			}
+		}
+	}
+	if beaconState.ValidatorRegistry[slashing.ProposerIndex].SlashedEpoch <= helpers.CurrentEpoch(beaconState) {
+		return fmt.Errorf("proposer %d has already been slashed", slashing.ProposerIndex)
 	}
 	return nil
 }
@@ -254,10 +353,23 @@ func ProcessAttesterSlashings(
 			params.BeaconConfig().MaxAttesterSlashings,
 		)
 	}
+	var sigBatch *signatureBatch
+	if verifySignatures {
+		sigBatch = newSignatureBatch(AttesterSlashingOperation)
+	}
 	for idx, slashing := range body.AttesterSlashings {
-		if err := verifyAttesterSlashing(slashing, verifySignatures); err != nil {
+		if err := verifyAttesterSlashing(slashing); err != nil {
 			return nil, fmt.Errorf("could not verify attester slashing #%d: %v", idx, err)
 		}
+		if verifySignatures {
+			sets, err := attesterSlashingSignatureSets(beaconState, slashing)
+			if err != nil {
+				return nil, &SignatureVerificationErr{Operation: AttesterSlashingOperation, Index: idx, Err: err}
+			}
+			for i, set := range sets {
+				sigBatch.add(idx, fmt.Sprintf("slashable attestation %d", i+1), set)
+			}
+		}
 		slashableIndices, err := attesterSlashableIndices(beaconState, slashing)
 		if err != nil {
 			return nil, fmt.Errorf("could not determine validator indices to slash: %v", err)
@@ -270,10 +382,15 @@ func ProcessAttesterSlashings(
 			}
 		}
 	}
+	if verifySignatures {
+		if err := sigBatch.verify(); err != nil {
+			return nil, err
+		}
+	}
 	return beaconState, nil
 }
 
-func verifyAttesterSlashing(slashing *pb.AttesterSlashing, verifySignatures bool) error {
+func verifyAttesterSlashing(slashing *pb.AttesterSlashing) error {
 	slashableAttestation1 := slashing.SlashableAttestation_1
 	slashableAttestation2 := slashing.SlashableAttestation_2
 	data1 := slashableAttestation1.Data
@@ -295,11 +412,10 @@ func verifyAttesterSlashing(slashing *pb.AttesterSlashing, verifySignatures bool
 	if !(isSameTarget || isSurroundVote(data1, data2)) {
 		return errors.New("attester slashing is not a double vote nor surround vote")
 	}
-	if err := verifySlashableAttestation(slashableAttestation1, verifySignatures); err != nil {
-		return fmt.Errorf("could not verify attester slashable attestation data 1: %v", err)
-	}
-	if err := verifySlashableAttestation(slashableAttestation2, verifySignatures); err != nil {
-		return fmt.Errorf("could not verify attester slashable attestation data 2: %v", err)
+	for i, slashableAttestation := range []*pb.SlashableAttestation{slashableAttestation1, slashableAttestation2} {
+		if err := verifySlashableAttestation(slashableAttestation); err != nil {
+			return fmt.Errorf("could not verify attester slashable attestation data %d: %v", i+1, err)
+		}
 	}
 	return nil
 }
@@ -327,10 +443,55 @@ func attesterSlashableIndices(beaconState *pb.BeaconState, slashing *pb.Attester
 	return slashableIndices, nil
 }
 
-func verifySlashableAttestation(att *pb.SlashableAttestation, verifySignatures bool) error {
+// attesterSlashingSignatureSets returns the aggregate signatures of both
+// slashable attestations in an attester slashing.
+func attesterSlashingSignatureSets(
+	beaconState *pb.BeaconState,
+	slashing *pb.AttesterSlashing,
+) ([]*bls.SignatureSet, error) {
+	slashableAttestations := []*pb.SlashableAttestation{
+		slashing.SlashableAttestation_1,
+		slashing.SlashableAttestation_2,
+	}
+	sets := make([]*bls.SignatureSet, len(slashableAttestations))
+	for i, slashableAttestation := range slashableAttestations {
+		set, err := slashableAttestationSignatureSet(beaconState, slashableAttestation)
+		if err != nil {
+			return nil, fmt.Errorf("slashable attestation %d: %v", i+1, err)
+		}
+		sets[i] = set
+	}
+	return sets, nil
+}
+
+// VerifyAttesterSlashing checks whether an attester slashing, including the
+// signatures of both slashable attestations, could be included in a block on top of
+// the given state and would slash at least one validator who has not been slashed yet.
+func VerifyAttesterSlashing(beaconState *pb.BeaconState, slashing *pb.AttesterSlashing) error {
+	if err := verifyAttesterSlashing(slashing); err != nil {
+		return err
+	}
+	sets, err := attesterSlashingSignatureSets(beaconState, slashing)
+	if err != nil {
+		return err
+	}
+	for i, set := range sets {
+		if !set.Verify() {
+			return &SignatureVerificationErr{
+				Operation: AttesterSlashingOperation,
+				Err:       fmt.Errorf("signature of slashable attestation %d did not verify", i+1),
+			}
+		}
+	}
+	_, err = attesterSlashableIndices(beaconState, slashing)
+	return err
+}
+
+func verifySlashableAttestation(att *pb.SlashableAttestation) error {
+	// [TO BE REMOVED IN PHASE 1] Verify that the custody bitfield is all 0s.
 	emptyCustody := make([]byte, len(att.CustodyBitfield))
-	if bytes.Equal(att.CustodyBitfield, emptyCustody) {
-		return errors.New("custody bit field can't all be 0s")
+	if !bytes.Equal(att.CustodyBitfield, emptyCustody) {
+		return fmt.Errorf("custody bit field must be all 0s in phase 0, received %#x", att.CustodyBitfield)
 	}
 	if len(att.ValidatorIndices) == 0 {
 		return errors.New("empty validator indices")
@@ -354,13 +515,80 @@ func verifySlashableAttestation(att *pb.SlashableAttestation, verifySignatures b
 			len(att.ValidatorIndices), params.BeaconConfig().MaxIndicesPerSlashableVote)
 	}
 
-	if verifySignatures {
-		// TODO(#258): Implement BLS verify multiple.
-		return nil
-	}
 	return nil
 }
 
+// slashableAttestationSignatureSet returns the aggregate signature of a
+// slashable attestation, split by the custody bit each validator signed.
+func slashableAttestationSignatureSet(
+	beaconState *pb.BeaconState,
+	att *pb.SlashableAttestation,
+) (*bls.SignatureSet, error) {
+	// Let custody_bit_0_indices = [] and custody_bit_1_indices = [].
+	// For i, validator_index in enumerate(slashable_attestation.validator_indices):
+	//   if get_bitfield_bit(slashable_attestation.custody_bitfield, i) == 0b0, append to custody_bit_0_indices,
+	//   otherwise append to custody_bit_1_indices.
+	// Verify that bls_verify_multiple(
+	//   pubkeys=[
+	//     bls_aggregate_pubkeys([state.validator_registry[i].pubkey for i in custody_bit_0_indices]),
+	//     bls_aggregate_pubkeys([state.validator_registry[i].pubkey for i in custody_bit_1_indices]),
+	//   ],
+	//   message_hashes=[
+	//     hash_tree_root(AttestationDataAndCustodyBit(data=slashable_attestation.data, custody_bit=0b0)),
+	//     hash_tree_root(AttestationDataAndCustodyBit(data=slashable_attestation.data, custody_bit=0b1)),
+	//   ],
+	//   signature=slashable_attestation.aggregate_signature,
+	//   domain=get_domain(state.fork, slot_to_epoch(vote_data.data.slot), DOMAIN_ATTESTATION),
+	// )
+	var custodyBit0Indices []uint64
+	var custodyBit1Indices []uint64
+	for i, validatorIndex := range att.ValidatorIndices {
+		bitSet, err := bitutil.CheckBit(att.CustodyBitfield, i)
+		if err != nil {
+			return nil, fmt.Errorf("could not get custody bit of validator %d: %v", validatorIndex, err)
+		}
+		if bitSet {
+			custodyBit1Indices = append(custodyBit1Indices, validatorIndex)
+		} else {
+			custodyBit0Indices = append(custodyBit0Indices, validatorIndex)
+		}
+	}
+	return attestationDataSignatureSet(
+		beaconState, att.Data, custodyBit0Indices, custodyBit1Indices, att.AggregateSignature,
+	)
+}
+
+// attestationDataSignatureSet returns the aggregate signature of validators
+// attesting to the given data, split by the custody bit each of them signed.
+func attestationDataSignatureSet(
+	beaconState *pb.BeaconState,
+	data *pb.AttestationData,
+	custodyBit0Indices []uint64,
+	custodyBit1Indices []uint64,
+	signature []byte,
+) (*bls.SignatureSet, error) {
+	custodyBit0Root, err := hashutil.HashAttestationDataAndCustodyBit(data, false)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash attestation data with custody bit 0: %v", err)
+	}
+	custodyBit1Root, err := hashutil.HashAttestationDataAndCustodyBit(data, true)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash attestation data with custody bit 1: %v", err)
+	}
+	domain := forkutil.DomainVersion(
+		beaconState.Fork,
+		helpers.SlotToEpoch(data.Slot),
+		params.BeaconConfig().DomainAttestation,
+	)
+	return aggregateSignatureSet(
+		beaconState,
+		[][]uint64{custodyBit0Indices, custodyBit1Indices},
+		[][32]byte{custodyBit0Root, custodyBit1Root},
+		signature,
+		domain,
+	)
+}
+
 // isSurroundVote checks if attestation 1's source epoch is smaller than attestation 2
 // while simultaneously checking if its target epoch is greater than that of attestation 2.
 // This is a Casper FFG slashing condition. This is known as "surrounding" a vote
@@ -408,10 +636,21 @@ func ProcessBlockAttestations(
 		)
 	}
 
+	var sigBatch *signatureBatch
+	if verifySignatures {
+		sigBatch = newSignatureBatch(AttestationOperation)
+	}
 	for idx, attestation := range atts {
-		if err := verifyAttestation(beaconState, attestation, verifySignatures); err != nil {
+		if err := verifyAttestation(beaconState, attestation); err != nil {
 			return nil, fmt.Errorf("could not verify attestation at index %d in block: %v", idx, err)
 		}
+		if verifySignatures {
+			set, err := attestationSignatureSet(beaconState, attestation)
+			if err != nil {
+				return nil, &SignatureVerificationErr{Operation: AttestationOperation, Index: idx, Err: err}
+			}
+			sigBatch.add(idx, "", set)
+		}
 		beaconState.LatestAttestations = append(beaconState.LatestAttestations, &pb.PendingAttestation{
 			Data:                attestation.Data,
 			AggregationBitfield: attestation.AggregationBitfield,
@@ -419,11 +658,16 @@ func ProcessBlockAttestations(
 			InclusionSlot:       beaconState.Slot,
 		})
 	}
+	if verifySignatures {
+		if err := sigBatch.verify(); err != nil {
+			return nil, err
+		}
+	}
 
 	return beaconState, nil
 }
 
-func verifyAttestation(beaconState *pb.BeaconState, att *pb.Attestation, verifySignatures bool) error {
+func verifyAttestation(beaconState *pb.BeaconState, att *pb.Attestation) error {
 	if att.Data.Slot < params.BeaconConfig().GenesisSlot {
 		return fmt.Errorf(
 			"attestation slot (slot %d) less than genesis slot (%d)",
@@ -513,21 +757,64 @@ func verifyAttestation(beaconState *pb.BeaconState, att *pb.Attestation, verifyS
 			att.Data.CrosslinkDataRootHash32,
 		)
 	}
-	if verifySignatures {
-		// TODO(#258): Integrate BLS signature verification for attestation.
-		// assert bls_verify_multiple(
-		//   pubkeys=[
-		//	 bls_aggregate_pubkeys([state.validator_registry[i].pubkey for i in custody_bit_0_participants]),
-		//   bls_aggregate_pubkeys([state.validator_registry[i].pubkey for i in custody_bit_1_participants]),
-		//   ],
-		//   message_hash=[
-		//   hash_tree_root(AttestationDataAndCustodyBit(data=attestation.data, custody_bit=0b0)),
-		//   hash_tree_root(AttestationDataAndCustodyBit(data=attestation.data, custody_bit=0b1)),
-		//   ],
-		//   signature=attestation.aggregate_signature,
-		//   domain=get_domain(state.fork, slot_to_epoch(attestation.data.slot), DOMAIN_ATTESTATION),
-		// )
-		return nil
+	return nil
+}
+
+// attestationSignatureSet returns the aggregate signature of an attestation,
+// split by the custody bit each participant signed.
+func attestationSignatureSet(beaconState *pb.BeaconState, att *pb.Attestation) (*bls.SignatureSet, error) {
+	// Let participants = get_attestation_participants(state, attestation.data, attestation.aggregation_bitfield).
+	// Let custody_bit_1_participants = get_attestation_participants(state, attestation.data,
+	//   attestation.custody_bitfield).
+	// Let custody_bit_0_participants = [i in participants for i not in custody_bit_1_participants].
+	//
+	// assert bls_verify_multiple(
+	//   pubkeys=[
+	//	 bls_aggregate_pubkeys([state.validator_registry[i].pubkey for i in custody_bit_0_participants]),
+	//   bls_aggregate_pubkeys([state.validator_registry[i].pubkey for i in custody_bit_1_participants]),
+	//   ],
+	//   message_hash=[
+	//   hash_tree_root(AttestationDataAndCustodyBit(data=attestation.data, custody_bit=0b0)),
+	//   hash_tree_root(AttestationDataAndCustodyBit(data=attestation.data, custody_bit=0b1)),
+	//   ],
+	//   signature=attestation.aggregate_signature,
+	//   domain=get_domain(state.fork, slot_to_epoch(attestation.data.slot), DOMAIN_ATTESTATION),
+	// )
+	participants, err := helpers.AttestationParticipants(beaconState, att.Data, att.AggregationBitfield)
+	if err != nil {
+		return nil, fmt.Errorf("could not get attestation participants: %v", err)
+	}
+	custodyBit1Participants, err := helpers.AttestationParticipants(beaconState, att.Data, att.CustodyBitfield)
+	if err != nil {
+		return nil, fmt.Errorf("could not get custody bit 1 participants: %v", err)
+	}
+	custodyBit1 := make(map[uint64]bool, len(custodyBit1Participants))
+	for _, idx := range custodyBit1Participants {
+		custodyBit1[idx] = true
+	}
+	var custodyBit0Participants []uint64
+	for _, idx := range participants {
+		if !custodyBit1[idx] {
+			custodyBit0Participants = append(custodyBit0Participants, idx)
+		}
+	}
+	return attestationDataSignatureSet(
+		beaconState, att.Data, custodyBit0Participants, custodyBit1Participants, att.AggregateSignature,
+	)
+}
+
+// VerifyAttestationSignature checks the aggregate signature of an attestation
+// against the public keys of its participants in the registry of the state.
+func VerifyAttestationSignature(beaconState *pb.BeaconState, att *pb.Attestation) error {
+	set, err := attestationSignatureSet(beaconState, att)
+	if err != nil {
+		return &SignatureVerificationErr{Operation: AttestationOperation, Err: err}
+	}
+	if !set.Verify() {
+		return &SignatureVerificationErr{
+			Operation: AttestationOperation,
+			Err:       errors.New("signature did not verify"),
+		}
 	}
 	return nil
 }
@@ -660,18 +947,41 @@ func ProcessValidatorExits(
 		)
 	}
 
+	var sigBatch *signatureBatch
+	if verifySignatures {
+		sigBatch = newSignatureBatch(VoluntaryExitOperation)
+	}
 	validatorRegistry := beaconState.ValidatorRegistry
 	for idx, exit := range exits {
-		if err := verifyExit(beaconState, exit, verifySignatures); err != nil {
+		if err := verifyExit(beaconState, exit); err != nil {
 			return nil, fmt.Errorf("could not verify exit #%d: %v", idx, err)
 		}
+		if verifySignatures {
+			set, err := exitSignatureSet(beaconState, exit)
+			if err != nil {
+				return nil, &SignatureVerificationErr{Operation: VoluntaryExitOperation, Index: idx, Err: err}
+			}
+			sigBatch.add(idx, "", set)
+		}
 		beaconState = v.InitiateValidatorExit(beaconState, exit.ValidatorIndex)
 	}
+	if verifySignatures {
+		if err := sigBatch.verify(); err != nil {
+			return nil, err
+		}
+	}
 	beaconState.ValidatorRegistry = validatorRegistry
 	return beaconState, nil
 }
 
-func verifyExit(beaconState *pb.BeaconState, exit *pb.VoluntaryExit, verifySignatures bool) error {
+func verifyExit(beaconState *pb.BeaconState, exit *pb.VoluntaryExit) error {
+	if exit.ValidatorIndex >= uint64(len(beaconState.ValidatorRegistry)) {
+		return fmt.Errorf(
+			"validator index %d is out of range, registry size is %d",
+			exit.ValidatorIndex,
+			len(beaconState.ValidatorRegistry),
+		)
+	}
 	validator := beaconState.ValidatorRegistry[exit.ValidatorIndex]
 	currentEpoch := helpers.CurrentEpoch(beaconState)
 	entryExitEffectEpoch := helpers.EntryExitEffectEpoch(currentEpoch)
@@ -689,14 +999,38 @@ func verifyExit(beaconState *pb.BeaconState, exit *pb.VoluntaryExit, verifySigna
 			exit.Epoch,
 		)
 	}
-	if verifySignatures {
-		// TODO(#258): Verify using BLS signature verification below:
-		// Let exit_message = hash_tree_root(
-		//   Exit(epoch=exit.epoch, validator_index=exit.validator_index, signature=EMPTY_SIGNATURE)
-		// )
-		// Verify that bls_verify(pubkey=validator.pubkey, message_hash=exit_message,
-		//   signature=exit.signature, domain=get_domain(state.fork, exit.epoch, DOMAIN_EXIT)).
-		return nil
+	return nil
+}
+
+// VerifyExit checks whether a voluntary exit, including its signature, could be
+// included in a block on top of the given state. It lets exits received outside
+// of blocks be validated before they are added to the operations pool.
+func VerifyExit(beaconState *pb.BeaconState, exit *pb.VoluntaryExit) error {
+	if err := verifyExit(beaconState, exit); err != nil {
+		return err
+	}
+	set, err := exitSignatureSet(beaconState, exit)
+	if err != nil {
+		return err
+	}
+	if !set.Verify() {
+		return &SignatureVerificationErr{Operation: VoluntaryExitOperation, Err: errors.New("signature did not verify")}
 	}
 	return nil
 }
+
+// exitSignatureSet returns the signature of a voluntary exit, which must have
+// been made by the exiting validator.
+func exitSignatureSet(beaconState *pb.BeaconState, exit *pb.VoluntaryExit) (*bls.SignatureSet, error) {
+	// Let exit_message = hash_tree_root(
+	//   Exit(epoch=exit.epoch, validator_index=exit.validator_index, signature=EMPTY_SIGNATURE)
+	// )
+	// Verify that bls_verify(pubkey=validator.pubkey, message_hash=exit_message,
+	//   signature=exit.signature, domain=get_domain(state.fork, exit.epoch, DOMAIN_EXIT)).
+	exitMessage, err := hashutil.HashVoluntaryExit(exit)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash exit: %v", err)
+	}
+	domain := forkutil.DomainVersion(beaconState.Fork, exit.Epoch, params.BeaconConfig().DomainExit)
+	return signatureSet(beaconState, exit.ValidatorIndex, exitMessage[:], exit.Signature, domain)
+}
@@ -286,7 +286,7 @@ func TestProcessProposerSlashings_UnmatchedShards(t *testing.T) {
 	}
 }
 
-func TestProcessProposerSlashings_UnmatchedBlockRoots(t *testing.T) {
+func TestProcessProposerSlashings_SameBlockRoots(t *testing.T) {
 	registry := []*pb.Validator{}
 	currentSlot := uint64(0)
 	slashings := []*pb.ProposerSlashing{
@@ -300,7 +300,7 @@ func TestProcessProposerSlashings_UnmatchedBlockRoots(t *testing.T) {
 			ProposalData_2: &pb.ProposalSignedData{
 				Slot:            1,
 				Shard:           0,
-				BlockRootHash32: []byte{1, 1, 0},
+				BlockRootHash32: []byte{0, 1, 0},
 			},
 		},
 	}
@@ -314,10 +314,7 @@ func TestProcessProposerSlashings_UnmatchedBlockRoots(t *testing.T) {
 			ProposerSlashings: slashings,
 		},
 	}
-	want := fmt.Sprintf(
-		"slashing proposal data block roots do not match: %#x, %#x",
-		[]byte{0, 1, 0}, []byte{1, 1, 0},
-	)
+	want := fmt.Sprintf("slashing proposal data block roots are the same: %#x", []byte{0, 1, 0})
 
 	if _, err := blocks.ProcessProposerSlashings(
 		beaconState,
@@ -356,7 +353,7 @@ func TestProcessProposerSlashings_AppliesCorrectStatus(t *testing.T) {
 			ProposalData_2: &pb.ProposalSignedData{
 				Slot:            params.BeaconConfig().GenesisSlot + 1,
 				Shard:           1,
-				BlockRootHash32: []byte{0, 1, 0},
+				BlockRootHash32: []byte{1, 1, 0},
 			},
 		},
 	}
@@ -418,7 +415,7 @@ func TestProcessAttesterSlashings_ThresholdReached(t *testing.T) {
 	}
 }
 
-func TestProcessAttesterSlashings_EmptyCustodyFields(t *testing.T) {
+func TestProcessAttesterSlashings_NonZeroCustodyFields(t *testing.T) {
 	slashings := []*pb.AttesterSlashing{
 		{
 			SlashableAttestation_1: &pb.SlashableAttestation{
@@ -426,20 +423,16 @@ func TestProcessAttesterSlashings_EmptyCustodyFields(t *testing.T) {
 					Slot:  5,
 					Shard: 4,
 				},
-				ValidatorIndices: make(
-					[]uint64,
-					params.BeaconConfig().MaxIndicesPerSlashableVote,
-				),
+				ValidatorIndices: []uint64{1},
+				CustodyBitfield:  []byte{0x80},
 			},
 			SlashableAttestation_2: &pb.SlashableAttestation{
 				Data: &pb.AttestationData{
 					Slot:  5,
 					Shard: 3,
 				},
-				ValidatorIndices: make(
-					[]uint64,
-					params.BeaconConfig().MaxIndicesPerSlashableVote,
-				),
+				ValidatorIndices: []uint64{1},
+				CustodyBitfield:  []byte{0x00},
 			},
 		},
 	}
@@ -455,55 +448,25 @@ func TestProcessAttesterSlashings_EmptyCustodyFields(t *testing.T) {
 			AttesterSlashings: slashings,
 		},
 	}
-	want := fmt.Sprint("custody bit field can't all be 0")
+	want := "could not verify attester slashable attestation data 1: custody bit field must be all 0s in phase 0"
 
 	if _, err := blocks.ProcessAttesterSlashings(
 		beaconState,
 		block,
 		false,
-	); !strings.Contains(err.Error(), want) {
+	); err == nil || !strings.Contains(err.Error(), want) {
 		t.Errorf("Expected %s, received %v", want, err)
 	}
 
-	// Perform the same check for SlashableVoteData_2.
-	slashings = []*pb.AttesterSlashing{
-		{
-			SlashableAttestation_1: &pb.SlashableAttestation{
-				Data: &pb.AttestationData{
-					Slot:  5,
-					Shard: 4,
-				},
-				ValidatorIndices: make(
-					[]uint64,
-					params.BeaconConfig().MaxIndicesPerSlashableVote,
-				),
-			},
-			SlashableAttestation_2: &pb.SlashableAttestation{
-				Data: &pb.AttestationData{
-					Slot:  5,
-					Shard: 3,
-				},
-				ValidatorIndices: make(
-					[]uint64,
-					params.BeaconConfig().MaxIndicesPerSlashableVote,
-				),
-			},
-		},
-	}
-	beaconState = &pb.BeaconState{
-		ValidatorRegistry: registry,
-		Slot:              currentSlot,
-	}
-	block = &pb.BeaconBlock{
-		Body: &pb.BeaconBlockBody{
-			AttesterSlashings: slashings,
-		},
-	}
+	// Perform the same check for SlashableAttestation_2.
+	slashings[0].SlashableAttestation_1.CustodyBitfield = []byte{0x00}
+	slashings[0].SlashableAttestation_2.CustodyBitfield = []byte{0x80}
+	want = "could not verify attester slashable attestation data 2: custody bit field must be all 0s in phase 0"
 	if _, err := blocks.ProcessAttesterSlashings(
 		beaconState,
 		block,
 		false,
-	); !strings.Contains(err.Error(), want) {
+	); err == nil || !strings.Contains(err.Error(), want) {
 		t.Errorf("Expected %s, received %v", want, err)
 	}
 }
@@ -517,12 +480,12 @@ func TestProcessAttesterSlashings_UnmatchedAttestations(t *testing.T) {
 			SlashableAttestation_1: &pb.SlashableAttestation{
 				Data:             att1,
 				ValidatorIndices: []uint64{1},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 			SlashableAttestation_2: &pb.SlashableAttestation{
 				Data:             att1,
 				ValidatorIndices: []uint64{2},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 		},
 	}
@@ -567,12 +530,12 @@ func TestProcessAttesterSlashings_EmptyVoteIndexIntersection(t *testing.T) {
 			SlashableAttestation_1: &pb.SlashableAttestation{
 				Data:             att1,
 				ValidatorIndices: []uint64{1, 2, 3, 4, 5, 6, 7, 8},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 			SlashableAttestation_2: &pb.SlashableAttestation{
 				Data:             att2,
 				ValidatorIndices: []uint64{9, 10, 11, 12, 13, 14, 15, 16},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 		},
 	}
@@ -628,12 +591,12 @@ func TestProcessAttesterSlashings_AppliesCorrectStatus(t *testing.T) {
 			SlashableAttestation_1: &pb.SlashableAttestation{
 				Data:             att1,
 				ValidatorIndices: []uint64{1, 2, 3, 4, 5, 6, 7, 8},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 			SlashableAttestation_2: &pb.SlashableAttestation{
 				Data:             att2,
 				ValidatorIndices: []uint64{1, 2, 3, 4, 5, 6, 7, 8},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 		},
 	}
@@ -0,0 +1,182 @@
+package blocks
+
+import (
+	"errors"
+	"fmt"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+)
+
+// Block operations carrying a signature, as reported by SignatureVerificationErr.
+const (
+	ProposalOperation         = "block proposal"
+	RandaoOperation           = "randao reveal"
+	ProposerSlashingOperation = "proposer slashing"
+	AttesterSlashingOperation = "attester slashing"
+	AttestationOperation      = "attestation"
+	VoluntaryExitOperation    = "voluntary exit"
+)
+
+// SignatureVerificationErr is returned when a BLS signature carried by a block,
+// or by one of the operations in its body, fails verification. Operation names
+// the kind of operation and Index its position within the block body. Index is
+// always 0 for the signatures of the block itself.
+type SignatureVerificationErr struct {
+	Operation string
+	Index     int
+	Err       error
+}
+
+func (e *SignatureVerificationErr) Error() string {
+	return fmt.Sprintf("invalid %s signature at index %d: %v", e.Operation, e.Index, e.Err)
+}
+
+// verifySignature checks a signature from a single validator in the registry.
+func verifySignature(
+	beaconState *pb.BeaconState,
+	validatorIndex uint64,
+	msg []byte,
+	signature []byte,
+	domain uint64,
+) error {
+	set, err := signatureSet(beaconState, validatorIndex, msg, signature, domain)
+	if err != nil {
+		return err
+	}
+	if !set.Verify() {
+		return errors.New("signature did not verify")
+	}
+	return nil
+}
+
+// signatureSet prepares a signature from a single validator in the registry for
+// verification.
+func signatureSet(
+	beaconState *pb.BeaconState,
+	validatorIndex uint64,
+	msg []byte,
+	signature []byte,
+	domain uint64,
+) (*bls.SignatureSet, error) {
+	if validatorIndex >= uint64(len(beaconState.ValidatorRegistry)) {
+		return nil, fmt.Errorf("validator index %d out of range", validatorIndex)
+	}
+	pub, err := bls.PublicKeyFromBytes(beaconState.ValidatorRegistry[validatorIndex].Pubkey)
+	if err != nil {
+		return nil, fmt.Errorf("could not deserialize validator public key: %v", err)
+	}
+	sig, err := bls.SignatureFromBytes(signature)
+	if err != nil {
+		return nil, fmt.Errorf("could not deserialize signature: %v", err)
+	}
+	return &bls.SignatureSet{
+		Signature:  sig,
+		PublicKeys: []*bls.PublicKey{pub},
+		Messages:   [][]byte{msg},
+		Domain:     domain,
+	}, nil
+}
+
+// aggregateSignatureSet prepares an aggregate signature where every validator in
+// indexGroups[i] signed msgs[i], mirroring bls_verify_multiple over the aggregated
+// public keys of each group. Empty groups are skipped as they contribute nothing
+// to the aggregate.
+func aggregateSignatureSet(
+	beaconState *pb.BeaconState,
+	indexGroups [][]uint64,
+	msgs [][32]byte,
+	signature []byte,
+	domain uint64,
+) (*bls.SignatureSet, error) {
+	var pubKeys []*bls.PublicKey
+	var messages [][]byte
+	for i, indices := range indexGroups {
+		if len(indices) == 0 {
+			continue
+		}
+		pub, err := aggregatePublicKeys(beaconState, indices)
+		if err != nil {
+			return nil, err
+		}
+		pubKeys = append(pubKeys, pub)
+		messages = append(messages, msgs[i][:])
+	}
+	if len(pubKeys) == 0 {
+		return nil, errors.New("no participants to verify the aggregate signature against")
+	}
+	sig, err := bls.SignatureFromBytes(signature)
+	if err != nil {
+		return nil, fmt.Errorf("could not deserialize aggregate signature: %v", err)
+	}
+	return &bls.SignatureSet{
+		Signature:  sig,
+		PublicKeys: pubKeys,
+		Messages:   messages,
+		Domain:     domain,
+	}, nil
+}
+
+// aggregatePublicKeys returns the aggregate public key of the given validators.
+func aggregatePublicKeys(beaconState *pb.BeaconState, indices []uint64) (*bls.PublicKey, error) {
+	var aggregate *bls.PublicKey
+	for _, idx := range indices {
+		if idx >= uint64(len(beaconState.ValidatorRegistry)) {
+			return nil, fmt.Errorf("validator index %d out of range", idx)
+		}
+		pub, err := bls.PublicKeyFromBytes(beaconState.ValidatorRegistry[idx].Pubkey)
+		if err != nil {
+			return nil, fmt.Errorf("could not deserialize public key of validator %d: %v", idx, err)
+		}
+		if aggregate == nil {
+			aggregate = pub
+			continue
+		}
+		aggregate = aggregate.Aggregate(pub)
+	}
+	return aggregate, nil
+}
+
+// signatureBatch collects the signatures of one kind of block operation while
+// the operations are processed, so they can all be verified in parallel at the
+// end. It remembers where each signature came from so a failure can still be
+// reported against the offending operation.
+type signatureBatch struct {
+	operation string
+	batch     *bls.SignatureBatch
+	indices   []int
+	contexts  []string
+}
+
+func newSignatureBatch(operation string) *signatureBatch {
+	return &signatureBatch{
+		operation: operation,
+		batch:     bls.NewSignatureBatch(),
+	}
+}
+
+// add queues a signature of the operation at the given index in the block body.
+// The context, if any, describes which of the operation's signatures it is.
+func (b *signatureBatch) add(index int, context string, set *bls.SignatureSet) {
+	b.batch.Add(set)
+	b.indices = append(b.indices, index)
+	b.contexts = append(b.contexts, context)
+}
+
+// verify checks every queued signature and returns a SignatureVerificationErr
+// for the first operation in the block body whose signature is invalid.
+func (b *signatureBatch) verify() error {
+	valid, failed := b.batch.Verify()
+	if valid {
+		return nil
+	}
+	err := errors.New("signature did not verify")
+	if b.contexts[failed] != "" {
+		err = fmt.Errorf("%s: %v", b.contexts[failed], err)
+	}
+	return &SignatureVerificationErr{
+		Operation: b.operation,
+		Index:     b.indices[failed],
+		Err:       err,
+	}
+}
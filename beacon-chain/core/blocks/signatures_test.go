@@ -0,0 +1,368 @@
+package blocks_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func init() {
+	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{
+		EnableCommitteesCache: false,
+	})
+}
+
+func setupSignatureState(t *testing.T) (*pb.BeaconState, []*bls.SecretKey) {
+	deposits, privKeys := setupInitialDeposits(t, 100)
+	beaconState, err := state.GenesisBeaconState(deposits, uint64(0), &pb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return beaconState, privKeys
+}
+
+func assertSignatureErr(t *testing.T, err error, operation string, index int) {
+	sigErr, ok := err.(*blocks.SignatureVerificationErr)
+	if !ok {
+		t.Fatalf("Expected signature verification error, received %v", err)
+	}
+	if sigErr.Operation != operation {
+		t.Errorf("Expected failing operation %s, received %s", operation, sigErr.Operation)
+	}
+	if sigErr.Index != index {
+		t.Errorf("Expected failing index %d, received %d", index, sigErr.Index)
+	}
+}
+
+func TestVerifyProposerSignature(t *testing.T) {
+	beaconState, privKeys := setupSignatureState(t)
+	proposerIdx, err := helpers.BeaconProposerIndex(beaconState, beaconState.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block := &pb.BeaconBlock{Slot: beaconState.Slot}
+	proposalRoot, err := hashutil.HashProposal(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := forkutil.DomainVersion(beaconState.Fork, helpers.CurrentEpoch(beaconState), params.BeaconConfig().DomainProposal)
+
+	block.Signature = privKeys[proposerIdx].Sign(proposalRoot[:], domain).Marshal()
+	if err := blocks.VerifyProposerSignature(beaconState, block); err != nil {
+		t.Errorf("Expected proposer signature to verify, received %v", err)
+	}
+
+	block.Signature = privKeys[proposerIdx+1].Sign(proposalRoot[:], domain).Marshal()
+	err = blocks.VerifyProposerSignature(beaconState, block)
+	assertSignatureErr(t, err, blocks.ProposalOperation, 0)
+}
+
+func TestProcessProposerSlashings_InvalidSignature(t *testing.T) {
+	beaconState, privKeys := setupSignatureState(t)
+	proposalData := &pb.ProposalSignedData{
+		Slot:            params.BeaconConfig().GenesisSlot,
+		Shard:           params.BeaconConfig().BeaconChainShardNumber,
+		BlockRootHash32: []byte{'A'},
+	}
+	proposalData2 := proto.Clone(proposalData).(*pb.ProposalSignedData)
+	proposalData2.BlockRootHash32 = []byte{'B'}
+	proposalRoot, err := hashutil.HashProto(proposalData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proposalRoot2, err := hashutil.HashProto(proposalData2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := forkutil.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(proposalData.Slot), params.BeaconConfig().DomainProposal)
+	slashing := &pb.ProposerSlashing{
+		ProposerIndex:       1,
+		ProposalData_1:      proposalData,
+		ProposalSignature_1: privKeys[1].Sign(proposalRoot[:], domain).Marshal(),
+		ProposalData_2:      proposalData2,
+		ProposalSignature_2: privKeys[2].Sign(proposalRoot2[:], domain).Marshal(),
+	}
+	block := &pb.BeaconBlock{
+		Body: &pb.BeaconBlockBody{
+			ProposerSlashings: []*pb.ProposerSlashing{slashing},
+		},
+	}
+
+	_, err = blocks.ProcessProposerSlashings(beaconState, block, true /* verify signatures */)
+	assertSignatureErr(t, err, blocks.ProposerSlashingOperation, 0)
+	if !strings.Contains(err.Error(), "proposal 2") {
+		t.Errorf("Expected error to name the invalid proposal, received %v", err)
+	}
+}
+
+func TestProcessAttesterSlashings_InvalidSignature(t *testing.T) {
+	beaconState, privKeys := setupSignatureState(t)
+	data1 := &pb.AttestationData{
+		Slot:           params.BeaconConfig().GenesisSlot,
+		JustifiedEpoch: params.BeaconConfig().GenesisEpoch,
+		Shard:          1,
+	}
+	data2 := &pb.AttestationData{
+		Slot:           params.BeaconConfig().GenesisSlot,
+		JustifiedEpoch: params.BeaconConfig().GenesisEpoch,
+		Shard:          2,
+	}
+	sign := func(data *pb.AttestationData, custodyBit bool, priv *bls.SecretKey) *bls.Signature {
+		root, err := hashutil.HashAttestationDataAndCustodyBit(data, custodyBit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		domain := forkutil.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(data.Slot), params.BeaconConfig().DomainAttestation)
+		return priv.Sign(root[:], domain)
+	}
+	// Both validators sign with custody bit 0, as required in phase 0.
+	validSig := bls.AggregateSignatures([]*bls.Signature{
+		sign(data1, false, privKeys[1]),
+		sign(data1, false, privKeys[2]),
+	})
+	// Validator 2 signed with custody bit 1 although its custody bit is 0.
+	invalidSig := bls.AggregateSignatures([]*bls.Signature{
+		sign(data2, false, privKeys[1]),
+		sign(data2, true, privKeys[2]),
+	})
+	slashing := &pb.AttesterSlashing{
+		SlashableAttestation_1: &pb.SlashableAttestation{
+			Data:               data1,
+			ValidatorIndices:   []uint64{1, 2},
+			CustodyBitfield:    []byte{0x00},
+			AggregateSignature: validSig.Marshal(),
+		},
+		SlashableAttestation_2: &pb.SlashableAttestation{
+			Data:               data2,
+			ValidatorIndices:   []uint64{1, 2},
+			CustodyBitfield:    []byte{0x00},
+			AggregateSignature: invalidSig.Marshal(),
+		},
+	}
+	block := &pb.BeaconBlock{
+		Body: &pb.BeaconBlockBody{
+			AttesterSlashings: []*pb.AttesterSlashing{slashing},
+		},
+	}
+
+	_, err := blocks.ProcessAttesterSlashings(beaconState, block, true /* verify signatures */)
+	assertSignatureErr(t, err, blocks.AttesterSlashingOperation, 0)
+	if !strings.Contains(err.Error(), "slashable attestation 2") {
+		t.Errorf("Expected error to name the invalid slashable attestation, received %v", err)
+	}
+}
+
+func TestProcessBlockAttestations_VerifiesAggregateSignature(t *testing.T) {
+	beaconState, privKeys := setupSignatureState(t)
+	attestationSlot := beaconState.Slot
+	beaconState.Slot += params.BeaconConfig().MinAttestationInclusionDelay
+	committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, attestationSlot, false /* registryChange */)
+	if err != nil {
+		t.Fatal(err)
+	}
+	committee := committees[0]
+	data := &pb.AttestationData{
+		Slot:                     attestationSlot,
+		Shard:                    committee.Shard,
+		JustifiedEpoch:           beaconState.JustifiedEpoch,
+		JustifiedBlockRootHash32: beaconState.JustifiedRoot,
+		LatestCrosslink:          beaconState.LatestCrosslinks[committee.Shard],
+		CrosslinkDataRootHash32:  params.BeaconConfig().ZeroHash[:],
+	}
+	custodyBit0Root, err := hashutil.HashAttestationDataAndCustodyBit(data, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := forkutil.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(data.Slot), params.BeaconConfig().DomainAttestation)
+	bitfieldLength := (len(committee.Committee) + 7) / 8
+	aggregationBitfield := make([]byte, bitfieldLength)
+	var sigs []*bls.Signature
+	for i, validatorIndex := range committee.Committee {
+		aggregationBitfield[i/8] |= 1 << uint(7-i%8)
+		sigs = append(sigs, privKeys[validatorIndex].Sign(custodyBit0Root[:], domain))
+	}
+	att := &pb.Attestation{
+		Data:                data,
+		AggregationBitfield: aggregationBitfield,
+		CustodyBitfield:     make([]byte, bitfieldLength),
+		AggregateSignature:  bls.AggregateSignatures(sigs).Marshal(),
+	}
+	block := &pb.BeaconBlock{
+		Body: &pb.BeaconBlockBody{
+			Attestations: []*pb.Attestation{att},
+		},
+	}
+	if _, err := blocks.ProcessBlockAttestations(beaconState, block, true /* verify signatures */); err != nil {
+		t.Fatalf("Expected attestation signature to verify, received %v", err)
+	}
+
+	// Drop the last signer from the aggregate while still claiming its participation.
+	beaconState.LatestAttestations = nil
+	att.AggregateSignature = bls.AggregateSignatures(sigs[:len(sigs)-1]).Marshal()
+	block.Body.Attestations = []*pb.Attestation{att, att}
+	_, err = blocks.ProcessBlockAttestations(beaconState, block, true /* verify signatures */)
+	assertSignatureErr(t, err, blocks.AttestationOperation, 0)
+}
+
+func TestProcessValidatorExits_VerifiesSignature(t *testing.T) {
+	beaconState, privKeys := setupSignatureState(t)
+	sign := func(exit *pb.VoluntaryExit, priv *bls.SecretKey) []byte {
+		exitMessage, err := hashutil.HashVoluntaryExit(exit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		domain := forkutil.DomainVersion(beaconState.Fork, exit.Epoch, params.BeaconConfig().DomainExit)
+		return priv.Sign(exitMessage[:], domain).Marshal()
+	}
+	validExit := &pb.VoluntaryExit{
+		Epoch:          params.BeaconConfig().GenesisEpoch,
+		ValidatorIndex: 3,
+	}
+	validExit.Signature = sign(validExit, privKeys[3])
+	invalidExit := &pb.VoluntaryExit{
+		Epoch:          params.BeaconConfig().GenesisEpoch,
+		ValidatorIndex: 4,
+	}
+	invalidExit.Signature = sign(invalidExit, privKeys[5])
+	block := &pb.BeaconBlock{
+		Body: &pb.BeaconBlockBody{
+			VoluntaryExits: []*pb.VoluntaryExit{validExit, invalidExit},
+		},
+	}
+
+	_, err := blocks.ProcessValidatorExits(beaconState, block, true /* verify signatures */)
+	assertSignatureErr(t, err, blocks.VoluntaryExitOperation, 1)
+}
+
+func TestVerifyExit(t *testing.T) {
+	beaconState, privKeys := setupSignatureState(t)
+	exit := &pb.VoluntaryExit{
+		Epoch:          params.BeaconConfig().GenesisEpoch,
+		ValidatorIndex: 3,
+	}
+	exitMessage, err := hashutil.HashVoluntaryExit(exit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := forkutil.DomainVersion(beaconState.Fork, exit.Epoch, params.BeaconConfig().DomainExit)
+
+	exit.Signature = privKeys[3].Sign(exitMessage[:], domain).Marshal()
+	if err := blocks.VerifyExit(beaconState, exit); err != nil {
+		t.Errorf("Expected exit to verify, received %v", err)
+	}
+
+	exit.Signature = privKeys[4].Sign(exitMessage[:], domain).Marshal()
+	err = blocks.VerifyExit(beaconState, exit)
+	assertSignatureErr(t, err, blocks.VoluntaryExitOperation, 0)
+
+	exit.ValidatorIndex = uint64(len(beaconState.ValidatorRegistry))
+	err = blocks.VerifyExit(beaconState, exit)
+	if err == nil || !strings.Contains(err.Error(), "out of range") {
+		t.Errorf("Expected out of range validator index error, received %v", err)
+	}
+}
+
+func TestVerifyProposerSlashing(t *testing.T) {
+	beaconState, privKeys := setupSignatureState(t)
+	proposalData1 := &pb.ProposalSignedData{
+		Slot:            params.BeaconConfig().GenesisSlot,
+		Shard:           params.BeaconConfig().BeaconChainShardNumber,
+		BlockRootHash32: []byte{'A'},
+	}
+	proposalData2 := proto.Clone(proposalData1).(*pb.ProposalSignedData)
+	proposalData2.BlockRootHash32 = []byte{'B'}
+	sign := func(data *pb.ProposalSignedData, priv *bls.SecretKey) []byte {
+		root, err := hashutil.HashProto(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		domain := forkutil.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(data.Slot), params.BeaconConfig().DomainProposal)
+		return priv.Sign(root[:], domain).Marshal()
+	}
+	slashing := &pb.ProposerSlashing{
+		ProposerIndex:       1,
+		ProposalData_1:      proposalData1,
+		ProposalSignature_1: sign(proposalData1, privKeys[1]),
+		ProposalData_2:      proposalData2,
+		ProposalSignature_2: sign(proposalData2, privKeys[1]),
+	}
+	if err := blocks.VerifyProposerSlashing(beaconState, slashing); err != nil {
+		t.Errorf("Expected proposer slashing to verify, received %v", err)
+	}
+
+	beaconState.ValidatorRegistry[1].SlashedEpoch = helpers.CurrentEpoch(beaconState)
+	err := blocks.VerifyProposerSlashing(beaconState, slashing)
+	if err == nil || !strings.Contains(err.Error(), "already been slashed") {
+		t.Errorf("Expected already slashed error, received %v", err)
+	}
+
+	slashing.ProposalSignature_2 = sign(proposalData2, privKeys[2])
+	err = blocks.VerifyProposerSlashing(beaconState, slashing)
+	assertSignatureErr(t, err, blocks.ProposerSlashingOperation, 0)
+}
+
+func TestVerifyAttesterSlashing(t *testing.T) {
+	beaconState, privKeys := setupSignatureState(t)
+	data1 := &pb.AttestationData{
+		Slot:           params.BeaconConfig().GenesisSlot,
+		JustifiedEpoch: params.BeaconConfig().GenesisEpoch,
+		Shard:          1,
+	}
+	data2 := &pb.AttestationData{
+		Slot:           params.BeaconConfig().GenesisSlot,
+		JustifiedEpoch: params.BeaconConfig().GenesisEpoch,
+		Shard:          2,
+	}
+	// Both validators sign with custody bit 0, as required in phase 0.
+	sign := func(data *pb.AttestationData, priv1 *bls.SecretKey, priv2 *bls.SecretKey) []byte {
+		var sigs []*bls.Signature
+		for _, priv := range []*bls.SecretKey{priv1, priv2} {
+			root, err := hashutil.HashAttestationDataAndCustodyBit(data, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			domain := forkutil.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(data.Slot), params.BeaconConfig().DomainAttestation)
+			sigs = append(sigs, priv.Sign(root[:], domain))
+		}
+		return bls.AggregateSignatures(sigs).Marshal()
+	}
+	slashing := &pb.AttesterSlashing{
+		SlashableAttestation_1: &pb.SlashableAttestation{
+			Data:               data1,
+			ValidatorIndices:   []uint64{1, 2},
+			CustodyBitfield:    []byte{0x00},
+			AggregateSignature: sign(data1, privKeys[1], privKeys[2]),
+		},
+		SlashableAttestation_2: &pb.SlashableAttestation{
+			Data:               data2,
+			ValidatorIndices:   []uint64{1, 2},
+			CustodyBitfield:    []byte{0x00},
+			AggregateSignature: sign(data2, privKeys[1], privKeys[2]),
+		},
+	}
+	if err := blocks.VerifyAttesterSlashing(beaconState, slashing); err != nil {
+		t.Errorf("Expected attester slashing to verify, received %v", err)
+	}
+
+	for _, idx := range []uint64{1, 2} {
+		beaconState.ValidatorRegistry[idx].SlashedEpoch = helpers.CurrentEpoch(beaconState)
+	}
+	err := blocks.VerifyAttesterSlashing(beaconState, slashing)
+	if err == nil || !strings.Contains(err.Error(), "non-empty list of slashable indices") {
+		t.Errorf("Expected no slashable indices error, received %v", err)
+	}
+
+	slashing.SlashableAttestation_2.AggregateSignature = sign(data2, privKeys[1], privKeys[3])
+	err = blocks.VerifyAttesterSlashing(beaconState, slashing)
+	assertSignatureErr(t, err, blocks.AttesterSlashingOperation, 0)
+}
@@ -11,9 +11,7 @@ import (
 
 	block "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
-	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
-	b "github.com/prysmaticlabs/prysm/shared/bytesutil"
 )
 
 // CurrentAttestations returns the pending attestations from current epoch.
@@ -207,6 +205,27 @@ func InclusionDistance(state *pb.BeaconState, validatorIndex uint64) (uint64, er
 	return 0, fmt.Errorf("could not find inclusion distance for validator index %d", validatorIndex)
 }
 
+// WinningCrosslink is the crosslink data root of a shard with the most
+// attesting balance, along with the validators that attested to it.
+type WinningCrosslink struct {
+	Root             []byte
+	AttestingIndices []uint64
+	AttestingBalance uint64
+}
+
+// WinningCrosslinks returns the winning crosslink of every shard attested to
+// in the current and previous epoch attestations. The attestations are
+// resolved to their participants once, so callers processing every shard of
+// an epoch should use this rather than calling AttestingValidators or
+// TotalAttestingBalance for each shard.
+func WinningCrosslinks(
+	state *pb.BeaconState,
+	currentEpochAttestations []*pb.PendingAttestation,
+	prevEpochAttestations []*pb.PendingAttestation) (map[uint64]*WinningCrosslink, error) {
+
+	return winningCrosslinks(state, func(uint64) bool { return true }, currentEpochAttestations, prevEpochAttestations)
+}
+
 // AttestingValidators returns the validators of the winning root.
 //
 // Spec pseudocode definition:
@@ -218,51 +237,30 @@ func AttestingValidators(
 	currentEpochAttestations []*pb.PendingAttestation,
 	prevEpochAttestations []*pb.PendingAttestation) ([]uint64, error) {
 
-	root, err := winningRoot(
-		state,
-		shard,
-		currentEpochAttestations,
-		prevEpochAttestations)
+	winner, err := shardWinningCrosslink(state, shard, currentEpochAttestations, prevEpochAttestations)
 	if err != nil {
 		return nil, fmt.Errorf("could not get winning root: %v", err)
 	}
-
-	indices, err := validators.AttestingValidatorIndices(
-		state,
-		shard,
-		root,
-		currentEpochAttestations,
-		prevEpochAttestations)
-	if err != nil {
-		return nil, fmt.Errorf("could not get attesting validator indices: %v", err)
-	}
-
-	return indices, nil
+	return winner.AttestingIndices, nil
 }
 
 // TotalAttestingBalance returns the total balance at stake of the validators
 // attested to the winning root.
 //
 // Spec pseudocode definition:
-//    Let total_balance(crosslink_committee) =
-//    sum([get_effective_balance(state, i) for i in crosslink_committee.committee])
+//    Let total_attesting_balance(crosslink_committee) =
+//    get_total_balance(state, attesting_validators(crosslink_committee))
 func TotalAttestingBalance(
 	state *pb.BeaconState,
 	shard uint64,
 	currentEpochAttestations []*pb.PendingAttestation,
 	prevEpochAttestations []*pb.PendingAttestation) (uint64, error) {
 
-	var totalBalance uint64
-	attestedValidatorIndices, err := AttestingValidators(state, shard, currentEpochAttestations, prevEpochAttestations)
+	winner, err := shardWinningCrosslink(state, shard, currentEpochAttestations, prevEpochAttestations)
 	if err != nil {
-		return 0, fmt.Errorf("could not get attesting validator indices: %v", err)
-	}
-
-	for _, index := range attestedValidatorIndices {
-		totalBalance += helpers.EffectiveBalance(state, index)
+		return 0, fmt.Errorf("could not get winning root: %v", err)
 	}
-
-	return totalBalance, nil
+	return winner.AttestingBalance, nil
 }
 
 // SinceFinality calculates and returns how many epoch has it been since
@@ -287,38 +285,95 @@ func winningRoot(
 	currentEpochAttestations []*pb.PendingAttestation,
 	prevEpochAttestations []*pb.PendingAttestation) ([]byte, error) {
 
-	var winnerBalance uint64
-	var winnerRoot []byte
-	var candidateRoots [][]byte
-	attestations := append(currentEpochAttestations, prevEpochAttestations...)
+	winner, err := shardWinningCrosslink(state, shard, currentEpochAttestations, prevEpochAttestations)
+	if err != nil {
+		return nil, err
+	}
+	return winner.Root, nil
+}
 
-	for _, attestation := range attestations {
-		if attestation.Data.Shard == shard {
-			candidateRoots = append(candidateRoots, attestation.Data.CrosslinkDataRootHash32)
-		}
+// shardWinningCrosslink returns the winning crosslink of a single shard. A shard
+// nobody attested to has an empty winning crosslink.
+func shardWinningCrosslink(
+	state *pb.BeaconState,
+	shard uint64,
+	currentEpochAttestations []*pb.PendingAttestation,
+	prevEpochAttestations []*pb.PendingAttestation) (*WinningCrosslink, error) {
+
+	winners, err := winningCrosslinks(state, func(s uint64) bool { return s == shard },
+		currentEpochAttestations, prevEpochAttestations)
+	if err != nil {
+		return nil, err
+	}
+	if winner, ok := winners[shard]; ok {
+		return winner, nil
 	}
+	return &WinningCrosslink{}, nil
+}
 
-	for _, candidateRoot := range candidateRoots {
-		indices, err := validators.AttestingValidatorIndices(
-			state,
-			shard,
-			candidateRoot,
-			currentEpochAttestations,
-			prevEpochAttestations)
-		if err != nil {
-			return nil, fmt.Errorf("could not get attesting validator indices: %v", err)
-		}
+// crosslinkCandidate is a crosslink data root of a shard and the union of the
+// participants of the attestations voting for it, in the order they were seen.
+type crosslinkCandidate struct {
+	root    []byte
+	indices []uint64
+	seen    map[uint64]bool
+}
 
-		var rootBalance uint64
-		for _, index := range indices {
-			rootBalance += helpers.EffectiveBalance(state, index)
+// winningCrosslinks groups the participants of the attestations of the shards
+// matching the filter by shard and crosslink data root, then picks the root
+// with the most attesting balance for every shard.
+func winningCrosslinks(
+	state *pb.BeaconState,
+	includeShard func(shard uint64) bool,
+	currentEpochAttestations []*pb.PendingAttestation,
+	prevEpochAttestations []*pb.PendingAttestation) (map[uint64]*WinningCrosslink, error) {
+
+	candidates := make(map[uint64][]*crosslinkCandidate)
+	candidatesByRoot := make(map[uint64]map[string]*crosslinkCandidate)
+	for _, attestations := range [][]*pb.PendingAttestation{currentEpochAttestations, prevEpochAttestations} {
+		for _, attestation := range attestations {
+			shard := attestation.Data.Shard
+			if !includeShard(shard) {
+				continue
+			}
+			participants, err := helpers.AttestationParticipants(state, attestation.Data, attestation.AggregationBitfield)
+			if err != nil {
+				return nil, fmt.Errorf("could not get attester indices: %v", err)
+			}
+			if candidatesByRoot[shard] == nil {
+				candidatesByRoot[shard] = make(map[string]*crosslinkCandidate)
+			}
+			root := attestation.Data.CrosslinkDataRootHash32
+			candidate, ok := candidatesByRoot[shard][string(root)]
+			if !ok {
+				candidate = &crosslinkCandidate{root: root, seen: make(map[uint64]bool)}
+				candidatesByRoot[shard][string(root)] = candidate
+				candidates[shard] = append(candidates[shard], candidate)
+			}
+			for _, index := range participants {
+				if !candidate.seen[index] {
+					candidate.seen[index] = true
+					candidate.indices = append(candidate.indices, index)
+				}
+			}
 		}
+	}
 
-		if rootBalance > winnerBalance ||
-			(rootBalance == winnerBalance && b.LowerThan(candidateRoot, winnerRoot)) {
-			winnerBalance = rootBalance
-			winnerRoot = candidateRoot
+	winners := make(map[uint64]*WinningCrosslink, len(candidates))
+	for shard, shardCandidates := range candidates {
+		var winner *WinningCrosslink
+		for _, candidate := range shardCandidates {
+			balance := TotalBalance(state, candidate.indices)
+			if winner == nil || balance > winner.AttestingBalance ||
+				(balance == winner.AttestingBalance && bytes.Compare(candidate.root, winner.Root) < 0) {
+				winner = &WinningCrosslink{
+					Root:             candidate.root,
+					AttestingIndices: candidate.indices,
+					AttestingBalance: balance,
+				}
+			}
 		}
+		winners[shard] = winner
 	}
-	return winnerRoot, nil
+	return winners, nil
 }
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
@@ -455,6 +456,60 @@ func TestTotalAttestingBalance_EmptyWinningRoot(t *testing.T) {
 	}
 }
 
+func TestWinningCrosslinks_PerShard(t *testing.T) {
+	state := buildState(params.BeaconConfig().GenesisSlot, 2*params.BeaconConfig().SlotsPerEpoch)
+	committees0, err := helpers.CrosslinkCommitteesAtSlot(state, state.Slot, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	committees1, err := helpers.CrosslinkCommitteesAtSlot(state, state.Slot+1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shard0, shard1 := committees0[0].Shard, committees1[0].Shard
+
+	attestation := func(slot uint64, shard uint64, root byte, bitfield byte) *pb.PendingAttestation {
+		return &pb.PendingAttestation{
+			Data: &pb.AttestationData{
+				Slot:                    slot,
+				Shard:                   shard,
+				CrosslinkDataRootHash32: []byte{root},
+			},
+			AggregationBitfield: []byte{bitfield},
+		}
+	}
+	// Shard 0 has two roots attested by one validator each, the tie goes to the lower root.
+	currentAttestations := []*pb.PendingAttestation{
+		attestation(state.Slot, shard0, 'B', 0x80),
+		attestation(state.Slot+1, shard1, 'C', 0x80),
+	}
+	prevAttestations := []*pb.PendingAttestation{
+		attestation(state.Slot, shard0, 'A', 0x40),
+		attestation(state.Slot+1, shard1, 'C', 0xC0),
+	}
+
+	winners, err := WinningCrosslinks(state, currentAttestations, prevAttestations)
+	if err != nil {
+		t.Fatalf("Could not get winning crosslinks: %v", err)
+	}
+	if len(winners) != 2 {
+		t.Fatalf("Expected winners for 2 shards, received %d", len(winners))
+	}
+	if !bytes.Equal(winners[shard0].Root, []byte{'A'}) {
+		t.Errorf("Expected shard %d winning root A, received %v", shard0, winners[shard0].Root)
+	}
+	if !reflect.DeepEqual(winners[shard0].AttestingIndices, []uint64{committees0[0].Committee[1]}) {
+		t.Errorf("Unexpected shard %d attesting indices %v", shard0, winners[shard0].AttestingIndices)
+	}
+	if !bytes.Equal(winners[shard1].Root, []byte{'C'}) {
+		t.Errorf("Expected shard %d winning root C, received %v", shard1, winners[shard1].Root)
+	}
+	if winners[shard1].AttestingBalance != 2*params.BeaconConfig().MaxDepositAmount {
+		t.Errorf("Expected shard %d attesting balance %d, received %d",
+			shard1, 2*params.BeaconConfig().MaxDepositAmount, winners[shard1].AttestingBalance)
+	}
+}
+
 func TestTotalBalance_CorrectBalance(t *testing.T) {
 	// Assign validators to different balances.
 	state := &pb.BeaconState{
@@ -13,7 +13,6 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
-	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/mathutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -47,23 +46,20 @@ func CanProcessEth1Data(state *pb.BeaconState) bool {
 //
 // Spec pseudocode definition:
 //    If the following are satisfied:
-//		* state.finalized_epoch > state.validator_registry_latest_change_epoch
+//		* state.finalized_epoch > state.validator_registry_update_epoch
 //		* state.latest_crosslinks[shard].epoch > state.validator_registry_update_epoch
-// 			for every shard number shard in [(state.current_epoch_start_shard + i) %
-//	 			SHARD_COUNT for i in range(get_current_epoch_committee_count(state) *
-//	 			SLOTS_PER_EPOCH)] (that is, for every shard in the current committees)
+// 			for every shard number shard in [(state.current_shuffling_start_shard + i) %
+//	 			SHARD_COUNT for i in range(get_current_epoch_committee_count(state))]
+//	 			(that is, for every shard in the current committees)
 func CanProcessValidatorRegistry(state *pb.BeaconState) bool {
 	if state.FinalizedEpoch <= state.ValidatorRegistryUpdateEpoch {
 		return false
 	}
-	if featureconfig.FeatureConfig().EnableCrosslinks {
-		shardsProcessed := helpers.CurrentEpochCommitteeCount(state) * params.BeaconConfig().SlotsPerEpoch
-		startShard := state.CurrentShufflingStartShard
-		for i := startShard; i < shardsProcessed; i++ {
-			if state.LatestCrosslinks[i%params.BeaconConfig().ShardCount].Epoch <=
-				state.ValidatorRegistryUpdateEpoch {
-				return false
-			}
+	committeeCount := helpers.CurrentEpochCommitteeCount(state)
+	for i := uint64(0); i < committeeCount; i++ {
+		shard := (state.CurrentShufflingStartShard + i) % params.BeaconConfig().ShardCount
+		if state.LatestCrosslinks[shard].Epoch <= state.ValidatorRegistryUpdateEpoch {
+			return false
 		}
 	}
 	return true
@@ -188,7 +184,8 @@ func ProcessJustificationAndFinalization(
 // ProcessCrosslinks goes through each crosslink committee and check
 // crosslink committee's attested balance * 3 is greater than total balance *2.
 // If it's greater then beacon node updates crosslink committee with
-// the state epoch and wining root.
+// the epoch of the committee's slot and wining root. The winning root of every
+// shard is computed once for the whole epoch range.
 //
 // Spec pseudocode definition:
 //	For every slot in range(get_epoch_start_slot(previous_epoch), get_epoch_start_slot(next_epoch)),
@@ -203,11 +200,15 @@ func ProcessCrosslinks(
 	prevEpochAttestations []*pb.PendingAttestation) (*pb.BeaconState, error) {
 
 	prevEpoch := helpers.PrevEpoch(state)
-	currentEpoch := helpers.CurrentEpoch(state)
 	nextEpoch := helpers.NextEpoch(state)
 	startSlot := helpers.StartSlot(prevEpoch)
 	endSlot := helpers.StartSlot(nextEpoch)
 
+	winners, err := WinningCrosslinks(state, thisEpochAttestations, prevEpochAttestations)
+	if err != nil {
+		return nil, fmt.Errorf("could not get winning crosslinks: %v", err)
+	}
+
 	for i := startSlot; i < endSlot; i++ {
 		// RegistryChange is a no-op when requesting slot in current and previous epoch.
 		// ProcessCrosslinks will never ask for slot in next epoch.
@@ -217,20 +218,15 @@ func ProcessCrosslinks(
 		}
 		for _, crosslinkCommittee := range crosslinkCommittees {
 			shard := crosslinkCommittee.Shard
-			committee := crosslinkCommittee.Committee
-			attestingBalance, err := TotalAttestingBalance(state, shard, thisEpochAttestations, prevEpochAttestations)
-			if err != nil {
-				return nil, fmt.Errorf("could not get attesting balance for shard committee %d: %v", shard, err)
+			winner, ok := winners[shard]
+			if !ok {
+				winner = &WinningCrosslink{}
 			}
-			totalBalance := TotalBalance(state, committee)
-			if attestingBalance*3 >= totalBalance*2 {
-				winningRoot, err := winningRoot(state, shard, thisEpochAttestations, prevEpochAttestations)
-				if err != nil {
-					return nil, fmt.Errorf("could not get winning root: %v", err)
-				}
+			totalBalance := TotalBalance(state, crosslinkCommittee.Committee)
+			if winner.AttestingBalance*3 >= totalBalance*2 {
 				state.LatestCrosslinks[shard] = &pb.Crosslink{
-					Epoch:                   currentEpoch,
-					CrosslinkDataRootHash32: winningRoot,
+					Epoch:                   helpers.SlotToEpoch(i),
+					CrosslinkDataRootHash32: winner.Root,
 				}
 			}
 		}
@@ -265,11 +261,11 @@ func ProcessEjections(state *pb.BeaconState, enableLogging bool) (*pb.BeaconStat
 	return state, nil
 }
 
-// ProcessPrevSlotShardSeed computes and sets current epoch's calculation slot
-// and start shard to previous epoch. Then it returns the updated state.
+// ProcessPrevSlotShardSeed computes and sets current epoch's shuffling epoch,
+// start shard and seed to previous epoch. Then it returns the updated state.
 //
 // Spec pseudocode definition:
-//	Set state.previous_epoch_randao_mix = state.current_epoch_randao_mix
+//	Set state.previous_shuffling_epoch = state.current_shuffling_epoch
 //	Set state.previous_shuffling_start_shard = state.current_shuffling_start_shard
 //  Set state.previous_shuffling_seed = state.current_shuffling_seed.
 func ProcessPrevSlotShardSeed(state *pb.BeaconState) *pb.BeaconState {
@@ -287,9 +283,12 @@ func ProcessPrevSlotShardSeed(state *pb.BeaconState) *pb.BeaconState {
 func ProcessCurrSlotShardSeed(state *pb.BeaconState) (*pb.BeaconState, error) {
 	state.CurrentShufflingStartShard = (state.CurrentShufflingStartShard +
 		helpers.CurrentEpochCommitteeCount(state)) % params.BeaconConfig().ShardCount
-	// TODO(#2072)we have removed the generation of a new seed for the timebeing to get it stable for the testnet.
-	// this will be handled in Q2.
 	state.CurrentShufflingEpoch = helpers.NextEpoch(state)
+	seed, err := helpers.GenerateSeed(state, state.CurrentShufflingEpoch)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate seed: %v", err)
+	}
+	state.CurrentShufflingSeedHash32 = seed[:]
 	return state, nil
 }
 
@@ -311,8 +310,11 @@ func ProcessPartialValidatorRegistry(state *pb.BeaconState) (*pb.BeaconState, er
 	if epochsSinceLastRegistryChange > 1 &&
 		mathutil.IsPowerOf2(epochsSinceLastRegistryChange) {
 		state.CurrentShufflingEpoch = helpers.NextEpoch(state)
-		// TODO(#2072)we have removed the generation of a new seed for the timebeing to get it stable for the testnet.
-		// this will be handled in Q2.
+		seed, err := helpers.GenerateSeed(state, state.CurrentShufflingEpoch)
+		if err != nil {
+			return nil, fmt.Errorf("could not generate seed: %v", err)
+		}
+		state.CurrentShufflingSeedHash32 = seed[:]
 	}
 	return state, nil
 }
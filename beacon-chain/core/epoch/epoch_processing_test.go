@@ -17,9 +17,7 @@ import (
 )
 
 func init() {
-	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{
-		EnableCrosslinks: true,
-	})
+	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{})
 }
 
 func TestCanProcessEpoch_TrueOnEpochs(t *testing.T) {
@@ -319,6 +317,45 @@ func TestProcessCrosslinks_CrosslinksCorrectEpoch(t *testing.T) {
 	}
 }
 
+func TestProcessCrosslinks_PrevEpochCrosslinkEpoch(t *testing.T) {
+	state := buildState(params.BeaconConfig().GenesisSlot, 2*params.BeaconConfig().SlotsPerEpoch)
+	state.Slot = params.BeaconConfig().GenesisSlot + 3*params.BeaconConfig().SlotsPerEpoch - 1
+	state.LatestCrosslinks = make([]*pb.Crosslink, params.BeaconConfig().ShardCount)
+	for i := range state.LatestCrosslinks {
+		state.LatestCrosslinks[i] = &pb.Crosslink{}
+	}
+	// Shuffle the previous epoch onto different shards than the current epoch.
+	state.PreviousShufflingStartShard = params.BeaconConfig().SlotsPerEpoch
+
+	prevEpochSlot := helpers.StartSlot(helpers.PrevEpoch(state))
+	committees, err := helpers.CrosslinkCommitteesAtSlot(state, prevEpochSlot, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shard := committees[0].Shard
+	attestations := []*pb.PendingAttestation{{
+		Data: &pb.AttestationData{
+			Slot:                    prevEpochSlot,
+			Shard:                   shard,
+			CrosslinkDataRootHash32: []byte{'A'},
+		},
+		AggregationBitfield: []byte{0xC0},
+	}}
+
+	newState, err := ProcessCrosslinks(state, nil, attestations)
+	if err != nil {
+		t.Fatalf("Could not execute ProcessCrosslinks: %v", err)
+	}
+	if newState.LatestCrosslinks[shard].Epoch != helpers.PrevEpoch(state) {
+		t.Errorf("Shard %d got crosslinked at epoch %d, wanted: %d",
+			shard, newState.LatestCrosslinks[shard].Epoch, helpers.PrevEpoch(state))
+	}
+	if !bytes.Equal(newState.LatestCrosslinks[shard].CrosslinkDataRootHash32, []byte{'A'}) {
+		t.Errorf("Shard %d's root hash is %#x, wanted: %#x",
+			shard, newState.LatestCrosslinks[shard].CrosslinkDataRootHash32, []byte{'A'})
+	}
+}
+
 func TestProcessCrosslinks_NoParticipantsBitField(t *testing.T) {
 	state := buildState(params.BeaconConfig().GenesisSlot+5, params.BeaconConfig().DepositsForChainStart)
 	state.LatestCrosslinks = []*pb.Crosslink{{}, {}}
@@ -444,6 +481,46 @@ func TestProcessPartialValidatorRegistry_CorrectShufflingEpoch(t *testing.T) {
 		t.Errorf("Incorrect CurrentShufflingEpoch, wanted: %d, got: %d",
 			helpers.NextEpoch(state), newState.CurrentShufflingEpoch)
 	}
+	seed, err := helpers.GenerateSeed(state, helpers.NextEpoch(state))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(newState.CurrentShufflingSeedHash32, seed[:]) {
+		t.Errorf("Incorrect CurrentShufflingSeedHash32, wanted: %#x, got: %#x",
+			seed, newState.CurrentShufflingSeedHash32)
+	}
+}
+
+func TestProcessCurrSlotShardSeed_RegeneratesSeed(t *testing.T) {
+	state := &pb.BeaconState{
+		Slot:                       params.BeaconConfig().SlotsPerEpoch * 2,
+		CurrentShufflingStartShard: params.BeaconConfig().ShardCount - 1,
+		CurrentShufflingSeedHash32: []byte{'S'},
+		LatestRandaoMixes:          [][]byte{{'A'}, {'B'}, {'C'}},
+		LatestIndexRootHash32S:     [][]byte{{'D'}, {'E'}, {'F'}},
+	}
+	newState, err := ProcessCurrSlotShardSeed(proto.Clone(state).(*pb.BeaconState))
+	if err != nil {
+		t.Fatalf("Could not process current shuffling seed: %v", err)
+	}
+	wantedShard := (state.CurrentShufflingStartShard + helpers.CurrentEpochCommitteeCount(state)) %
+		params.BeaconConfig().ShardCount
+	if newState.CurrentShufflingStartShard != wantedShard {
+		t.Errorf("Incorrect CurrentShufflingStartShard, wanted: %d, got: %d",
+			wantedShard, newState.CurrentShufflingStartShard)
+	}
+	if newState.CurrentShufflingEpoch != helpers.NextEpoch(state) {
+		t.Errorf("Incorrect CurrentShufflingEpoch, wanted: %d, got: %d",
+			helpers.NextEpoch(state), newState.CurrentShufflingEpoch)
+	}
+	seed, err := helpers.GenerateSeed(state, helpers.NextEpoch(state))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(newState.CurrentShufflingSeedHash32, seed[:]) {
+		t.Errorf("Incorrect CurrentShufflingSeedHash32, wanted: %#x, got: %#x",
+			seed, newState.CurrentShufflingSeedHash32)
+	}
 }
 
 func TestCleanupAttestations_RemovesFromLastEpoch(t *testing.T) {
@@ -0,0 +1,42 @@
+package helpers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bitutil"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+)
+
+// ErrAttestationBitfieldsOverlap is returned when aggregating attestations which
+// both include the signature of the same validator.
+var ErrAttestationBitfieldsOverlap = errors.New("attestation aggregation bitfields overlap")
+
+// AggregateAttestation combines two attestations of the same attestation data into a single
+// attestation carrying the participation and signatures of both. The attestations must not
+// have any participant in common, as their signature would otherwise be counted twice in the
+// aggregate signature.
+func AggregateAttestation(a1 *pb.Attestation, a2 *pb.Attestation) (*pb.Attestation, error) {
+	if !proto.Equal(a1.Data, a2.Data) {
+		return nil, errors.New("cannot aggregate attestations with different attestation data")
+	}
+	if bitutil.Overlaps(a1.AggregationBitfield, a2.AggregationBitfield) {
+		return nil, ErrAttestationBitfieldsOverlap
+	}
+	sig1, err := bls.SignatureFromBytes(a1.AggregateSignature)
+	if err != nil {
+		return nil, fmt.Errorf("could not deserialize attestation signature: %v", err)
+	}
+	sig2, err := bls.SignatureFromBytes(a2.AggregateSignature)
+	if err != nil {
+		return nil, fmt.Errorf("could not deserialize attestation signature: %v", err)
+	}
+	return &pb.Attestation{
+		Data:                a1.Data,
+		AggregationBitfield: bitutil.Or(a1.AggregationBitfield, a2.AggregationBitfield),
+		CustodyBitfield:     bitutil.Or(a1.CustodyBitfield, a2.CustodyBitfield),
+		AggregateSignature:  bls.AggregateSignatures([]*bls.Signature{sig1, sig2}).Marshal(),
+	}, nil
+}
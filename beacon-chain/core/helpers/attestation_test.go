@@ -0,0 +1,64 @@
+package helpers
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+)
+
+func TestAggregateAttestation_OK(t *testing.T) {
+	data := &pb.AttestationData{Slot: 5, Shard: 2}
+	msg := []byte("attestation data")
+	domain := uint64(1)
+
+	var pubKeys []*bls.PublicKey
+	var atts []*pb.Attestation
+	for _, bitfield := range [][]byte{{128}, {64}} {
+		priv, err := bls.RandKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pubKeys = append(pubKeys, priv.PublicKey())
+		atts = append(atts, &pb.Attestation{
+			Data:                data,
+			AggregationBitfield: bitfield,
+			CustodyBitfield:     []byte{0},
+			AggregateSignature:  priv.Sign(msg, domain).Marshal(),
+		})
+	}
+
+	aggregate, err := AggregateAttestation(atts[0], atts[1])
+	if err != nil {
+		t.Fatalf("Could not aggregate attestations: %v", err)
+	}
+	if !bytes.Equal(aggregate.AggregationBitfield, []byte{192}) {
+		t.Errorf("Wanted aggregation bitfield %v, received %v", []byte{192}, aggregate.AggregationBitfield)
+	}
+	sig, err := bls.SignatureFromBytes(aggregate.AggregateSignature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sig.VerifyAggregate(pubKeys, msg, domain) {
+		t.Error("Aggregate signature did not verify against the attesters' public keys")
+	}
+}
+
+func TestAggregateAttestation_OverlappingBitfields(t *testing.T) {
+	data := &pb.AttestationData{Slot: 5, Shard: 2}
+	a1 := &pb.Attestation{Data: data, AggregationBitfield: []byte{192}}
+	a2 := &pb.Attestation{Data: data, AggregationBitfield: []byte{64}}
+	if _, err := AggregateAttestation(a1, a2); err != ErrAttestationBitfieldsOverlap {
+		t.Errorf("Expected %v, received %v", ErrAttestationBitfieldsOverlap, err)
+	}
+}
+
+func TestAggregateAttestation_DifferentData(t *testing.T) {
+	a1 := &pb.Attestation{Data: &pb.AttestationData{Slot: 5}, AggregationBitfield: []byte{128}}
+	a2 := &pb.Attestation{Data: &pb.AttestationData{Slot: 6}, AggregationBitfield: []byte{64}}
+	if _, err := AggregateAttestation(a1, a2); err == nil {
+		t.Error("Expected aggregating attestations with different data to fail")
+	}
+}
@@ -13,7 +13,6 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	v "github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
-	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
@@ -66,7 +65,7 @@ func ExecuteStateTransition(
 	if block != nil {
 		state, err = ProcessBlock(ctx, state, block, config)
 		if err != nil {
-			return nil, fmt.Errorf("could not process block: %v", err)
+			return nil, wrapBlockErr(err, "could not process block")
 		}
 	}
 
@@ -128,9 +127,8 @@ func ProcessBlock(
 
 	// Verify block signature.
 	if config.VerifySignatures {
-		// TODO(#781): Verify Proposer Signature.
-		if err := b.VerifyProposerSignature(block); err != nil {
-			return nil, fmt.Errorf("could not verify proposer signature: %v", err)
+		if err := b.VerifyProposerSignature(state, block); err != nil {
+			return nil, wrapBlockErr(err, "could not verify proposer signature")
 		}
 	}
 
@@ -140,24 +138,24 @@ func ProcessBlock(
 	// Verify block RANDAO.
 	state, err = b.ProcessBlockRandao(state, block, config.VerifySignatures, config.Logging)
 	if err != nil {
-		return nil, fmt.Errorf("could not verify and process block randao: %v", err)
+		return nil, wrapBlockErr(err, "could not verify and process block randao")
 	}
 
 	// Process ETH1 data.
 	state = b.ProcessEth1DataInBlock(state, block)
 	state, err = b.ProcessAttesterSlashings(state, block, config.VerifySignatures)
 	if err != nil {
-		return nil, fmt.Errorf("could not verify block attester slashings: %v", err)
+		return nil, wrapBlockErr(err, "could not verify block attester slashings")
 	}
 
 	state, err = b.ProcessProposerSlashings(state, block, config.VerifySignatures)
 	if err != nil {
-		return nil, fmt.Errorf("could not verify block proposer slashings: %v", err)
+		return nil, wrapBlockErr(err, "could not verify block proposer slashings")
 	}
 
 	state, err = b.ProcessBlockAttestations(state, block, config.VerifySignatures)
 	if err != nil {
-		return nil, fmt.Errorf("could not process block attestations: %v", err)
+		return nil, wrapBlockErr(err, "could not process block attestations")
 	}
 
 	state, err = b.ProcessValidatorDeposits(state, block)
@@ -166,7 +164,7 @@ func ProcessBlock(
 	}
 	state, err = b.ProcessValidatorExits(state, block, config.VerifySignatures)
 	if err != nil {
-		return nil, fmt.Errorf("could not process validator exits: %v", err)
+		return nil, wrapBlockErr(err, "could not process validator exits")
 	}
 
 	if config.Logging {
@@ -183,6 +181,16 @@ func ProcessBlock(
 	return state, nil
 }
 
+// wrapBlockErr adds context to an error from processing a block. Signature
+// verification errors are returned as is, so callers can tell which operation
+// and index of the block carried the invalid signature.
+func wrapBlockErr(err error, msg string) error {
+	if sigErr, ok := err.(*b.SignatureVerificationErr); ok {
+		return sigErr
+	}
+	return fmt.Errorf("%s: %v", msg, err)
+}
+
 // ProcessEpoch describes the per epoch operations that are performed on the
 // beacon state.
 //
@@ -274,15 +282,12 @@ func ProcessEpoch(ctx context.Context, state *pb.BeaconState, config *Transition
 	}
 
 	// Process crosslinks records.
-	// TODO(#2072): Include an optimized process crosslinks version.
-	if featureconfig.FeatureConfig().EnableCrosslinks {
-		state, err = e.ProcessCrosslinks(
-			state,
-			currentEpochAttestations,
-			prevEpochAttestations)
-		if err != nil {
-			return nil, fmt.Errorf("could not process crosslink records: %v", err)
-		}
+	state, err = e.ProcessCrosslinks(
+		state,
+		currentEpochAttestations,
+		prevEpochAttestations)
+	if err != nil {
+		return nil, fmt.Errorf("could not process crosslink records: %v", err)
 	}
 
 	// Process attester rewards and penalties.
@@ -379,15 +384,12 @@ func ProcessEpoch(ctx context.Context, state *pb.BeaconState, config *Transition
 	}
 
 	// Process crosslink rewards and penalties.
-	// TODO(#2072): Optimize crosslinks.
-	if featureconfig.FeatureConfig().EnableCrosslinks {
-		state, err = bal.Crosslinks(
-			state,
-			currentEpochAttestations,
-			prevEpochAttestations)
-		if err != nil {
-			return nil, fmt.Errorf("could not process crosslink rewards and penalties: %v", err)
-		}
+	state, err = bal.Crosslinks(
+		state,
+		currentEpochAttestations,
+		prevEpochAttestations)
+	if err != nil {
+		return nil, fmt.Errorf("could not process crosslink rewards and penalties: %v", err)
 	}
 
 	// Process ejections.
@@ -398,7 +400,6 @@ func ProcessEpoch(ctx context.Context, state *pb.BeaconState, config *Transition
 
 	// Process validator registry.
 	state = e.ProcessPrevSlotShardSeed(state)
-	state = v.ProcessPenaltiesAndExits(state)
 	if e.CanProcessValidatorRegistry(state) {
 		state, err = v.UpdateRegistry(state)
 		if err != nil {
@@ -422,9 +423,11 @@ func ProcessEpoch(ctx context.Context, state *pb.BeaconState, config *Transition
 		return nil, fmt.Errorf("could not update latest index roots: %v", err)
 	}
 
-	// TODO(1763): Implement process_slashings from ETH2.0 beacon chain spec.
+	// Penalize the slashed validators halfway through their withdrawal delay.
+	state = v.ProcessSlashings(state)
 
-	// TODO(1764): Implement process_exit_queue from ETH2.0 beacon chain spec.
+	// Prepare the exited validators which waited long enough for withdrawal.
+	state = v.ProcessExitQueue(state)
 
 	// Update accumulated slashed balances from current epoch to next epoch.
 	state = e.UpdateLatestSlashedBalances(state)
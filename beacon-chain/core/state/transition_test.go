@@ -19,9 +19,7 @@ import (
 )
 
 func init() {
-	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{
-		EnableCrosslinks: true,
-	})
+	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{})
 }
 
 func setupInitialDeposits(t *testing.T, numDeposits uint64) ([]*pb.Deposit, []*bls.SecretKey) {
@@ -123,7 +121,7 @@ func TestProcessBlock_IncorrectAttesterSlashing(t *testing.T) {
 			ProposalData_2: &pb.ProposalSignedData{
 				Slot:            1,
 				Shard:           1,
-				BlockRootHash32: []byte{0, 1, 0},
+				BlockRootHash32: []byte{1, 1, 0},
 			},
 		},
 	}
@@ -168,7 +166,7 @@ func TestProcessBlock_IncorrectProcessBlockAttestations(t *testing.T) {
 			ProposalData_2: &pb.ProposalSignedData{
 				Slot:            1,
 				Shard:           1,
-				BlockRootHash32: []byte{0, 1, 0},
+				BlockRootHash32: []byte{1, 1, 0},
 			},
 		},
 	}
@@ -185,12 +183,12 @@ func TestProcessBlock_IncorrectProcessBlockAttestations(t *testing.T) {
 			SlashableAttestation_1: &pb.SlashableAttestation{
 				Data:             att1,
 				ValidatorIndices: []uint64{1, 2, 3, 4, 5, 6, 7, 8},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 			SlashableAttestation_2: &pb.SlashableAttestation{
 				Data:             att2,
 				ValidatorIndices: []uint64{1, 2, 3, 4, 5, 6, 7, 8},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 		},
 	}
@@ -237,7 +235,7 @@ func TestProcessBlock_IncorrectProcessExits(t *testing.T) {
 			ProposalData_2: &pb.ProposalSignedData{
 				Slot:            params.BeaconConfig().GenesisSlot + 1,
 				Shard:           1,
-				BlockRootHash32: []byte{0, 1, 0},
+				BlockRootHash32: []byte{1, 1, 0},
 			},
 		},
 	}
@@ -254,12 +252,12 @@ func TestProcessBlock_IncorrectProcessExits(t *testing.T) {
 			SlashableAttestation_1: &pb.SlashableAttestation{
 				Data:             att1,
 				ValidatorIndices: []uint64{1, 2, 3, 4, 5, 6, 7, 8},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 			SlashableAttestation_2: &pb.SlashableAttestation{
 				Data:             att2,
 				ValidatorIndices: []uint64{1, 2, 3, 4, 5, 6, 7, 8},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 		},
 	}
@@ -330,7 +328,7 @@ func TestProcessBlock_PassesProcessingConditions(t *testing.T) {
 			ProposalData_2: &pb.ProposalSignedData{
 				Slot:            1,
 				Shard:           1,
-				BlockRootHash32: []byte{0, 1, 0},
+				BlockRootHash32: []byte{1, 1, 0},
 			},
 		},
 	}
@@ -347,12 +345,12 @@ func TestProcessBlock_PassesProcessingConditions(t *testing.T) {
 			SlashableAttestation_1: &pb.SlashableAttestation{
 				Data:             att1,
 				ValidatorIndices: []uint64{1, 2, 3, 4, 5, 6, 7, 8},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 			SlashableAttestation_2: &pb.SlashableAttestation{
 				Data:             att2,
 				ValidatorIndices: []uint64{1, 2, 3, 4, 5, 6, 7, 8},
-				CustodyBitfield:  []byte{0xFF},
+				CustodyBitfield:  []byte{0x00},
 			},
 		},
 	}
@@ -7,6 +7,8 @@ package validators
 import (
 	"bytes"
 	"fmt"
+	"math/big"
+	"sort"
 	"sync"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
@@ -360,87 +362,113 @@ func UpdateRegistry(state *pb.BeaconState) (*pb.BeaconState, error) {
 	return state, nil
 }
 
-// ProcessPenaltiesAndExits prepares the validators and the slashed validators
-// for withdrawal.
+// ProcessSlashings penalizes the slashed validators halfway through their withdrawal delay,
+// proportionally to the total balance slashed over the last LATEST_SLASHED_EXIT_LENGTH epochs.
+// A slashed validator's SlashedEpoch is set to the epoch it can withdraw at.
 //
 // Spec pseudocode definition:
-// def process_penalties_and_exits(state: BeaconState) -> None:
+// def process_slashings(state: BeaconState) -> None:
 //    """
-//    Process the penalties and prepare the validators who are eligible to withdrawal.
+//    Process the slashings.
 //    Note that this function mutates ``state``.
 //    """
 //    current_epoch = get_current_epoch(state)
-//    # The active validators
 //    active_validator_indices = get_active_validator_indices(state.validator_registry, current_epoch)
-//    # The total effective balance of active validators
-//    total_balance = sum(get_effective_balance(state, i) for i in active_validator_indices)
+//    total_balance = get_total_balance(state, active_validator_indices)
+//
+//    # Compute `total_penalties`
+//    total_at_start = state.latest_slashed_balances[(current_epoch + 1) % LATEST_SLASHED_EXIT_LENGTH]
+//    total_at_end = state.latest_slashed_balances[current_epoch % LATEST_SLASHED_EXIT_LENGTH]
+//    total_penalties = total_at_end - total_at_start
 //
 //    for index, validator in enumerate(state.validator_registry):
-//        if current_epoch == validator.slashed_epoch + LATEST_PENALIZED_EXIT_LENGTH // 2:
-//            epoch_index = current_epoch % LATEST_PENALIZED_EXIT_LENGTH
-//            total_at_start = state.latest_slashed_balances[(epoch_index + 1) % LATEST_PENALIZED_EXIT_LENGTH]
-//            total_at_end = state.latest_slashed_balances[epoch_index]
-//            total_penalties = total_at_end - total_at_start
-//            penalty = get_effective_balance(state, index) * min(total_penalties * 3, total_balance) // total_balance
+//        if validator.slashed and current_epoch == validator.withdrawable_epoch - LATEST_SLASHED_EXIT_LENGTH // 2:
+//            penalty = max(
+//                get_effective_balance(state, index) * min(total_penalties * 3, total_balance) // total_balance,
+//                get_effective_balance(state, index) // MIN_PENALTY_QUOTIENT
+//            )
 //            state.validator_balances[index] -= penalty
-//
-//    def eligible(index):
-//        validator = state.validator_registry[index]
-//        if validator.slashed_epoch <= current_epoch:
-//            slashed_withdrawal_epochs = LATEST_PENALIZED_EXIT_LENGTH // 2
-//            return current_epoch >= validator.slashed_epoch + slashd_withdrawal_epochs
-//        else:
-//            return current_epoch >= validator.exit_epoch + MIN_VALIDATOR_WITHDRAWAL_DELAY
-//
-//    all_indices = list(range(len(state.validator_registry)))
-//    eligible_indices = filter(eligible, all_indices)
-//    # Sort in order of exit epoch, and validators that exit within the same epoch exit in order of validator index
-//    sorted_indices = sorted(eligible_indices, key=lambda index: state.validator_registry[index].exit_epoch)
-//    withdrawn_so_far = 0
-//    for index in sorted_indices:
-//        prepare_validator_for_withdrawal(state, index)
-//        withdrawn_so_far += 1
-//        if withdrawn_so_far >= MAX_EXIT_DEQUEUES_PER_EPOCH:
-//            break
-func ProcessPenaltiesAndExits(state *pb.BeaconState) *pb.BeaconState {
+func ProcessSlashings(state *pb.BeaconState) *pb.BeaconState {
 	currentEpoch := helpers.CurrentEpoch(state)
 	activeValidatorIndices := helpers.ActiveValidatorIndices(
 		state.ValidatorRegistry, currentEpoch)
 	totalBalance := helpers.TotalBalance(state, activeValidatorIndices)
 
+	exitLength := params.BeaconConfig().LatestSlashedExitLength
+	totalAtStart := state.LatestSlashedBalances[(currentEpoch+1)%exitLength]
+	totalAtEnd := state.LatestSlashedBalances[currentEpoch%exitLength]
+	totalPenalties := totalAtEnd - totalAtStart
+
 	for idx, validator := range state.ValidatorRegistry {
-		slashed := validator.SlashedEpoch +
-			params.BeaconConfig().LatestSlashedExitLength/2
-		if currentEpoch == slashed {
-			slashedEpoch := currentEpoch % params.BeaconConfig().LatestSlashedExitLength
-			slashedEpochStart := (slashedEpoch + 1) % params.BeaconConfig().LatestSlashedExitLength
-			totalAtStart := state.LatestSlashedBalances[slashedEpochStart]
-			totalAtEnd := state.LatestSlashedBalances[slashedEpoch]
-			totalPenalties := totalAtStart - totalAtEnd
-
-			penaltyMultiplier := totalPenalties * 3
-			if totalBalance < penaltyMultiplier {
-				penaltyMultiplier = totalBalance
-			}
-			penalty := helpers.EffectiveBalance(state, uint64(idx)) *
-				penaltyMultiplier / totalBalance
-			state.ValidatorBalances[idx] -= penalty
+		if validator.SlashedEpoch == params.BeaconConfig().FarFutureEpoch ||
+			currentEpoch != validator.SlashedEpoch-exitLength/2 {
+			continue
+		}
+		penaltyMultiplier := totalPenalties * 3
+		if totalBalance < penaltyMultiplier {
+			penaltyMultiplier = totalBalance
 		}
+		effectiveBalance := helpers.EffectiveBalance(state, uint64(idx))
+		// The product of two balances in Gwei does not fit in 64 bits.
+		penalty := new(big.Int).Mul(
+			new(big.Int).SetUint64(effectiveBalance),
+			new(big.Int).SetUint64(penaltyMultiplier),
+		)
+		penalty.Div(penalty, new(big.Int).SetUint64(totalBalance))
+		minPenalty := new(big.Int).SetUint64(effectiveBalance / params.BeaconConfig().MinPenaltyQuotient)
+		if penalty.Cmp(minPenalty) < 0 {
+			penalty = minPenalty
+		}
+		if penalty.Cmp(new(big.Int).SetUint64(state.ValidatorBalances[idx])) > 0 {
+			state.ValidatorBalances[idx] = 0
+			continue
+		}
+		state.ValidatorBalances[idx] -= penalty.Uint64()
 	}
-	allIndices := allValidatorsIndices(state)
+	return state
+}
+
+// ProcessExitQueue prepares the exited validators which waited long enough for
+// withdrawal, at most MAX_EXIT_DEQUEUES_PER_EPOCH of them per epoch.
+//
+// Spec pseudocode definition:
+// def process_exit_queue(state: BeaconState) -> None:
+//    """
+//    Process the exit queue.
+//    Note that this function mutates ``state``.
+//    """
+//    def eligible(index):
+//        validator = state.validator_registry[index]
+//        # Filter out dequeued validators
+//        if validator.withdrawable_epoch != FAR_FUTURE_EPOCH:
+//            return False
+//        # Dequeue if the minimum amount of time has passed
+//        else:
+//            return get_current_epoch(state) >= validator.exit_epoch + MIN_VALIDATOR_WITHDRAWABILITY_DELAY
+//
+//    eligible_indices = filter(eligible, list(range(len(state.validator_registry))))
+//    # Sort in order of exit epoch, and validators that exit within the same epoch exit in order of validator index
+//    sorted_indices = sorted(eligible_indices, key=lambda index: state.validator_registry[index].exit_epoch)
+//    for dequeues, index in enumerate(sorted_indices):
+//        if dequeues >= MAX_EXIT_DEQUEUES_PER_EPOCH:
+//            break
+//        prepare_validator_for_withdrawal(state, index)
+func ProcessExitQueue(state *pb.BeaconState) *pb.BeaconState {
 	var eligibleIndices []uint64
-	for _, idx := range allIndices {
+	for _, idx := range allValidatorsIndices(state) {
 		if eligibleToExit(state, idx) {
 			eligibleIndices = append(eligibleIndices, idx)
 		}
 	}
-	var withdrawnSoFar uint64
-	for _, idx := range eligibleIndices {
-		state = prepareValidatorForWithdrawal(state, idx)
-		withdrawnSoFar++
-		if withdrawnSoFar >= params.BeaconConfig().MaxExitDequeuesPerEpoch {
+	sort.SliceStable(eligibleIndices, func(i, j int) bool {
+		return state.ValidatorRegistry[eligibleIndices[i]].ExitEpoch <
+			state.ValidatorRegistry[eligibleIndices[j]].ExitEpoch
+	})
+	for dequeues, idx := range eligibleIndices {
+		if uint64(dequeues) >= params.BeaconConfig().MaxExitDequeuesPerEpoch {
 			break
 		}
+		state = prepareValidatorForWithdrawal(state, idx)
 	}
 	return state
 }
@@ -526,30 +554,38 @@ func maxBalanceChurn(totalBalance uint64) uint64 {
 	return params.BeaconConfig().MaxDepositAmount
 }
 
-// eligibleToExit checks if a validator is eligible to exit whether it was
-// slashed or not.
+// eligibleToExit checks if a validator which has not been dequeued yet is eligible
+// for withdrawal. Slashed validators become eligible at their SlashedEpoch, which is
+// set LATEST_SLASHED_EXIT_LENGTH epochs after they were slashed.
 //
 // Spec pseudocode definition:
 // def eligible(index):
 //    validator = state.validator_registry[index]
-//    if validator.slashed_epoch <= current_epoch:
-//         slashed_withdrawal_epochs = LATEST_PENALIZED_EXIT_LENGTH // 2
-//        return current_epoch >= validator.slashed_epoch + slashd_withdrawal_epochs
+//    # Filter out dequeued validators
+//    if validator.withdrawable_epoch != FAR_FUTURE_EPOCH:
+//        return False
+//    # Dequeue if the minimum amount of time has passed
 //    else:
-//        return current_epoch >= validator.exit_epoch + MIN_VALIDATOR_WITHDRAWAL_DELAY
+//        return get_current_epoch(state) >= validator.exit_epoch + MIN_VALIDATOR_WITHDRAWABILITY_DELAY
 func eligibleToExit(state *pb.BeaconState, idx uint64) bool {
 	currentEpoch := helpers.CurrentEpoch(state)
 	validator := state.ValidatorRegistry[idx]
 
-	if validator.SlashedEpoch <= currentEpoch {
-		slashedWithdrawalEpochs := params.BeaconConfig().LatestSlashedExitLength / 2
-		return currentEpoch >= validator.SlashedEpoch+slashedWithdrawalEpochs
+	if validator.WithdrawalEpoch != params.BeaconConfig().FarFutureEpoch {
+		return false
+	}
+	if validator.SlashedEpoch != params.BeaconConfig().FarFutureEpoch {
+		return currentEpoch >= validator.SlashedEpoch
+	}
+	if validator.ExitEpoch == params.BeaconConfig().FarFutureEpoch {
+		return false
 	}
 	return currentEpoch >= validator.ExitEpoch+params.BeaconConfig().MinValidatorWithdrawalDelay
 }
 
 // prepareValidatorForWithdrawal sets validator's status flag to
-// WITHDRAWABLE.
+// WITHDRAWABLE, and records the epoch it became withdrawable at. The
+// withdrawal delay was already waited out in the exit queue.
 //
 // Spec pseudocode definition:
 // def prepare_validator_for_withdrawal(state: BeaconState, index: ValidatorIndex) -> None:
@@ -562,6 +598,7 @@ func eligibleToExit(state *pb.BeaconState, idx uint64) bool {
 func prepareValidatorForWithdrawal(state *pb.BeaconState, idx uint64) *pb.BeaconState {
 	state.ValidatorRegistry[idx].StatusFlags |=
 		pb.Validator_WITHDRAWABLE
+	state.ValidatorRegistry[idx].WithdrawalEpoch = helpers.CurrentEpoch(state)
 	return state
 }
 
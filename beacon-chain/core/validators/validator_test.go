@@ -461,69 +461,142 @@ func TestSlashValidator_AlreadyWithdrawn(t *testing.T) {
 	}
 }
 
-func TestProcessPenaltiesExits_NothingHappened(t *testing.T) {
+func TestProcessSlashings_NothingHappened(t *testing.T) {
 	state := &pb.BeaconState{
-		ValidatorBalances: []uint64{params.BeaconConfig().MaxDepositAmount},
+		LatestSlashedBalances: make([]uint64, params.BeaconConfig().LatestSlashedExitLength),
+		ValidatorBalances:     []uint64{params.BeaconConfig().MaxDepositAmount},
 		ValidatorRegistry: []*pb.Validator{
-			{ExitEpoch: params.BeaconConfig().FarFutureEpoch},
+			{ExitEpoch: params.BeaconConfig().FarFutureEpoch, SlashedEpoch: params.BeaconConfig().FarFutureEpoch},
 		},
 	}
-	if ProcessPenaltiesAndExits(state).ValidatorBalances[0] !=
-		params.BeaconConfig().MaxDepositAmount {
+	if ProcessSlashings(state).ValidatorBalances[0] != params.BeaconConfig().MaxDepositAmount {
 		t.Errorf("wanted validator balance %d, got %d",
 			params.BeaconConfig().MaxDepositAmount,
-			ProcessPenaltiesAndExits(state).ValidatorBalances[0])
+			state.ValidatorBalances[0])
 	}
 }
 
-func TestProcessPenaltiesExits_ValidatorSlashed(t *testing.T) {
-
-	latestSlashedExits := make([]uint64, params.BeaconConfig().LatestSlashedExitLength)
-	for i := 0; i < len(latestSlashedExits); i++ {
-		latestSlashedExits[i] = uint64(i) * params.BeaconConfig().MaxDepositAmount
+func TestProcessSlashings_ProportionalPenalty(t *testing.T) {
+	exitLength := params.BeaconConfig().LatestSlashedExitLength
+	maxDeposit := params.BeaconConfig().MaxDepositAmount
+	currentEpoch := exitLength / 2
+	latestSlashedBalances := make([]uint64, exitLength)
+	// One validator out of ten was slashed over the last exit length epochs.
+	latestSlashedBalances[currentEpoch%exitLength] = maxDeposit
+
+	registry := make([]*pb.Validator, 10)
+	balances := make([]uint64, len(registry))
+	for i := range registry {
+		registry[i] = &pb.Validator{
+			ExitEpoch:    params.BeaconConfig().FarFutureEpoch,
+			SlashedEpoch: params.BeaconConfig().FarFutureEpoch,
+		}
+		balances[i] = maxDeposit
 	}
+	registry[0].SlashedEpoch = currentEpoch + exitLength/2
+	registry[0].ExitEpoch = currentEpoch
+	// A validator slashed at another epoch is not penalized yet.
+	registry[1].SlashedEpoch = currentEpoch + exitLength/2 + 1
 
 	state := &pb.BeaconState{
-		Slot:                  params.BeaconConfig().LatestSlashedExitLength / 2 * params.BeaconConfig().SlotsPerEpoch,
-		LatestSlashedBalances: latestSlashedExits,
-		ValidatorBalances:     []uint64{params.BeaconConfig().MaxDepositAmount, params.BeaconConfig().MaxDepositAmount},
-		ValidatorRegistry: []*pb.Validator{
-			{ExitEpoch: params.BeaconConfig().FarFutureEpoch},
-		},
+		Slot:                  currentEpoch * params.BeaconConfig().SlotsPerEpoch,
+		LatestSlashedBalances: latestSlashedBalances,
+		ValidatorBalances:     balances,
+		ValidatorRegistry:     registry,
 	}
+	totalBalance := helpers.TotalBalance(state, helpers.ActiveValidatorIndices(registry, currentEpoch))
+	// The slashed validator already exited, so three times the slashed balance is a third
+	// of the balance of the nine validators still active.
+	penalty := maxDeposit / (totalBalance / (3 * maxDeposit))
 
-	penalty := helpers.EffectiveBalance(state, 0) *
-		helpers.EffectiveBalance(state, 0) /
-		params.BeaconConfig().MaxDepositAmount
-
-	newState := ProcessPenaltiesAndExits(state)
-	if newState.ValidatorBalances[0] != params.BeaconConfig().MaxDepositAmount-penalty {
-		t.Errorf("wanted validator balance %d, got %d",
-			params.BeaconConfig().MaxDepositAmount-penalty,
-			newState.ValidatorBalances[0])
+	state = ProcessSlashings(state)
+	if state.ValidatorBalances[0] != maxDeposit-penalty {
+		t.Errorf("wanted validator balance %d, got %d", maxDeposit-penalty, state.ValidatorBalances[0])
+	}
+	if state.ValidatorBalances[1] != maxDeposit {
+		t.Errorf("wanted validator balance %d, got %d", maxDeposit, state.ValidatorBalances[1])
 	}
 }
 
-func TestEligibleToExit_OK(t *testing.T) {
+func TestProcessSlashings_MinimumPenalty(t *testing.T) {
+	exitLength := params.BeaconConfig().LatestSlashedExitLength
+	maxDeposit := params.BeaconConfig().MaxDepositAmount
+	currentEpoch := exitLength / 2
+
 	state := &pb.BeaconState{
-		Slot: 1,
+		Slot:                  currentEpoch * params.BeaconConfig().SlotsPerEpoch,
+		LatestSlashedBalances: make([]uint64, exitLength),
+		ValidatorBalances:     []uint64{maxDeposit, maxDeposit},
 		ValidatorRegistry: []*pb.Validator{
-			{ExitEpoch: params.BeaconConfig().ActivationExitDelay},
+			{ExitEpoch: currentEpoch, SlashedEpoch: currentEpoch + exitLength/2},
+			{ExitEpoch: params.BeaconConfig().FarFutureEpoch, SlashedEpoch: params.BeaconConfig().FarFutureEpoch},
 		},
 	}
-	if eligibleToExit(state, 0) {
-		t.Error("eligible to exit should be true but got false")
+	want := maxDeposit - maxDeposit/params.BeaconConfig().MinPenaltyQuotient
+	if ProcessSlashings(state).ValidatorBalances[0] != want {
+		t.Errorf("wanted validator balance %d, got %d", want, state.ValidatorBalances[0])
 	}
+}
 
-	state = &pb.BeaconState{
-		Slot: params.BeaconConfig().MinValidatorWithdrawalDelay,
-		ValidatorRegistry: []*pb.Validator{
-			{ExitEpoch: params.BeaconConfig().ActivationExitDelay,
-				SlashedEpoch: 1},
-		},
+func TestEligibleToExit_OK(t *testing.T) {
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	delay := params.BeaconConfig().MinValidatorWithdrawalDelay
+	tests := []struct {
+		validator *pb.Validator
+		eligible  bool
+	}{
+		{&pb.Validator{ExitEpoch: farFuture, SlashedEpoch: farFuture, WithdrawalEpoch: farFuture}, false},
+		{&pb.Validator{ExitEpoch: 2, SlashedEpoch: farFuture, WithdrawalEpoch: farFuture}, false},
+		{&pb.Validator{ExitEpoch: 1, SlashedEpoch: farFuture, WithdrawalEpoch: farFuture}, true},
+		{&pb.Validator{ExitEpoch: 1, SlashedEpoch: farFuture, WithdrawalEpoch: delay}, false},
+		{&pb.Validator{ExitEpoch: 1, SlashedEpoch: delay + 2, WithdrawalEpoch: farFuture}, false},
+		{&pb.Validator{ExitEpoch: 1, SlashedEpoch: delay + 1, WithdrawalEpoch: farFuture}, true},
+	}
+	for i, tt := range tests {
+		state := &pb.BeaconState{
+			Slot:              (delay + 1) * params.BeaconConfig().SlotsPerEpoch,
+			ValidatorRegistry: []*pb.Validator{tt.validator},
+		}
+		if eligibleToExit(state, 0) != tt.eligible {
+			t.Errorf("%d: wanted eligible to exit %t, got %t", i, tt.eligible, !tt.eligible)
+		}
+	}
+}
+
+func TestProcessExitQueue_DequeuesByExitEpoch(t *testing.T) {
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	currentEpoch := params.BeaconConfig().MinValidatorWithdrawalDelay + 10
+	maxDequeues := params.BeaconConfig().MaxExitDequeuesPerEpoch
+
+	registry := make([]*pb.Validator, maxDequeues+2)
+	for i := range registry {
+		registry[i] = &pb.Validator{
+			ExitEpoch:       uint64(len(registry) - i),
+			SlashedEpoch:    farFuture,
+			WithdrawalEpoch: farFuture,
+		}
 	}
-	if eligibleToExit(state, 0) {
-		t.Error("eligible to exit should be true but got false")
+	// Validators which did not exit are never dequeued.
+	registry[0].ExitEpoch = farFuture
+
+	state := &pb.BeaconState{
+		Slot:              currentEpoch * params.BeaconConfig().SlotsPerEpoch,
+		ValidatorRegistry: registry,
+	}
+	state = ProcessExitQueue(state)
+
+	for i, validator := range state.ValidatorRegistry {
+		// The validators with the lowest exit epochs are at the end of the registry.
+		withdrawable := i >= len(registry)-int(maxDequeues)
+		if (validator.StatusFlags == pb.Validator_WITHDRAWABLE) != withdrawable {
+			t.Errorf("validator %d: wanted withdrawable %t, got status %v", i, withdrawable, validator.StatusFlags)
+		}
+		if withdrawable && validator.WithdrawalEpoch != currentEpoch {
+			t.Errorf("validator %d: wanted withdrawal epoch %d, got %d", i, currentEpoch, validator.WithdrawalEpoch)
+		}
+		if !withdrawable && validator.WithdrawalEpoch != farFuture {
+			t.Errorf("validator %d: wanted withdrawal epoch %d, got %d", i, farFuture, validator.WithdrawalEpoch)
+		}
 	}
 }
 
@@ -1,6 +1,7 @@
 package db
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -68,22 +69,33 @@ func (db *BeaconDB) SaveBlock(block *pb.BeaconBlock) error {
 	if err != nil {
 		return fmt.Errorf("failed to encode block: %v", err)
 	}
-	slotBinary := encodeSlotNumber(block.Slot)
-
 	if block.Slot > db.highestBlockSlot {
 		db.highestBlockSlot = block.Slot
 	}
 
+	// The main chain is only written by UpdateChainHead and ReorgMainChain, as the
+	// block may belong to a fork which does not become canonical.
 	return db.update(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(blockBucket)
-		mainChain := tx.Bucket(mainChainBucket)
-		if err := mainChain.Put(slotBinary, root[:]); err != nil {
-			return fmt.Errorf("failed to include the block in the main chain bucket: %v", err)
+		if err := indexBlock(tx, block, root[:]); err != nil {
+			return err
 		}
 		return bucket.Put(root[:], enc)
 	})
 }
 
+// indexBlock adds the parent root and slot index entries of a block.
+func indexBlock(tx *bolt.Tx, block *pb.BeaconBlock, root []byte) error {
+	parentKey := append(append([]byte{}, block.ParentRootHash32...), root...)
+	if err := tx.Bucket(blockParentIndexBucket).Put(parentKey, []byte{}); err != nil {
+		return fmt.Errorf("failed to index the block by parent root: %v", err)
+	}
+	if err := tx.Bucket(blockSlotIndexBucket).Put(append(encodeSlotNumber(block.Slot), root...), []byte{}); err != nil {
+		return fmt.Errorf("failed to index the block by slot: %v", err)
+	}
+	return nil
+}
+
 // DeleteBlock deletes a block using the slot and its root as keys in their respective buckets.
 func (db *BeaconDB) DeleteBlock(block *pb.BeaconBlock) error {
 	root, err := hashutil.HashBeaconBlock(block)
@@ -95,8 +107,18 @@ func (db *BeaconDB) DeleteBlock(block *pb.BeaconBlock) error {
 	return db.update(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(blockBucket)
 		mainChain := tx.Bucket(mainChainBucket)
-		if err := mainChain.Delete(slotBinary); err != nil {
-			return fmt.Errorf("failed to include the block in the main chain bucket: %v", err)
+		// Another fork's block may own the slot in the main chain.
+		if bytes.Equal(mainChain.Get(slotBinary), root[:]) {
+			if err := mainChain.Delete(slotBinary); err != nil {
+				return fmt.Errorf("failed to remove the block from the main chain bucket: %v", err)
+			}
+		}
+		parentKey := append(append([]byte{}, block.ParentRootHash32...), root[:]...)
+		if err := tx.Bucket(blockParentIndexBucket).Delete(parentKey); err != nil {
+			return fmt.Errorf("failed to remove the block from the parent root index: %v", err)
+		}
+		if err := tx.Bucket(blockSlotIndexBucket).Delete(append(slotBinary, root[:]...)); err != nil {
+			return fmt.Errorf("failed to remove the block from the slot index: %v", err)
 		}
 		return bucket.Delete(root[:])
 	})
@@ -168,14 +190,18 @@ func (db *BeaconDB) ChainHead() (*pb.BeaconBlock, error) {
 		mainChain := tx.Bucket(mainChainBucket)
 		blockBkt := tx.Bucket(blockBucket)
 
-		height := chainInfo.Get(mainChainHeightKey)
-		if height == nil {
-			return errors.New("unable to determine chain height")
-		}
-
-		blockRoot := mainChain.Get(height)
+		// Blocks saved after the head may own its slot in the main chain, the head root
+		// is only looked up by height for databases which did not record it.
+		blockRoot := chainInfo.Get(mainChainHeadRootKey)
 		if blockRoot == nil {
-			return fmt.Errorf("root at the current height not found: %d", height)
+			height := chainInfo.Get(mainChainHeightKey)
+			if height == nil {
+				return errors.New("unable to determine chain height")
+			}
+			blockRoot = mainChain.Get(height)
+			if blockRoot == nil {
+				return fmt.Errorf("root at the current height not found: %d", height)
+			}
 		}
 
 		enc := blockBkt.Get(blockRoot)
@@ -228,6 +254,45 @@ func (db *BeaconDB) UpdateChainHead(ctx context.Context, block *pb.BeaconBlock,
 			return fmt.Errorf("failed to record the block as the head of the main chain: %v", err)
 		}
 
+		if err := chainInfo.Put(mainChainHeadRootKey, blockRoot[:]); err != nil {
+			return fmt.Errorf("failed to record the block root as the head of the main chain: %v", err)
+		}
+
+		return nil
+	})
+}
+
+// ReorgMainChain removes the blocks orphaned by a chain reorganization from the main chain
+// and replaces them with the blocks of the new canonical branch. Orphaned slots which the
+// main chain already maps to another block are left untouched.
+func (db *BeaconDB) ReorgMainChain(ctx context.Context, orphaned []*pb.BeaconBlock, canonical []*pb.BeaconBlock) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.ReorgMainChain")
+	defer span.End()
+
+	return db.update(func(tx *bolt.Tx) error {
+		mainChain := tx.Bucket(mainChainBucket)
+		for _, block := range orphaned {
+			root, err := hashutil.HashBeaconBlock(block)
+			if err != nil {
+				return fmt.Errorf("unable to tree hash block: %v", err)
+			}
+			slotBinary := encodeSlotNumber(block.Slot)
+			if !bytes.Equal(mainChain.Get(slotBinary), root[:]) {
+				continue
+			}
+			if err := mainChain.Delete(slotBinary); err != nil {
+				return fmt.Errorf("failed to remove the block from the main chain bucket: %v", err)
+			}
+		}
+		for _, block := range canonical {
+			root, err := hashutil.HashBeaconBlock(block)
+			if err != nil {
+				return fmt.Errorf("unable to tree hash block: %v", err)
+			}
+			if err := mainChain.Put(encodeSlotNumber(block.Slot), root[:]); err != nil {
+				return fmt.Errorf("failed to include the block in the main chain bucket: %v", err)
+			}
+		}
 		return nil
 	})
 }
@@ -264,6 +329,50 @@ func (db *BeaconDB) BlockBySlot(ctx context.Context, slot uint64) (*pb.BeaconBlo
 	return block, err
 }
 
+// BlocksBySlot returns every saved block with the given slot, whichever fork
+// they belong to.
+func (db *BeaconDB) BlocksBySlot(ctx context.Context, slot uint64) ([]*pb.BeaconBlock, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.BlocksBySlot")
+	defer span.End()
+	span.AddAttributes(trace.Int64Attribute("slot", int64(slot-params.BeaconConfig().GenesisSlot)))
+
+	return db.indexedBlocks(blockSlotIndexBucket, encodeSlotNumber(slot))
+}
+
+// BlocksByParent returns every saved block whose parent is the block with the given root.
+func (db *BeaconDB) BlocksByParent(ctx context.Context, parentRoot [32]byte) ([]*pb.BeaconBlock, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.BlocksByParent")
+	defer span.End()
+
+	return db.indexedBlocks(blockParentIndexBucket, parentRoot[:])
+}
+
+// indexedBlocks returns the blocks of an index bucket whose keys are the prefix
+// followed by the block root.
+func (db *BeaconDB) indexedBlocks(index []byte, prefix []byte) ([]*pb.BeaconBlock, error) {
+	var blocks []*pb.BeaconBlock
+	err := db.view(func(tx *bolt.Tx) error {
+		blockBkt := tx.Bucket(blockBucket)
+		c := tx.Bucket(index).Cursor()
+		for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+			if len(k) != len(prefix)+32 {
+				continue
+			}
+			enc := blockBkt.Get(k[len(prefix):])
+			if enc == nil {
+				continue
+			}
+			block, err := createBlock(enc)
+			if err != nil {
+				return err
+			}
+			blocks = append(blocks, block)
+		}
+		return nil
+	})
+	return blocks, err
+}
+
 // HighestBlockSlot returns the in-memory value for the highest block we've
 // seen in the database.
 func (db *BeaconDB) HighestBlockSlot() uint64 {
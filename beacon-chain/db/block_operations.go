@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/boltdb/bolt"
 	"github.com/gogo/protobuf/proto"
@@ -41,3 +42,154 @@ func (db *BeaconDB) HasExit(hash [32]byte) bool {
 	}
 	return exists
 }
+
+// DeleteExit deletes the exit request from the beacon chain db.
+func (db *BeaconDB) DeleteExit(exit *pb.VoluntaryExit) error {
+	hash, err := hashutil.HashProto(exit)
+	if err != nil {
+		return err
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(blockOperationsBucket)
+		return b.Delete(hash[:])
+	})
+}
+
+// Exits retrieves all the exit requests from the beacon chain db.
+func (db *BeaconDB) Exits() ([]*pb.VoluntaryExit, error) {
+	var exits []*pb.VoluntaryExit
+	err := db.view(func(tx *bolt.Tx) error {
+		b := tx.Bucket(blockOperationsBucket)
+		return b.ForEach(func(k, v []byte) error {
+			exit := &pb.VoluntaryExit{}
+			if err := proto.Unmarshal(v, exit); err != nil {
+				return fmt.Errorf("failed to unmarshal encoding: %v", err)
+			}
+			exits = append(exits, exit)
+			return nil
+		})
+	})
+	return exits, err
+}
+
+// SaveProposerSlashing puts the proposer slashing into the beacon chain db.
+func (db *BeaconDB) SaveProposerSlashing(ctx context.Context, slashing *pb.ProposerSlashing) error {
+	ctx, span := trace.StartSpan(ctx, "beaconDB.SaveProposerSlashing")
+	defer span.End()
+
+	hash, err := hashutil.HashProto(slashing)
+	if err != nil {
+		return err
+	}
+	encodedSlashing, err := proto.Marshal(slashing)
+	if err != nil {
+		return err
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(proposerSlashingBucket)
+		return b.Put(hash[:], encodedSlashing)
+	})
+}
+
+// HasProposerSlashing checks if the proposer slashing exists.
+func (db *BeaconDB) HasProposerSlashing(hash [32]byte) bool {
+	exists := false
+	if err := db.view(func(tx *bolt.Tx) error {
+		b := tx.Bucket(proposerSlashingBucket)
+		exists = b.Get(hash[:]) != nil
+		return nil
+	}); err != nil {
+		return false
+	}
+	return exists
+}
+
+// DeleteProposerSlashing deletes the proposer slashing from the beacon chain db.
+func (db *BeaconDB) DeleteProposerSlashing(slashing *pb.ProposerSlashing) error {
+	hash, err := hashutil.HashProto(slashing)
+	if err != nil {
+		return err
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(proposerSlashingBucket)
+		return b.Delete(hash[:])
+	})
+}
+
+// ProposerSlashings retrieves all the proposer slashings from the beacon chain db.
+func (db *BeaconDB) ProposerSlashings() ([]*pb.ProposerSlashing, error) {
+	var slashings []*pb.ProposerSlashing
+	err := db.view(func(tx *bolt.Tx) error {
+		b := tx.Bucket(proposerSlashingBucket)
+		return b.ForEach(func(k, v []byte) error {
+			slashing := &pb.ProposerSlashing{}
+			if err := proto.Unmarshal(v, slashing); err != nil {
+				return fmt.Errorf("failed to unmarshal encoding: %v", err)
+			}
+			slashings = append(slashings, slashing)
+			return nil
+		})
+	})
+	return slashings, err
+}
+
+// SaveAttesterSlashing puts the attester slashing into the beacon chain db.
+func (db *BeaconDB) SaveAttesterSlashing(ctx context.Context, slashing *pb.AttesterSlashing) error {
+	ctx, span := trace.StartSpan(ctx, "beaconDB.SaveAttesterSlashing")
+	defer span.End()
+
+	hash, err := hashutil.HashProto(slashing)
+	if err != nil {
+		return err
+	}
+	encodedSlashing, err := proto.Marshal(slashing)
+	if err != nil {
+		return err
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(attesterSlashingBucket)
+		return b.Put(hash[:], encodedSlashing)
+	})
+}
+
+// HasAttesterSlashing checks if the attester slashing exists.
+func (db *BeaconDB) HasAttesterSlashing(hash [32]byte) bool {
+	exists := false
+	if err := db.view(func(tx *bolt.Tx) error {
+		b := tx.Bucket(attesterSlashingBucket)
+		exists = b.Get(hash[:]) != nil
+		return nil
+	}); err != nil {
+		return false
+	}
+	return exists
+}
+
+// DeleteAttesterSlashing deletes the attester slashing from the beacon chain db.
+func (db *BeaconDB) DeleteAttesterSlashing(slashing *pb.AttesterSlashing) error {
+	hash, err := hashutil.HashProto(slashing)
+	if err != nil {
+		return err
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(attesterSlashingBucket)
+		return b.Delete(hash[:])
+	})
+}
+
+// AttesterSlashings retrieves all the attester slashings from the beacon chain db.
+func (db *BeaconDB) AttesterSlashings() ([]*pb.AttesterSlashing, error) {
+	var slashings []*pb.AttesterSlashing
+	err := db.view(func(tx *bolt.Tx) error {
+		b := tx.Bucket(attesterSlashingBucket)
+		return b.ForEach(func(k, v []byte) error {
+			slashing := &pb.AttesterSlashing{}
+			if err := proto.Unmarshal(v, slashing); err != nil {
+				return fmt.Errorf("failed to unmarshal encoding: %v", err)
+			}
+			slashings = append(slashings, slashing)
+			return nil
+		})
+	})
+	return slashings, err
+}
@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"reflect"
 	"testing"
 
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -31,3 +32,108 @@ func TestBeaconDB_HasExit(t *testing.T) {
 		t.Fatal("Expected HasExit to return true")
 	}
 }
+
+func TestBeaconDB_ExitsAndDeleteExit(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	exits := []*pb.VoluntaryExit{
+		{Epoch: 100, ValidatorIndex: 1},
+		{Epoch: 100, ValidatorIndex: 2},
+	}
+	for _, exit := range exits {
+		if err := db.SaveExit(context.Background(), exit); err != nil {
+			t.Fatalf("Failed to save exit request: %v", err)
+		}
+	}
+	saved, err := db.Exits()
+	if err != nil {
+		t.Fatalf("Could not retrieve exit requests: %v", err)
+	}
+	if len(saved) != len(exits) {
+		t.Fatalf("Expected %d exit requests, received %d", len(exits), len(saved))
+	}
+
+	if err := db.DeleteExit(exits[0]); err != nil {
+		t.Fatalf("Could not delete exit request: %v", err)
+	}
+	saved, err = db.Exits()
+	if err != nil {
+		t.Fatalf("Could not retrieve exit requests: %v", err)
+	}
+	if !reflect.DeepEqual(saved, exits[1:]) {
+		t.Errorf("Expected remaining exit requests %v, received %v", exits[1:], saved)
+	}
+}
+
+func TestBeaconDB_ProposerSlashings(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	slashings := []*pb.ProposerSlashing{
+		{ProposerIndex: 1},
+		{ProposerIndex: 2},
+	}
+	hash, err := hashutil.HashProto(slashings[0])
+	if err != nil {
+		t.Fatalf("Could not hash proposer slashing: %v", err)
+	}
+	if db.HasProposerSlashing(hash) {
+		t.Fatal("Expected HasProposerSlashing to return false")
+	}
+	for _, slashing := range slashings {
+		if err := db.SaveProposerSlashing(context.Background(), slashing); err != nil {
+			t.Fatalf("Failed to save proposer slashing: %v", err)
+		}
+	}
+	if !db.HasProposerSlashing(hash) {
+		t.Fatal("Expected HasProposerSlashing to return true")
+	}
+
+	if err := db.DeleteProposerSlashing(slashings[0]); err != nil {
+		t.Fatalf("Could not delete proposer slashing: %v", err)
+	}
+	saved, err := db.ProposerSlashings()
+	if err != nil {
+		t.Fatalf("Could not retrieve proposer slashings: %v", err)
+	}
+	if !reflect.DeepEqual(saved, slashings[1:]) {
+		t.Errorf("Expected remaining proposer slashings %v, received %v", slashings[1:], saved)
+	}
+}
+
+func TestBeaconDB_AttesterSlashings(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	slashings := []*pb.AttesterSlashing{
+		{SlashableAttestation_1: &pb.SlashableAttestation{ValidatorIndices: []uint64{1}}},
+		{SlashableAttestation_1: &pb.SlashableAttestation{ValidatorIndices: []uint64{2}}},
+	}
+	hash, err := hashutil.HashProto(slashings[0])
+	if err != nil {
+		t.Fatalf("Could not hash attester slashing: %v", err)
+	}
+	if db.HasAttesterSlashing(hash) {
+		t.Fatal("Expected HasAttesterSlashing to return false")
+	}
+	for _, slashing := range slashings {
+		if err := db.SaveAttesterSlashing(context.Background(), slashing); err != nil {
+			t.Fatalf("Failed to save attester slashing: %v", err)
+		}
+	}
+	if !db.HasAttesterSlashing(hash) {
+		t.Fatal("Expected HasAttesterSlashing to return true")
+	}
+
+	if err := db.DeleteAttesterSlashing(slashings[0]); err != nil {
+		t.Fatalf("Could not delete attester slashing: %v", err)
+	}
+	saved, err := db.AttesterSlashings()
+	if err != nil {
+		t.Fatalf("Could not retrieve attester slashings: %v", err)
+	}
+	if !reflect.DeepEqual(saved, slashings[1:]) {
+		t.Errorf("Expected remaining attester slashings %v, received %v", slashings[1:], saved)
+	}
+}
@@ -106,6 +106,81 @@ func TestDeleteBlock_OK(t *testing.T) {
 	}
 }
 
+func TestBlocksByParentAndSlot_CompetingForks(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	parent := &pb.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot}
+	parentRoot, err := hashutil.HashBeaconBlock(parent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slot := params.BeaconConfig().GenesisSlot + 1
+	block1 := &pb.BeaconBlock{Slot: slot, ParentRootHash32: parentRoot[:], RandaoReveal: []byte{'A'}}
+	block2 := &pb.BeaconBlock{Slot: slot, ParentRootHash32: parentRoot[:], RandaoReveal: []byte{'B'}}
+	other := &pb.BeaconBlock{Slot: slot + 1, ParentRootHash32: []byte{'C'}}
+	for _, block := range []*pb.BeaconBlock{parent, block1, block2, other} {
+		if err := db.SaveBlock(block); err != nil {
+			t.Fatalf("Could not save block: %v", err)
+		}
+	}
+
+	children, err := db.BlocksByParent(ctx, parentRoot)
+	if err != nil {
+		t.Fatalf("Could not get blocks by parent: %v", err)
+	}
+	if len(children) != 2 {
+		t.Fatalf("Expected 2 children, received %d", len(children))
+	}
+	atSlot, err := db.BlocksBySlot(ctx, slot)
+	if err != nil {
+		t.Fatalf("Could not get blocks by slot: %v", err)
+	}
+	if len(atSlot) != 2 {
+		t.Fatalf("Expected 2 blocks at slot %d, received %d", slot, len(atSlot))
+	}
+	for _, wanted := range []*pb.BeaconBlock{block1, block2} {
+		var inChildren, inSlot bool
+		for i := range children {
+			inChildren = inChildren || proto.Equal(children[i], wanted)
+			inSlot = inSlot || proto.Equal(atSlot[i], wanted)
+		}
+		if !inChildren || !inSlot {
+			t.Errorf("Expected block %v to be indexed by parent and slot", wanted)
+		}
+	}
+
+	// block2 owns the slot in the main chain, deleting block1 must not remove it.
+	if err := db.ReorgMainChain(ctx, nil, []*pb.BeaconBlock{block2}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.DeleteBlock(block1); err != nil {
+		t.Fatal(err)
+	}
+	mainBlock, err := db.BlockBySlot(ctx, slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(mainBlock, block2) {
+		t.Errorf("Expected block %v at slot %d, received %v", block2, slot, mainBlock)
+	}
+	children, err = db.BlocksByParent(ctx, parentRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(children) != 1 || !proto.Equal(children[0], block2) {
+		t.Errorf("Expected only %v as child after deletion, received %v", block2, children)
+	}
+	atSlot, err = db.BlocksBySlot(ctx, slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(atSlot) != 1 || !proto.Equal(atSlot[0], block2) {
+		t.Errorf("Expected only %v at slot %d after deletion, received %v", block2, slot, atSlot)
+	}
+}
+
 func TestBlockBySlotEmptyChain_OK(t *testing.T) {
 	db := setupDB(t)
 	defer teardownDB(t, db)
@@ -206,6 +281,73 @@ func TestUpdateChainHead_OK(t *testing.T) {
 	}
 }
 
+func TestChainHead_SameSlotBlockSavedAfterHead(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	slot := params.BeaconConfig().GenesisSlot + 1
+	head := &pb.BeaconBlock{Slot: slot, RandaoReveal: []byte{'A'}}
+	if err := db.SaveBlock(head); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(ctx, head, &pb.BeaconState{Slot: slot}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveBlock(&pb.BeaconBlock{Slot: slot, RandaoReveal: []byte{'B'}}); err != nil {
+		t.Fatal(err)
+	}
+
+	chainHead, err := db.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(chainHead, head) {
+		t.Errorf("Expected chain head %v, received %v", head, chainHead)
+	}
+}
+
+func TestReorgMainChain_OK(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+	genesisSlot := params.BeaconConfig().GenesisSlot
+
+	// Blocks of the old chain at slots 1 and 2, the new chain has a block at slot 1
+	// and skips slot 2.
+	orphaned := []*pb.BeaconBlock{
+		{Slot: genesisSlot + 2, RandaoReveal: []byte{'A'}},
+		{Slot: genesisSlot + 1, RandaoReveal: []byte{'A'}},
+	}
+	canonical := []*pb.BeaconBlock{{Slot: genesisSlot + 1, RandaoReveal: []byte{'B'}}}
+	for _, block := range append(canonical, orphaned...) {
+		if err := db.SaveBlock(block); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.ReorgMainChain(ctx, nil, orphaned); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.ReorgMainChain(ctx, orphaned, canonical); err != nil {
+		t.Fatalf("Could not reorg main chain: %v", err)
+	}
+	block, err := db.BlockBySlot(ctx, genesisSlot+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(block, canonical[0]) {
+		t.Errorf("Expected block %v in the main chain, received %v", canonical[0], block)
+	}
+	block, err = db.BlockBySlot(ctx, genesisSlot+2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if block != nil {
+		t.Errorf("Expected orphaned slot to be removed from the main chain, received %v", block)
+	}
+}
+
 func TestChainProgress_OK(t *testing.T) {
 	db := setupDB(t)
 	defer teardownDB(t, db)
@@ -0,0 +1,124 @@
+package db
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// BlockDatabase defines the methods which store and retrieve beacon blocks,
+// and keep track of the main chain and its head.
+type BlockDatabase interface {
+	Block(root [32]byte) (*pb.BeaconBlock, error)
+	HasBlock(root [32]byte) bool
+	SaveBlock(block *pb.BeaconBlock) error
+	DeleteBlock(block *pb.BeaconBlock) error
+	BlockBySlot(ctx context.Context, slot uint64) (*pb.BeaconBlock, error)
+	BlocksBySlot(ctx context.Context, slot uint64) ([]*pb.BeaconBlock, error)
+	BlocksByParent(ctx context.Context, parentRoot [32]byte) ([]*pb.BeaconBlock, error)
+	HighestBlockSlot() uint64
+	ChainHead() (*pb.BeaconBlock, error)
+	UpdateChainHead(ctx context.Context, block *pb.BeaconBlock, beaconState *pb.BeaconState) error
+	ReorgMainChain(ctx context.Context, orphaned []*pb.BeaconBlock, canonical []*pb.BeaconBlock) error
+	JustifiedBlock() (*pb.BeaconBlock, error)
+	SaveJustifiedBlock(block *pb.BeaconBlock) error
+	FinalizedBlock() (*pb.BeaconBlock, error)
+	SaveFinalizedBlock(block *pb.BeaconBlock) error
+}
+
+// StateDatabase defines the methods which store and retrieve the head, justified,
+// finalized and historical beacon states.
+type StateDatabase interface {
+	InitializeState(ctx context.Context, genesisTime uint64, deposits []*pb.Deposit, eth1Data *pb.Eth1Data) error
+	HeadState(ctx context.Context) (*pb.BeaconState, error)
+	SaveState(ctx context.Context, beaconState *pb.BeaconState) error
+	JustifiedState() (*pb.BeaconState, error)
+	SaveJustifiedState(beaconState *pb.BeaconState) error
+	FinalizedState() (*pb.BeaconState, error)
+	SaveFinalizedState(beaconState *pb.BeaconState) error
+	SetHistoricalStateRetention(retention HistoricalStateRetention)
+	SaveHistoricalState(ctx context.Context, beaconState *pb.BeaconState) error
+	HistoricalStateFromSlot(ctx context.Context, slot uint64) (*pb.BeaconState, error)
+}
+
+// AttestationDatabase defines the methods which store the attestations and the
+// other block operations which have not been included in a block yet.
+type AttestationDatabase interface {
+	Attestation(hash [32]byte) (*pb.Attestation, error)
+	Attestations() ([]*pb.Attestation, error)
+	HasAttestation(hash [32]byte) bool
+	SaveAttestation(ctx context.Context, attestation *pb.Attestation) error
+	DeleteAttestation(attestation *pb.Attestation) error
+	Exits() ([]*pb.VoluntaryExit, error)
+	HasExit(hash [32]byte) bool
+	SaveExit(ctx context.Context, exit *pb.VoluntaryExit) error
+	DeleteExit(exit *pb.VoluntaryExit) error
+	ProposerSlashings() ([]*pb.ProposerSlashing, error)
+	HasProposerSlashing(hash [32]byte) bool
+	SaveProposerSlashing(ctx context.Context, slashing *pb.ProposerSlashing) error
+	DeleteProposerSlashing(slashing *pb.ProposerSlashing) error
+	AttesterSlashings() ([]*pb.AttesterSlashing, error)
+	HasAttesterSlashing(hash [32]byte) bool
+	SaveAttesterSlashing(ctx context.Context, slashing *pb.AttesterSlashing) error
+	DeleteAttesterSlashing(slashing *pb.AttesterSlashing) error
+}
+
+// ValidatorDatabase defines the methods which map validator public keys to their
+// index in the validator registry.
+type ValidatorDatabase interface {
+	ValidatorIndex(pubKey []byte) (uint64, error)
+	HasValidator(pubKey []byte) bool
+	HasAllValidators(pubKeys [][]byte) bool
+	HasAnyValidators(pubKeys [][]byte) bool
+	SaveValidatorIndex(pubKey []byte, index int) error
+	SaveValidatorIndexBatch(pubKey []byte, index int) error
+	DeleteValidatorIndex(pubKey []byte) error
+}
+
+// DepositDatabase defines the methods which keep track of the deposits observed
+// in the deposit contract on the proof of work chain.
+type DepositDatabase interface {
+	InsertDeposit(ctx context.Context, d *pb.Deposit, blockNum *big.Int) error
+	AllDeposits(ctx context.Context, beforeBlk *big.Int) []*pb.Deposit
+	InsertPendingDeposit(ctx context.Context, d *pb.Deposit, blockNum *big.Int) error
+	PendingDeposits(ctx context.Context, beforeBlk *big.Int) []*pb.Deposit
+	RemovePendingDeposit(ctx context.Context, d *pb.Deposit) error
+	PrunePendingDeposits(ctx context.Context, merkleTreeIndex uint64) error
+	MarkPubkeyForChainstart(ctx context.Context, pubkey string) error
+	PubkeyInChainstart(ctx context.Context, pubkey string) bool
+	SaveLastProcessedEth1Block(ctx context.Context, blockNum *big.Int) error
+	LastProcessedEth1Block(ctx context.Context) (*big.Int, error)
+	VerifyContractAddress(ctx context.Context, addr common.Address) error
+}
+
+// SlasherDatabase defines the methods which keep the proposals and votes observed
+// by the slasher, and the attestations they were taken from.
+type SlasherDatabase interface {
+	SaveProposalRecord(ctx context.Context, slot uint64, proposerIndex uint64, record *ProposalRecord) error
+	ProposalRecord(slot uint64, proposerIndex uint64) (*ProposalRecord, error)
+	SaveSlashableAttestation(ctx context.Context, targetEpoch uint64, att *pb.SlashableAttestation) error
+	SlashableAttestation(targetEpoch uint64, hash [32]byte) (*pb.SlashableAttestation, error)
+	SaveValidatorVote(ctx context.Context, validatorIndex uint64, vote *ValidatorVote) error
+	ValidatorVotes(validatorIndex uint64) ([]*ValidatorVote, error)
+	PruneSlasherHistory(epoch uint64) error
+}
+
+// Database defines the storage backend of the beacon chain, which the services of
+// the beacon node depend on. BeaconDB persists it in a bolt database, while
+// InMemoryDB keeps it in memory for tests and simulations.
+type Database interface {
+	BlockDatabase
+	StateDatabase
+	AttestationDatabase
+	ValidatorDatabase
+	DepositDatabase
+	SlasherDatabase
+	Close() error
+}
+
+var (
+	_ Database = (*BeaconDB)(nil)
+	_ Database = (*InMemoryDB)(nil)
+)
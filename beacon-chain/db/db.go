@@ -2,6 +2,7 @@ package db
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"path"
 	"sync"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/boltdb/bolt"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/sirupsen/logrus"
 )
 
@@ -28,12 +30,10 @@ type BeaconDB struct {
 	// Beacon block info in memory
 	highestBlockSlot uint64
 
-	// Beacon chain deposits in memory.
-	pendingDeposits       []*depositContainer
-	deposits              []*depositContainer
-	depositsLock          sync.RWMutex
-	chainstartPubkeys     map[string]bool
-	chainstartPubkeysLock sync.RWMutex
+	// Beacon chain deposits, cached in memory and written through to their buckets.
+	depositCache
+
+	historicalStateRetention HistoricalStateRetention
 }
 
 // Close closes the underlying boltdb database.
@@ -66,27 +66,87 @@ func NewDB(dirPath string) (*BeaconDB, error) {
 	if err := os.MkdirAll(dirPath, 0700); err != nil {
 		return nil, err
 	}
-	datafile := path.Join(dirPath, "beaconchain.db")
-	boltDB, err := bolt.Open(datafile, 0600, &bolt.Options{Timeout: 1 * time.Second})
+	boltDB, err := openBoltDB(dirPath, false /* readOnly */)
 	if err != nil {
-		if err == bolt.ErrTimeout {
-			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
-		}
 		return nil, err
 	}
 
 	db := &BeaconDB{db: boltDB, DatabasePath: dirPath}
 
 	if err := db.update(func(tx *bolt.Tx) error {
-		return createBuckets(tx, blockBucket, attestationBucket, mainChainBucket, histStateBucket,
-			chainInfoBucket, cleanupHistoryBucket, blockOperationsBucket, validatorBucket)
+		// A new database is created at the latest schema version, while existing
+		// ones are brought up to date by the migrations below.
+		isNew := tx.Bucket(blockBucket) == nil
+		if err := createBuckets(tx, blockBucket, attestationBucket, mainChainBucket, histStateBucket,
+			chainInfoBucket, cleanupHistoryBucket, blockOperationsBucket, validatorBucket,
+			proposerSlashingBucket, attesterSlashingBucket, slasherProposalsBucket, slasherAttestationsBucket,
+			slasherVotesBucket, blockParentIndexBucket, blockSlotIndexBucket, depositsBucket, pendingDepositsBucket,
+			chainstartPubkeysBucket); err != nil {
+			return err
+		}
+		if isNew {
+			return tx.Bucket(chainInfoBucket).Put(schemaVersionKey, bytesutil.Bytes8(LatestSchemaVersion()))
+		}
+		return nil
 	}); err != nil {
+		boltDB.Close()
+		return nil, err
+	}
+
+	if err := db.migrate(); err != nil {
+		boltDB.Close()
+		return nil, err
+	}
+
+	if err := db.loadDeposits(); err != nil {
+		boltDB.Close()
 		return nil, err
 	}
 
 	return db, err
 }
 
+// NewReadOnlyDB opens an existing database without modifying it, for tools which
+// look inside the data directory of a node. The database must be at the latest
+// schema version, as it cannot be migrated.
+func NewReadOnlyDB(dirPath string) (*BeaconDB, error) {
+	if _, err := os.Stat(path.Join(dirPath, "beaconchain.db")); err != nil {
+		return nil, fmt.Errorf("could not find database: %v", err)
+	}
+	boltDB, err := openBoltDB(dirPath, true /* readOnly */)
+	if err != nil {
+		return nil, err
+	}
+
+	db := &BeaconDB{db: boltDB, DatabasePath: dirPath}
+	version, err := db.SchemaVersion()
+	if err != nil {
+		boltDB.Close()
+		return nil, err
+	}
+	if version != LatestSchemaVersion() {
+		boltDB.Close()
+		return nil, fmt.Errorf(
+			"database schema version %d is not the latest supported version %d, start the beacon node to migrate it",
+			version,
+			LatestSchemaVersion(),
+		)
+	}
+	return db, nil
+}
+
+func openBoltDB(dirPath string, readOnly bool) (*bolt.DB, error) {
+	datafile := path.Join(dirPath, "beaconchain.db")
+	boltDB, err := bolt.Open(datafile, 0600, &bolt.Options{Timeout: 1 * time.Second, ReadOnly: readOnly})
+	if err != nil {
+		if err == bolt.ErrTimeout {
+			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
+		}
+		return nil, err
+	}
+	return boltDB, nil
+}
+
 // ClearDB removes the previously stored directory at the data directory.
 func ClearDB(dirPath string) error {
 	if _, err := os.Stat(dirPath); os.IsNotExist(err) {
@@ -0,0 +1,209 @@
+package db
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// depositCache keeps the deposits of the deposit contract and the pubkeys marked
+// for chainstart in memory. Both databases embed it, the BeaconDB overriding the
+// methods which modify it to write the deposits through to their buckets.
+type depositCache struct {
+	pendingDeposits       []*depositContainer
+	deposits              []*depositContainer
+	depositsLock          sync.RWMutex
+	chainstartPubkeys     map[string]bool
+	chainstartPubkeysLock sync.RWMutex
+}
+
+// InsertDeposit into the cache. If deposit or block number are nil
+// then this method does nothing.
+func (c *depositCache) InsertDeposit(ctx context.Context, d *pb.Deposit, blockNum *big.Int) error {
+	ctx, span := trace.StartSpan(ctx, "depositCache.InsertDeposit")
+	defer span.End()
+	if isNilDeposit(d, blockNum) {
+		return nil
+	}
+	c.depositsLock.Lock()
+	defer c.depositsLock.Unlock()
+	c.addDeposit(&depositContainer{deposit: d, block: blockNum})
+	return nil
+}
+
+// AllDeposits returns a list of deposits all historical deposits until the given block number
+// (inclusive). If no block is specified then this method returns all historical deposits.
+func (c *depositCache) AllDeposits(ctx context.Context, beforeBlk *big.Int) []*pb.Deposit {
+	ctx, span := trace.StartSpan(ctx, "depositCache.AllDeposits")
+	defer span.End()
+	c.depositsLock.RLock()
+	defer c.depositsLock.RUnlock()
+	return depositsUntil(c.deposits, beforeBlk)
+}
+
+// InsertPendingDeposit into the cache. If deposit or block number are nil
+// then this method does nothing.
+func (c *depositCache) InsertPendingDeposit(ctx context.Context, d *pb.Deposit, blockNum *big.Int) error {
+	ctx, span := trace.StartSpan(ctx, "depositCache.InsertPendingDeposit")
+	defer span.End()
+	if isNilDeposit(d, blockNum) {
+		return nil
+	}
+	c.depositsLock.Lock()
+	defer c.depositsLock.Unlock()
+	c.addPendingDeposit(&depositContainer{deposit: d, block: blockNum})
+	return nil
+}
+
+// PendingDeposits returns a list of deposits until the given block number
+// (inclusive). If no block is specified then this method returns all pending
+// deposits.
+func (c *depositCache) PendingDeposits(ctx context.Context, beforeBlk *big.Int) []*pb.Deposit {
+	ctx, span := trace.StartSpan(ctx, "depositCache.PendingDeposits")
+	defer span.End()
+	c.depositsLock.RLock()
+	defer c.depositsLock.RUnlock()
+	return depositsUntil(c.pendingDeposits, beforeBlk)
+}
+
+// RemovePendingDeposit from the cache. The deposit is indexed by the
+// MerkleTreeIndex. This method does nothing if deposit ptr is nil.
+func (c *depositCache) RemovePendingDeposit(ctx context.Context, d *pb.Deposit) error {
+	ctx, span := trace.StartSpan(ctx, "depositCache.RemovePendingDeposit")
+	defer span.End()
+	if d == nil {
+		log.Debug("Ignoring nil deposit removal")
+		return nil
+	}
+	c.depositsLock.Lock()
+	defer c.depositsLock.Unlock()
+	if idx := c.pendingDepositIndex(d); idx >= 0 {
+		c.removePendingDeposit(idx)
+	}
+	return nil
+}
+
+// PrunePendingDeposits removes any deposit which is older than the given deposit merkle tree index.
+func (c *depositCache) PrunePendingDeposits(ctx context.Context, merkleTreeIndex uint64) error {
+	ctx, span := trace.StartSpan(ctx, "depositCache.PrunePendingDeposits")
+	defer span.End()
+	if merkleTreeIndex == 0 {
+		log.Debug("Ignoring 0 deposit removal")
+		return nil
+	}
+	c.depositsLock.Lock()
+	defer c.depositsLock.Unlock()
+	c.prunePendingDeposits(merkleTreeIndex)
+	return nil
+}
+
+// MarkPubkeyForChainstart sets the pubkey deposit status to true.
+func (c *depositCache) MarkPubkeyForChainstart(ctx context.Context, pubkey string) error {
+	ctx, span := trace.StartSpan(ctx, "depositCache.MarkPubkeyForChainstart")
+	defer span.End()
+	c.chainstartPubkeysLock.Lock()
+	defer c.chainstartPubkeysLock.Unlock()
+	c.markPubkeyForChainstart(pubkey)
+	return nil
+}
+
+// PubkeyInChainstart returns bool for whether the pubkey passed in has deposited.
+func (c *depositCache) PubkeyInChainstart(ctx context.Context, pubkey string) bool {
+	ctx, span := trace.StartSpan(ctx, "depositCache.PubkeyInChainstart")
+	defer span.End()
+	c.chainstartPubkeysLock.RLock()
+	defer c.chainstartPubkeysLock.RUnlock()
+	return c.chainstartPubkeys[pubkey]
+}
+
+// The methods below modify the cache, their callers holding the matching lock.
+
+func (c *depositCache) addDeposit(ctnr *depositContainer) {
+	c.deposits = append(c.deposits, ctnr)
+	historicalDepositsCount.Inc()
+}
+
+func (c *depositCache) addPendingDeposit(ctnr *depositContainer) {
+	c.pendingDeposits = append(c.pendingDeposits, ctnr)
+	pendingDepositsCount.Inc()
+}
+
+// pendingDepositIndex returns the index of the pending deposit with the merkle
+// tree index of the given deposit, or -1 if there is none.
+func (c *depositCache) pendingDepositIndex(d *pb.Deposit) int {
+	for i, ctnr := range c.pendingDeposits {
+		if ctnr.deposit.MerkleTreeIndex == d.MerkleTreeIndex {
+			return i
+		}
+	}
+	return -1
+}
+
+func (c *depositCache) removePendingDeposit(idx int) {
+	c.pendingDeposits = append(c.pendingDeposits[:idx], c.pendingDeposits[idx+1:]...)
+	pendingDepositsCount.Dec()
+}
+
+// prunedPendingDeposits returns the pending deposits older than the given merkle
+// tree index.
+func (c *depositCache) prunedPendingDeposits(merkleTreeIndex uint64) []*depositContainer {
+	var pruned []*depositContainer
+	for _, dp := range c.pendingDeposits {
+		if dp.deposit.MerkleTreeIndex < merkleTreeIndex {
+			pruned = append(pruned, dp)
+		}
+	}
+	return pruned
+}
+
+func (c *depositCache) prunePendingDeposits(merkleTreeIndex uint64) {
+	var cleanDeposits []*depositContainer
+	for _, dp := range c.pendingDeposits {
+		if dp.deposit.MerkleTreeIndex >= merkleTreeIndex {
+			cleanDeposits = append(cleanDeposits, dp)
+		}
+	}
+	c.pendingDeposits = cleanDeposits
+	pendingDepositsCount.Set(float64(len(c.pendingDeposits)))
+}
+
+func (c *depositCache) markPubkeyForChainstart(pubkey string) {
+	if c.chainstartPubkeys == nil {
+		c.chainstartPubkeys = make(map[string]bool)
+	}
+	c.chainstartPubkeys[pubkey] = true
+}
+
+// isNilDeposit returns true, after logging it, if the deposit or its block number
+// are nil, in which case the deposit is not inserted.
+func isNilDeposit(d *pb.Deposit, blockNum *big.Int) bool {
+	if d == nil || blockNum == nil {
+		log.WithFields(logrus.Fields{
+			"block":   blockNum,
+			"deposit": d,
+		}).Debug("Ignoring nil deposit insertion")
+		return true
+	}
+	return false
+}
+
+// depositsUntil returns the deposits until the given block number (inclusive),
+// or all of them if no block is specified, sorted by merkle index.
+func depositsUntil(ctnrs []*depositContainer, beforeBlk *big.Int) []*pb.Deposit {
+	var deposits []*pb.Deposit
+	for _, ctnr := range ctnrs {
+		if beforeBlk == nil || beforeBlk.Cmp(ctnr.block) > -1 {
+			deposits = append(deposits, ctnr.deposit)
+		}
+	}
+	// Sort the deposits by Merkle index.
+	sort.SliceStable(deposits, func(i, j int) bool {
+		return deposits[i].MerkleTreeIndex < deposits[j].MerkleTreeIndex
+	})
+	return deposits
+}
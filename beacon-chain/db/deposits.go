@@ -2,13 +2,15 @@ package db
 
 import (
 	"context"
+	"encoding/binary"
+	"fmt"
 	"math/big"
-	"sort"
 
+	"github.com/boltdb/bolt"
+	"github.com/gogo/protobuf/proto"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
-	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
 
@@ -21,62 +23,147 @@ var (
 
 // InsertDeposit into the database. If deposit or block number are nil
 // then this method does nothing.
-func (db *BeaconDB) InsertDeposit(ctx context.Context, d *pb.Deposit, blockNum *big.Int) {
+func (db *BeaconDB) InsertDeposit(ctx context.Context, d *pb.Deposit, blockNum *big.Int) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.InsertDeposit")
 	defer span.End()
-	if d == nil || blockNum == nil {
-		log.WithFields(logrus.Fields{
-			"block":   blockNum,
-			"deposit": d,
-		}).Debug("Ignoring nil deposit insertion")
-		return
+	if isNilDeposit(d, blockNum) {
+		return nil
 	}
 	db.depositsLock.Lock()
 	defer db.depositsLock.Unlock()
-	db.deposits = append(db.deposits, &depositContainer{deposit: d, block: blockNum})
-	historicalDepositsCount.Inc()
+	ctnr := &depositContainer{deposit: d, block: blockNum}
+	if err := db.saveDepositContainer(depositsBucket, ctnr); err != nil {
+		return err
+	}
+	db.addDeposit(ctnr)
+	return nil
 }
 
 // MarkPubkeyForChainstart sets the pubkey deposit status to true.
-func (db *BeaconDB) MarkPubkeyForChainstart(ctx context.Context, pubkey string) {
+func (db *BeaconDB) MarkPubkeyForChainstart(ctx context.Context, pubkey string) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.MarkPubkeyForChainstart")
 	defer span.End()
 	db.chainstartPubkeysLock.Lock()
 	defer db.chainstartPubkeysLock.Unlock()
-	db.chainstartPubkeys[pubkey] = true
+	if err := db.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(chainstartPubkeysBucket).Put([]byte(pubkey), []byte{1})
+	}); err != nil {
+		return fmt.Errorf("could not save chainstart pubkey: %v", err)
+	}
+	db.markPubkeyForChainstart(pubkey)
+	return nil
 }
 
-// PubkeyInChainstart returns bool for whether the pubkey passed in has deposited.
-func (db *BeaconDB) PubkeyInChainstart(ctx context.Context, pubkey string) bool {
-	ctx, span := trace.StartSpan(ctx, "BeaconDB.PubkeyInChainstart")
+// SaveLastProcessedEth1Block records the number of the last eth1 block whose
+// deposit contract logs were processed, so that log processing can resume from
+// the next block after a restart.
+func (db *BeaconDB) SaveLastProcessedEth1Block(ctx context.Context, blockNum *big.Int) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveLastProcessedEth1Block")
 	defer span.End()
-	db.chainstartPubkeysLock.Lock()
-	defer db.chainstartPubkeysLock.Unlock()
-	if db.chainstartPubkeys != nil {
-		return db.chainstartPubkeys[pubkey]
-	}
-	db.chainstartPubkeys = make(map[string]bool)
-	return false
+	return db.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(chainInfoBucket).Put(lastProcessedEth1BlockKey, blockNum.Bytes())
+	})
 }
 
-// AllDeposits returns a list of deposits all historical deposits until the given block number
-// (inclusive). If no block is specified then this method returns all historical deposits.
-func (db *BeaconDB) AllDeposits(ctx context.Context, beforeBlk *big.Int) []*pb.Deposit {
-	ctx, span := trace.StartSpan(ctx, "BeaconDB.AllDeposits")
+// LastProcessedEth1Block returns the number of the last eth1 block whose deposit
+// contract logs were processed, or nil if none were.
+func (db *BeaconDB) LastProcessedEth1Block(ctx context.Context) (*big.Int, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.LastProcessedEth1Block")
 	defer span.End()
-	db.depositsLock.RLock()
-	defer db.depositsLock.RUnlock()
+	var blockNum *big.Int
+	err := db.view(func(tx *bolt.Tx) error {
+		if enc := tx.Bucket(chainInfoBucket).Get(lastProcessedEth1BlockKey); enc != nil {
+			blockNum = new(big.Int).SetBytes(enc)
+		}
+		return nil
+	})
+	return blockNum, err
+}
+
+// depositKey is the eth1 block number followed by the merkle tree index of the
+// deposit, both big-endian so that deposits are iterated in eth1 order.
+func depositKey(ctnr *depositContainer) []byte {
+	key := make([]byte, 16)
+	if ctnr.block != nil {
+		binary.BigEndian.PutUint64(key[:8], ctnr.block.Uint64())
+	}
+	binary.BigEndian.PutUint64(key[8:], ctnr.deposit.MerkleTreeIndex)
+	return key
+}
+
+// saveDepositContainer writes the deposit through to the bucket.
+func (db *BeaconDB) saveDepositContainer(bucket []byte, ctnr *depositContainer) error {
+	enc, err := proto.Marshal(ctnr.deposit)
+	if err != nil {
+		return fmt.Errorf("could not encode deposit: %v", err)
+	}
+	if err := db.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucket).Put(depositKey(ctnr), enc)
+	}); err != nil {
+		return fmt.Errorf("could not save deposit: %v", err)
+	}
+	return nil
+}
 
-	var deposits []*pb.Deposit
-	for _, ctnr := range db.deposits {
-		if beforeBlk == nil || beforeBlk.Cmp(ctnr.block) > -1 {
-			deposits = append(deposits, ctnr.deposit)
+// deleteDepositContainers removes the deposits from the bucket.
+func (db *BeaconDB) deleteDepositContainers(bucket []byte, ctnrs []*depositContainer) error {
+	if len(ctnrs) == 0 {
+		return nil
+	}
+	if err := db.update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(bucket)
+		for _, ctnr := range ctnrs {
+			if err := bkt.Delete(depositKey(ctnr)); err != nil {
+				return err
+			}
 		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("could not delete deposits: %v", err)
 	}
-	// Sort the deposits by Merkle index.
-	sort.SliceStable(deposits, func(i, j int) bool {
-		return deposits[i].MerkleTreeIndex < deposits[j].MerkleTreeIndex
+	return nil
+}
+
+// loadDeposits reads the deposits and chainstart pubkeys saved by previous runs
+// into memory.
+func (db *BeaconDB) loadDeposits() error {
+	db.depositsLock.Lock()
+	defer db.depositsLock.Unlock()
+	db.chainstartPubkeysLock.Lock()
+	defer db.chainstartPubkeysLock.Unlock()
+
+	db.chainstartPubkeys = make(map[string]bool)
+	err := db.view(func(tx *bolt.Tx) error {
+		var err error
+		if db.deposits, err = depositContainers(tx.Bucket(depositsBucket)); err != nil {
+			return err
+		}
+		if db.pendingDeposits, err = depositContainers(tx.Bucket(pendingDepositsBucket)); err != nil {
+			return err
+		}
+		return tx.Bucket(chainstartPubkeysBucket).ForEach(func(pubkey []byte, _ []byte) error {
+			db.chainstartPubkeys[string(pubkey)] = true
+			return nil
+		})
 	})
+	if err != nil {
+		return fmt.Errorf("could not load deposits: %v", err)
+	}
+	historicalDepositsCount.Add(float64(len(db.deposits)))
+	pendingDepositsCount.Set(float64(len(db.pendingDeposits)))
+	return nil
+}
 
-	return deposits
+func depositContainers(bucket *bolt.Bucket) ([]*depositContainer, error) {
+	var ctnrs []*depositContainer
+	err := bucket.ForEach(func(key []byte, enc []byte) error {
+		deposit := &pb.Deposit{}
+		if err := proto.Unmarshal(enc, deposit); err != nil {
+			return fmt.Errorf("could not decode deposit: %v", err)
+		}
+		block := new(big.Int).SetUint64(binary.BigEndian.Uint64(key[:8]))
+		ctnrs = append(ctnrs, &depositContainer{deposit: deposit, block: block})
+		return nil
+	})
+	return ctnrs, err
 }
@@ -0,0 +1,848 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
+	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// InMemoryDB is a Database which keeps the beacon chain in memory only, for tests
+// and simulations which do not need to persist it. Saved and retrieved objects are
+// copies, as with BeaconDB, so callers may mutate them freely.
+type InMemoryDB struct {
+	lock sync.RWMutex
+
+	blocks           map[[32]byte]*pb.BeaconBlock
+	mainChain        map[uint64][32]byte
+	headRoot         *[32]byte
+	highestBlockSlot uint64
+	justifiedBlock   *pb.BeaconBlock
+	finalizedBlock   *pb.BeaconBlock
+
+	headState                *pb.BeaconState
+	justifiedState           *pb.BeaconState
+	finalizedState           *pb.BeaconState
+	historicalStates         map[uint64]*pb.BeaconState
+	historicalStateRetention HistoricalStateRetention
+
+	attestations      map[[32]byte]*pb.Attestation
+	exits             map[[32]byte]*pb.VoluntaryExit
+	proposerSlashings map[[32]byte]*pb.ProposerSlashing
+	attesterSlashings map[[32]byte]*pb.AttesterSlashing
+	validators        map[[32]byte]uint64
+
+	proposalRecords       map[proposalKey]*ProposalRecord
+	slashableAttestations map[uint64]map[[32]byte]*pb.SlashableAttestation
+	validatorVotes        map[uint64]map[uint64]*ValidatorVote
+
+	depositCache
+	lastProcessedEth1Block *big.Int
+	depositContractAddress []byte
+}
+
+// NewInMemoryDB initializes an empty in-memory database.
+func NewInMemoryDB() *InMemoryDB {
+	return &InMemoryDB{
+		blocks:                make(map[[32]byte]*pb.BeaconBlock),
+		mainChain:             make(map[uint64][32]byte),
+		historicalStates:      make(map[uint64]*pb.BeaconState),
+		attestations:          make(map[[32]byte]*pb.Attestation),
+		exits:                 make(map[[32]byte]*pb.VoluntaryExit),
+		proposerSlashings:     make(map[[32]byte]*pb.ProposerSlashing),
+		attesterSlashings:     make(map[[32]byte]*pb.AttesterSlashing),
+		validators:            make(map[[32]byte]uint64),
+		proposalRecords:       make(map[proposalKey]*ProposalRecord),
+		slashableAttestations: make(map[uint64]map[[32]byte]*pb.SlashableAttestation),
+		validatorVotes:        make(map[uint64]map[uint64]*ValidatorVote),
+	}
+}
+
+// Close is a no-op, the database is released with the InMemoryDB itself.
+func (db *InMemoryDB) Close() error {
+	return nil
+}
+
+func cloneBlock(block *pb.BeaconBlock) *pb.BeaconBlock {
+	if block == nil {
+		return nil
+	}
+	return proto.Clone(block).(*pb.BeaconBlock)
+}
+
+func cloneState(beaconState *pb.BeaconState) *pb.BeaconState {
+	if beaconState == nil {
+		return nil
+	}
+	return proto.Clone(beaconState).(*pb.BeaconState)
+}
+
+// Block accepts a block root and returns the corresponding block.
+// Returns nil if the block does not exist.
+func (db *InMemoryDB) Block(root [32]byte) (*pb.BeaconBlock, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+	return cloneBlock(db.blocks[root]), nil
+}
+
+// HasBlock accepts a block root and returns true if the block exists.
+func (db *InMemoryDB) HasBlock(root [32]byte) bool {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+	_, ok := db.blocks[root]
+	return ok
+}
+
+// SaveBlock accepts a block and saves it. The block is only included in the main
+// chain once it becomes part of the canonical chain.
+func (db *InMemoryDB) SaveBlock(block *pb.BeaconBlock) error {
+	root, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return fmt.Errorf("failed to tree hash block: %v", err)
+	}
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	if block.Slot > db.highestBlockSlot {
+		db.highestBlockSlot = block.Slot
+	}
+	db.blocks[root] = cloneBlock(block)
+	return nil
+}
+
+// DeleteBlock deletes a block, and removes it from the main chain if it is
+// included in it.
+func (db *InMemoryDB) DeleteBlock(block *pb.BeaconBlock) error {
+	root, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return fmt.Errorf("failed to tree hash block: %v", err)
+	}
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	if mainRoot, ok := db.mainChain[block.Slot]; ok && mainRoot == root {
+		delete(db.mainChain, block.Slot)
+	}
+	delete(db.blocks, root)
+	return nil
+}
+
+// BlockBySlot accepts a slot number and returns the corresponding block in the main chain.
+// Returns nil if a block was not recorded for the given slot.
+func (db *InMemoryDB) BlockBySlot(ctx context.Context, slot uint64) (*pb.BeaconBlock, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+	root, ok := db.mainChain[slot]
+	if !ok {
+		return nil, nil
+	}
+	return cloneBlock(db.blocks[root]), nil
+}
+
+// BlocksBySlot returns every saved block with the given slot, whichever fork
+// they belong to.
+func (db *InMemoryDB) BlocksBySlot(ctx context.Context, slot uint64) ([]*pb.BeaconBlock, error) {
+	return db.filterBlocks(func(block *pb.BeaconBlock) bool {
+		return block.Slot == slot
+	}), nil
+}
+
+// BlocksByParent returns every saved block whose parent is the block with the given root.
+func (db *InMemoryDB) BlocksByParent(ctx context.Context, parentRoot [32]byte) ([]*pb.BeaconBlock, error) {
+	return db.filterBlocks(func(block *pb.BeaconBlock) bool {
+		return bytes.Equal(block.ParentRootHash32, parentRoot[:])
+	}), nil
+}
+
+// filterBlocks returns the saved blocks matching the filter, ordered by root as
+// they are in the indices of BeaconDB.
+func (db *InMemoryDB) filterBlocks(filter func(block *pb.BeaconBlock) bool) []*pb.BeaconBlock {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+	var roots [][32]byte
+	for root, block := range db.blocks {
+		if filter(block) {
+			roots = append(roots, root)
+		}
+	}
+	var blocks []*pb.BeaconBlock
+	for _, root := range sortHashes(roots) {
+		blocks = append(blocks, cloneBlock(db.blocks[root]))
+	}
+	return blocks
+}
+
+// HighestBlockSlot returns the highest slot of the blocks saved in the database.
+func (db *InMemoryDB) HighestBlockSlot() uint64 {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+	return db.highestBlockSlot
+}
+
+// ChainHead returns the head of the main chain.
+func (db *InMemoryDB) ChainHead() (*pb.BeaconBlock, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+	if db.headRoot == nil {
+		return nil, errors.New("unable to determine chain height")
+	}
+	block, ok := db.blocks[*db.headRoot]
+	if !ok {
+		return nil, fmt.Errorf("block not found: %x", *db.headRoot)
+	}
+	return cloneBlock(block), nil
+}
+
+// UpdateChainHead updates the head of the chain as well as the corresponding state.
+func (db *InMemoryDB) UpdateChainHead(ctx context.Context, block *pb.BeaconBlock, beaconState *pb.BeaconState) error {
+	blockRoot, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return fmt.Errorf("unable to tree hash block: %v", err)
+	}
+	if err := db.SaveState(ctx, beaconState); err != nil {
+		return fmt.Errorf("failed to save beacon state as canonical: %v", err)
+	}
+
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	if _, ok := db.blocks[blockRoot]; !ok {
+		return fmt.Errorf("expected block %#x to have already been saved before updating head", blockRoot)
+	}
+	if block.Slot > db.highestBlockSlot {
+		db.highestBlockSlot = block.Slot
+	}
+	db.mainChain[block.Slot] = blockRoot
+	db.headRoot = &blockRoot
+	return nil
+}
+
+// ReorgMainChain removes the blocks orphaned by a chain reorganization from the main chain
+// and replaces them with the blocks of the new canonical branch. Orphaned slots which the
+// main chain already maps to another block are left untouched.
+func (db *InMemoryDB) ReorgMainChain(ctx context.Context, orphaned []*pb.BeaconBlock, canonical []*pb.BeaconBlock) error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	for _, block := range orphaned {
+		root, err := hashutil.HashBeaconBlock(block)
+		if err != nil {
+			return fmt.Errorf("unable to tree hash block: %v", err)
+		}
+		if mainRoot, ok := db.mainChain[block.Slot]; ok && mainRoot == root {
+			delete(db.mainChain, block.Slot)
+		}
+	}
+	for _, block := range canonical {
+		root, err := hashutil.HashBeaconBlock(block)
+		if err != nil {
+			return fmt.Errorf("unable to tree hash block: %v", err)
+		}
+		db.mainChain[block.Slot] = root
+	}
+	return nil
+}
+
+// JustifiedBlock retrieves the justified block from the db.
+func (db *InMemoryDB) JustifiedBlock() (*pb.BeaconBlock, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+	if db.justifiedBlock == nil {
+		return nil, errors.New("no justified block saved")
+	}
+	return cloneBlock(db.justifiedBlock), nil
+}
+
+// SaveJustifiedBlock saves the last justified block from canonical chain to the db.
+func (db *InMemoryDB) SaveJustifiedBlock(block *pb.BeaconBlock) error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	db.justifiedBlock = cloneBlock(block)
+	return nil
+}
+
+// FinalizedBlock retrieves the finalized block from the db.
+func (db *InMemoryDB) FinalizedBlock() (*pb.BeaconBlock, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+	if db.finalizedBlock == nil {
+		return nil, errors.New("no finalized block saved")
+	}
+	return cloneBlock(db.finalizedBlock), nil
+}
+
+// SaveFinalizedBlock saves the last finalized block from canonical chain to the db.
+func (db *InMemoryDB) SaveFinalizedBlock(block *pb.BeaconBlock) error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	db.finalizedBlock = cloneBlock(block)
+	return nil
+}
+
+// InitializeState creates an initial genesis state for the beacon
+// node using a set of genesis validators.
+func (db *InMemoryDB) InitializeState(ctx context.Context, genesisTime uint64, deposits []*pb.Deposit, eth1Data *pb.Eth1Data) error {
+	beaconState, err := state.GenesisBeaconState(deposits, genesisTime, eth1Data)
+	if err != nil {
+		return err
+	}
+	stateHash, err := hashutil.HashProto(beaconState)
+	if err != nil {
+		return err
+	}
+	genesisBlock := b.NewGenesisBlock(stateHash[:])
+	blockRoot, err := hashutil.HashBeaconBlock(genesisBlock)
+	if err != nil {
+		return err
+	}
+
+	if err := db.SaveState(ctx, beaconState); err != nil {
+		return err
+	}
+
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	db.blocks[blockRoot] = genesisBlock
+	db.mainChain[genesisBlock.Slot] = blockRoot
+	db.headRoot = &blockRoot
+	for i, validator := range beaconState.ValidatorRegistry {
+		db.validators[hashutil.Hash(validator.Pubkey)] = uint64(i)
+	}
+	db.finalizedState = cloneState(beaconState)
+	return nil
+}
+
+// HeadState fetches the canonical beacon chain's head state from the db.
+// Returns nil if no state was saved.
+func (db *InMemoryDB) HeadState(ctx context.Context) (*pb.BeaconState, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+	return cloneState(db.headState), nil
+}
+
+// SaveState updates the beacon chain state.
+func (db *InMemoryDB) SaveState(ctx context.Context, beaconState *pb.BeaconState) error {
+	if err := db.SaveHistoricalState(ctx, beaconState); err != nil {
+		return err
+	}
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	db.headState = cloneState(beaconState)
+	return nil
+}
+
+// JustifiedState retrieves the justified state from the db.
+func (db *InMemoryDB) JustifiedState() (*pb.BeaconState, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+	if db.justifiedState == nil {
+		return nil, errors.New("no justified state saved")
+	}
+	return cloneState(db.justifiedState), nil
+}
+
+// SaveJustifiedState saves the last justified state in the db.
+func (db *InMemoryDB) SaveJustifiedState(beaconState *pb.BeaconState) error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	db.justifiedState = cloneState(beaconState)
+	return nil
+}
+
+// FinalizedState retrieves the finalized state from the db.
+func (db *InMemoryDB) FinalizedState() (*pb.BeaconState, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+	if db.finalizedState == nil {
+		return nil, errors.New("no finalized state saved")
+	}
+	return cloneState(db.finalizedState), nil
+}
+
+// SaveFinalizedState saves the last finalized state in the db. Historical states
+// older than it are pruned according to the retention policy of the database.
+func (db *InMemoryDB) SaveFinalizedState(beaconState *pb.BeaconState) error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	slots := make([]uint64, 0, len(db.historicalStates))
+	for slot := range db.historicalStates {
+		slots = append(slots, slot)
+	}
+	sort.Slice(slots, func(i, j int) bool {
+		return slots[i] < slots[j]
+	})
+	for _, slot := range db.historicalStateRetention.prunedSlots(slots, beaconState.Slot) {
+		delete(db.historicalStates, slot)
+	}
+	db.finalizedState = cloneState(beaconState)
+	return nil
+}
+
+// SetHistoricalStateRetention sets the policy used to prune historical states
+// when a new finalized state is saved, as for BeaconDB.
+func (db *InMemoryDB) SetHistoricalStateRetention(retention HistoricalStateRetention) {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	db.historicalStateRetention = retention
+}
+
+// SaveHistoricalState saves the state as the historical state of its slot.
+func (db *InMemoryDB) SaveHistoricalState(ctx context.Context, beaconState *pb.BeaconState) error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	db.historicalStates[beaconState.Slot] = cloneState(beaconState)
+	return nil
+}
+
+// HistoricalStateFromSlot retrieves the state that is closest to the input slot,
+// while being smaller than or equal to the input slot.
+func (db *InMemoryDB) HistoricalStateFromSlot(ctx context.Context, slot uint64) (*pb.BeaconState, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+	var closest *pb.BeaconState
+	for stateSlot, beaconState := range db.historicalStates {
+		if stateSlot <= slot && (closest == nil || stateSlot > closest.Slot) {
+			closest = beaconState
+		}
+	}
+	if closest == nil {
+		return nil, errors.New("no historical states saved in db")
+	}
+	return cloneState(closest), nil
+}
+
+// Attestation retrieves an attestation record from the db using its hash.
+func (db *InMemoryDB) Attestation(hash [32]byte) (*pb.Attestation, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+	attestation, ok := db.attestations[hash]
+	if !ok {
+		return nil, nil
+	}
+	return proto.Clone(attestation).(*pb.Attestation), nil
+}
+
+// Attestations retrieves all the attestation records from the db.
+// These are the attestations that have not been seen on the beacon chain.
+func (db *InMemoryDB) Attestations() ([]*pb.Attestation, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+	var attestations []*pb.Attestation
+	hashes := make([][32]byte, 0, len(db.attestations))
+	for hash := range db.attestations {
+		hashes = append(hashes, hash)
+	}
+	for _, hash := range sortHashes(hashes) {
+		attestations = append(attestations, proto.Clone(db.attestations[hash]).(*pb.Attestation))
+	}
+	return attestations, nil
+}
+
+// HasAttestation checks if the attestation exists.
+func (db *InMemoryDB) HasAttestation(hash [32]byte) bool {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+	_, ok := db.attestations[hash]
+	return ok
+}
+
+// SaveAttestation puts the attestation record into the db.
+func (db *InMemoryDB) SaveAttestation(ctx context.Context, attestation *pb.Attestation) error {
+	hash, err := hashutil.HashProto(attestation)
+	if err != nil {
+		return err
+	}
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	db.attestations[hash] = proto.Clone(attestation).(*pb.Attestation)
+	return nil
+}
+
+// DeleteAttestation deletes the attestation record from the db.
+func (db *InMemoryDB) DeleteAttestation(attestation *pb.Attestation) error {
+	hash, err := hashutil.HashProto(attestation)
+	if err != nil {
+		return err
+	}
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	delete(db.attestations, hash)
+	return nil
+}
+
+// Exits retrieves all the exit requests from the db.
+func (db *InMemoryDB) Exits() ([]*pb.VoluntaryExit, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+	var exits []*pb.VoluntaryExit
+	hashes := make([][32]byte, 0, len(db.exits))
+	for hash := range db.exits {
+		hashes = append(hashes, hash)
+	}
+	for _, hash := range sortHashes(hashes) {
+		exits = append(exits, proto.Clone(db.exits[hash]).(*pb.VoluntaryExit))
+	}
+	return exits, nil
+}
+
+// HasExit checks if the exit request exists.
+func (db *InMemoryDB) HasExit(hash [32]byte) bool {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+	_, ok := db.exits[hash]
+	return ok
+}
+
+// SaveExit puts the exit request into the db.
+func (db *InMemoryDB) SaveExit(ctx context.Context, exit *pb.VoluntaryExit) error {
+	hash, err := hashutil.HashProto(exit)
+	if err != nil {
+		return err
+	}
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	db.exits[hash] = proto.Clone(exit).(*pb.VoluntaryExit)
+	return nil
+}
+
+// DeleteExit deletes the exit request from the db.
+func (db *InMemoryDB) DeleteExit(exit *pb.VoluntaryExit) error {
+	hash, err := hashutil.HashProto(exit)
+	if err != nil {
+		return err
+	}
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	delete(db.exits, hash)
+	return nil
+}
+
+// ProposerSlashings retrieves all the proposer slashings from the db.
+func (db *InMemoryDB) ProposerSlashings() ([]*pb.ProposerSlashing, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+	var slashings []*pb.ProposerSlashing
+	hashes := make([][32]byte, 0, len(db.proposerSlashings))
+	for hash := range db.proposerSlashings {
+		hashes = append(hashes, hash)
+	}
+	for _, hash := range sortHashes(hashes) {
+		slashings = append(slashings, proto.Clone(db.proposerSlashings[hash]).(*pb.ProposerSlashing))
+	}
+	return slashings, nil
+}
+
+// HasProposerSlashing checks if the proposer slashing exists.
+func (db *InMemoryDB) HasProposerSlashing(hash [32]byte) bool {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+	_, ok := db.proposerSlashings[hash]
+	return ok
+}
+
+// SaveProposerSlashing puts the proposer slashing into the db.
+func (db *InMemoryDB) SaveProposerSlashing(ctx context.Context, slashing *pb.ProposerSlashing) error {
+	hash, err := hashutil.HashProto(slashing)
+	if err != nil {
+		return err
+	}
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	db.proposerSlashings[hash] = proto.Clone(slashing).(*pb.ProposerSlashing)
+	return nil
+}
+
+// DeleteProposerSlashing deletes the proposer slashing from the db.
+func (db *InMemoryDB) DeleteProposerSlashing(slashing *pb.ProposerSlashing) error {
+	hash, err := hashutil.HashProto(slashing)
+	if err != nil {
+		return err
+	}
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	delete(db.proposerSlashings, hash)
+	return nil
+}
+
+// AttesterSlashings retrieves all the attester slashings from the db.
+func (db *InMemoryDB) AttesterSlashings() ([]*pb.AttesterSlashing, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+	var slashings []*pb.AttesterSlashing
+	hashes := make([][32]byte, 0, len(db.attesterSlashings))
+	for hash := range db.attesterSlashings {
+		hashes = append(hashes, hash)
+	}
+	for _, hash := range sortHashes(hashes) {
+		slashings = append(slashings, proto.Clone(db.attesterSlashings[hash]).(*pb.AttesterSlashing))
+	}
+	return slashings, nil
+}
+
+// HasAttesterSlashing checks if the attester slashing exists.
+func (db *InMemoryDB) HasAttesterSlashing(hash [32]byte) bool {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+	_, ok := db.attesterSlashings[hash]
+	return ok
+}
+
+// SaveAttesterSlashing puts the attester slashing into the db.
+func (db *InMemoryDB) SaveAttesterSlashing(ctx context.Context, slashing *pb.AttesterSlashing) error {
+	hash, err := hashutil.HashProto(slashing)
+	if err != nil {
+		return err
+	}
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	db.attesterSlashings[hash] = proto.Clone(slashing).(*pb.AttesterSlashing)
+	return nil
+}
+
+// DeleteAttesterSlashing deletes the attester slashing from the db.
+func (db *InMemoryDB) DeleteAttesterSlashing(slashing *pb.AttesterSlashing) error {
+	hash, err := hashutil.HashProto(slashing)
+	if err != nil {
+		return err
+	}
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	delete(db.attesterSlashings, hash)
+	return nil
+}
+
+// sortHashes sorts the hashes in place and returns them, in the order BeaconDB
+// iterates over its buckets.
+func sortHashes(hashes [][32]byte) [][32]byte {
+	sort.Slice(hashes, func(i, j int) bool {
+		return bytes.Compare(hashes[i][:], hashes[j][:]) < 0
+	})
+	return hashes
+}
+
+// ValidatorIndex accepts a public key and returns the corresponding validator index.
+func (db *InMemoryDB) ValidatorIndex(pubKey []byte) (uint64, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+	index, ok := db.validators[hashutil.Hash(pubKey)]
+	if !ok {
+		return 0, fmt.Errorf("validator %#x does not exist", pubKey)
+	}
+	return index, nil
+}
+
+// HasValidator checks if a validator index map exists.
+func (db *InMemoryDB) HasValidator(pubKey []byte) bool {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+	_, ok := db.validators[hashutil.Hash(pubKey)]
+	return ok
+}
+
+// HasAllValidators returns true if all validators in a list of public keys
+// are in the db.
+func (db *InMemoryDB) HasAllValidators(pubKeys [][]byte) bool {
+	for _, pk := range pubKeys {
+		if !db.HasValidator(pk) {
+			return false
+		}
+	}
+	return len(pubKeys) > 0
+}
+
+// HasAnyValidators returns true if any validator in a list of public keys
+// are in the db.
+func (db *InMemoryDB) HasAnyValidators(pubKeys [][]byte) bool {
+	for _, pk := range pubKeys {
+		if db.HasValidator(pk) {
+			return true
+		}
+	}
+	return false
+}
+
+// SaveValidatorIndex accepts a public key and validator index and saves them.
+func (db *InMemoryDB) SaveValidatorIndex(pubKey []byte, index int) error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	db.validators[hashutil.Hash(pubKey)] = uint64(index)
+	return nil
+}
+
+// SaveValidatorIndexBatch is the same as SaveValidatorIndex, there is no write
+// to batch in memory.
+func (db *InMemoryDB) SaveValidatorIndexBatch(pubKey []byte, index int) error {
+	return db.SaveValidatorIndex(pubKey, index)
+}
+
+// DeleteValidatorIndex deletes the validator index map record.
+func (db *InMemoryDB) DeleteValidatorIndex(pubKey []byte) error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	delete(db.validators, hashutil.Hash(pubKey))
+	return nil
+}
+
+// SaveLastProcessedEth1Block records the number of the last eth1 block whose
+// deposit contract logs were processed.
+func (db *InMemoryDB) SaveLastProcessedEth1Block(ctx context.Context, blockNum *big.Int) error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	db.lastProcessedEth1Block = new(big.Int).Set(blockNum)
+	return nil
+}
+
+// LastProcessedEth1Block returns the number of the last eth1 block whose deposit
+// contract logs were processed, or nil if none were.
+func (db *InMemoryDB) LastProcessedEth1Block(ctx context.Context) (*big.Int, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+	if db.lastProcessedEth1Block == nil {
+		return nil, nil
+	}
+	return new(big.Int).Set(db.lastProcessedEth1Block), nil
+}
+
+// VerifyContractAddress records the address of the deposit contract the first
+// time it is called, and checks the address against it afterwards.
+func (db *InMemoryDB) VerifyContractAddress(ctx context.Context, addr common.Address) error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	if db.depositContractAddress == nil {
+		db.depositContractAddress = addr.Bytes()
+		return nil
+	}
+	if !bytes.Equal(db.depositContractAddress, addr.Bytes()) {
+		return fmt.Errorf("invalid deposit contract address, expected %#x", db.depositContractAddress)
+	}
+	return nil
+}
+
+// proposalKey identifies the proposal of a validator at a slot.
+type proposalKey struct {
+	slot          uint64
+	proposerIndex uint64
+}
+
+// SaveProposalRecord records the block proposed by a validator at a slot. Only the
+// first proposal seen for a slot and proposer is kept.
+func (db *InMemoryDB) SaveProposalRecord(ctx context.Context, slot uint64, proposerIndex uint64, record *ProposalRecord) error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	key := proposalKey{slot: slot, proposerIndex: proposerIndex}
+	if _, ok := db.proposalRecords[key]; ok {
+		return nil
+	}
+	db.proposalRecords[key] = &ProposalRecord{
+		BlockRoot: record.BlockRoot,
+		Signature: append([]byte{}, record.Signature...),
+	}
+	return nil
+}
+
+// ProposalRecord retrieves the block proposed by a validator at a slot. It returns
+// nil if no proposal was recorded.
+func (db *InMemoryDB) ProposalRecord(slot uint64, proposerIndex uint64) (*ProposalRecord, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+	record, ok := db.proposalRecords[proposalKey{slot: slot, proposerIndex: proposerIndex}]
+	if !ok {
+		return nil, nil
+	}
+	return &ProposalRecord{
+		BlockRoot: record.BlockRoot,
+		Signature: append([]byte{}, record.Signature...),
+	}, nil
+}
+
+// SaveSlashableAttestation stores an attestation observed by the slasher, indexed by
+// its target epoch so it can later be used as evidence in an attester slashing.
+func (db *InMemoryDB) SaveSlashableAttestation(ctx context.Context, targetEpoch uint64, att *pb.SlashableAttestation) error {
+	hash, err := hashutil.HashProto(att)
+	if err != nil {
+		return err
+	}
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	if db.slashableAttestations[targetEpoch] == nil {
+		db.slashableAttestations[targetEpoch] = make(map[[32]byte]*pb.SlashableAttestation)
+	}
+	db.slashableAttestations[targetEpoch][hash] = proto.Clone(att).(*pb.SlashableAttestation)
+	return nil
+}
+
+// SlashableAttestation retrieves an attestation observed by the slasher by its target
+// epoch and hash. It returns nil if it was not found.
+func (db *InMemoryDB) SlashableAttestation(targetEpoch uint64, hash [32]byte) (*pb.SlashableAttestation, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+	att, ok := db.slashableAttestations[targetEpoch][hash]
+	if !ok {
+		return nil, nil
+	}
+	return proto.Clone(att).(*pb.SlashableAttestation), nil
+}
+
+// SaveValidatorVote records the source and target epochs a validator voted for. Only
+// the first vote seen for a validator and target epoch is kept.
+func (db *InMemoryDB) SaveValidatorVote(ctx context.Context, validatorIndex uint64, vote *ValidatorVote) error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	if db.validatorVotes[validatorIndex] == nil {
+		db.validatorVotes[validatorIndex] = make(map[uint64]*ValidatorVote)
+	}
+	if _, ok := db.validatorVotes[validatorIndex][vote.TargetEpoch]; ok {
+		return nil
+	}
+	saved := *vote
+	db.validatorVotes[validatorIndex][vote.TargetEpoch] = &saved
+	return nil
+}
+
+// ValidatorVotes retrieves all the votes recorded for a validator.
+func (db *InMemoryDB) ValidatorVotes(validatorIndex uint64) ([]*ValidatorVote, error) {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+	var votes []*ValidatorVote
+	for _, vote := range db.validatorVotes[validatorIndex] {
+		saved := *vote
+		votes = append(votes, &saved)
+	}
+	sort.Slice(votes, func(i, j int) bool {
+		return votes[i].TargetEpoch < votes[j].TargetEpoch
+	})
+	return votes, nil
+}
+
+// PruneSlasherHistory deletes the proposals, votes and attestations recorded by the
+// slasher before the given epoch.
+func (db *InMemoryDB) PruneSlasherHistory(epoch uint64) error {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	startSlot := epoch * params.BeaconConfig().SlotsPerEpoch
+	for key := range db.proposalRecords {
+		if key.slot < startSlot {
+			delete(db.proposalRecords, key)
+		}
+	}
+	for targetEpoch := range db.slashableAttestations {
+		if targetEpoch < epoch {
+			delete(db.slashableAttestations, targetEpoch)
+		}
+	}
+	for _, votes := range db.validatorVotes {
+		for targetEpoch := range votes {
+			if targetEpoch < epoch {
+				delete(votes, targetEpoch)
+			}
+		}
+	}
+	return nil
+}
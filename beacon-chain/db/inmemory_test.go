@@ -0,0 +1,290 @@
+package db
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// runDatabaseTest runs the test against both the bolt and the in-memory database.
+func runDatabaseTest(t *testing.T, test func(t *testing.T, db Database)) {
+	t.Run("bolt", func(t *testing.T) {
+		db := setupDB(t)
+		defer teardownDB(t, db)
+		test(t, db)
+	})
+	t.Run("in-memory", func(t *testing.T) {
+		test(t, NewInMemoryDB())
+	})
+}
+
+func TestDatabase_BlocksAndMainChain(t *testing.T) {
+	runDatabaseTest(t, func(t *testing.T, db Database) {
+		ctx := context.Background()
+		parent := &pb.BeaconBlock{Slot: 1}
+		parentRoot, err := hashutil.HashBeaconBlock(parent)
+		if err != nil {
+			t.Fatal(err)
+		}
+		child := &pb.BeaconBlock{Slot: 2, ParentRootHash32: parentRoot[:]}
+		fork := &pb.BeaconBlock{Slot: 2, ParentRootHash32: parentRoot[:], RandaoReveal: []byte{1}}
+		for _, block := range []*pb.BeaconBlock{parent, fork, child} {
+			if err := db.SaveBlock(block); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if _, err := db.ChainHead(); err == nil {
+			t.Error("Expected an error before the chain head is set")
+		}
+		// Saved blocks are only included in the main chain once they are canonical.
+		if block, err := db.BlockBySlot(ctx, 2); err != nil || block != nil {
+			t.Errorf("Expected no block in the main chain at slot 2, received %v, %v", block, err)
+		}
+		if err := db.UpdateChainHead(ctx, child, &pb.BeaconState{Slot: 2}); err != nil {
+			t.Fatal(err)
+		}
+		competing := &pb.BeaconBlock{Slot: 2, ParentRootHash32: parentRoot[:], RandaoReveal: []byte{2}}
+		if err := db.SaveBlock(competing); err != nil {
+			t.Fatal(err)
+		}
+		if block, err := db.BlockBySlot(ctx, 2); err != nil || !proto.Equal(block, child) {
+			t.Errorf("Expected the head %v in the main chain at slot 2, received %v, %v", child, block, err)
+		}
+		if err := db.DeleteBlock(competing); err != nil {
+			t.Fatal(err)
+		}
+		head, err := db.ChainHead()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(head, child) {
+			t.Errorf("Expected head %v, received %v", child, head)
+		}
+		if db.HighestBlockSlot() != 2 {
+			t.Errorf("Expected highest block slot 2, received %d", db.HighestBlockSlot())
+		}
+		if err := db.UpdateChainHead(ctx, &pb.BeaconBlock{Slot: 5}, &pb.BeaconState{Slot: 5}); err == nil {
+			t.Error("Expected an error updating the head to an unsaved block")
+		}
+
+		children, err := db.BlocksByParent(ctx, parentRoot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(children) != 2 {
+			t.Errorf("Expected 2 children, received %d", len(children))
+		}
+		atSlot, err := db.BlocksBySlot(ctx, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(atSlot) != 2 {
+			t.Errorf("Expected 2 blocks at slot 2, received %d", len(atSlot))
+		}
+
+		if err := db.ReorgMainChain(ctx, []*pb.BeaconBlock{child}, []*pb.BeaconBlock{fork}); err != nil {
+			t.Fatal(err)
+		}
+		canonical, err := db.BlockBySlot(ctx, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(canonical, fork) {
+			t.Errorf("Expected the fork to be canonical at slot 2, received %v", canonical)
+		}
+
+		if err := db.DeleteBlock(fork); err != nil {
+			t.Fatal(err)
+		}
+		forkRoot, err := hashutil.HashBeaconBlock(fork)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if db.HasBlock(forkRoot) {
+			t.Error("Expected the fork to be deleted")
+		}
+		if block, err := db.BlockBySlot(ctx, 2); err != nil || block != nil {
+			t.Errorf("Expected no block in the main chain at slot 2, received %v, %v", block, err)
+		}
+
+		// Retrieved blocks are copies.
+		retrieved, err := db.Block(parentRoot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		retrieved.Slot = 100
+		if again, _ := db.Block(parentRoot); again.Slot != 1 {
+			t.Error("Expected the saved block not to be modified through a retrieved copy")
+		}
+	})
+}
+
+func TestDatabase_States(t *testing.T) {
+	runDatabaseTest(t, func(t *testing.T, db Database) {
+		ctx := context.Background()
+		if _, err := db.JustifiedState(); err == nil {
+			t.Error("Expected an error when no justified state is saved")
+		}
+		if _, err := db.FinalizedState(); err == nil {
+			t.Error("Expected an error when no finalized state is saved")
+		}
+		if headState, err := db.HeadState(ctx); err != nil || headState != nil {
+			t.Errorf("Expected no head state, received %v, %v", headState, err)
+		}
+
+		saved := &pb.BeaconState{Slot: 10}
+		if err := db.SaveState(ctx, saved); err != nil {
+			t.Fatal(err)
+		}
+		saved.Slot = 11
+		headState, err := db.HeadState(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if headState.Slot != 10 {
+			t.Errorf("Expected head state of slot 10, received %d", headState.Slot)
+		}
+		if err := db.SaveHistoricalState(ctx, &pb.BeaconState{Slot: 20}); err != nil {
+			t.Fatal(err)
+		}
+		historical, err := db.HistoricalStateFromSlot(ctx, 15)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if historical.Slot != 10 {
+			t.Errorf("Expected historical state of slot 10, received %d", historical.Slot)
+		}
+
+		if err := db.SaveJustifiedState(&pb.BeaconState{Slot: 8}); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.SaveFinalizedState(&pb.BeaconState{Slot: 4}); err != nil {
+			t.Fatal(err)
+		}
+		if justified, err := db.JustifiedState(); err != nil || justified.Slot != 8 {
+			t.Errorf("Expected justified state of slot 8, received %v, %v", justified, err)
+		}
+		if finalized, err := db.FinalizedState(); err != nil || finalized.Slot != 4 {
+			t.Errorf("Expected finalized state of slot 4, received %v, %v", finalized, err)
+		}
+
+		// Of the states older than the finalized state, only the earliest of
+		// every 2 epochs is retained.
+		db.SetHistoricalStateRetention(HistoricalStateRetention{EpochInterval: 2})
+		epochStart := func(epoch uint64) uint64 {
+			return params.BeaconConfig().GenesisSlot + epoch*params.BeaconConfig().SlotsPerEpoch
+		}
+		retained := []uint64{epochStart(2) + 1, epochStart(4) + 1, epochStart(6) + 1}
+		pruned := []uint64{epochStart(3), epochStart(5)}
+		for _, slot := range append(retained, pruned...) {
+			if err := db.SaveHistoricalState(ctx, &pb.BeaconState{Slot: slot}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := db.SaveFinalizedState(&pb.BeaconState{Slot: epochStart(6)}); err != nil {
+			t.Fatal(err)
+		}
+		for _, slot := range retained {
+			if historical, err := db.HistoricalStateFromSlot(ctx, slot); err != nil || historical.Slot != slot {
+				t.Errorf("Expected historical state of slot %d to be retained, received %v, %v", slot, historical, err)
+			}
+		}
+		for _, slot := range pruned {
+			if historical, err := db.HistoricalStateFromSlot(ctx, slot); err != nil || historical.Slot == slot {
+				t.Errorf("Expected historical state of slot %d to be pruned, received %v, %v", slot, historical, err)
+			}
+		}
+	})
+}
+
+func TestDatabase_OperationsAndValidators(t *testing.T) {
+	runDatabaseTest(t, func(t *testing.T, db Database) {
+		ctx := context.Background()
+		attestation := &pb.Attestation{Data: &pb.AttestationData{Slot: 3}}
+		hash, err := hashutil.HashProto(attestation)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.SaveAttestation(ctx, attestation); err != nil {
+			t.Fatal(err)
+		}
+		if !db.HasAttestation(hash) {
+			t.Error("Expected the attestation to be saved")
+		}
+		if attestations, err := db.Attestations(); err != nil || len(attestations) != 1 {
+			t.Errorf("Expected 1 attestation, received %v, %v", attestations, err)
+		}
+		if err := db.DeleteAttestation(attestation); err != nil {
+			t.Fatal(err)
+		}
+		if db.HasAttestation(hash) {
+			t.Error("Expected the attestation to be deleted")
+		}
+
+		exit := &pb.VoluntaryExit{ValidatorIndex: 1}
+		if err := db.SaveExit(ctx, exit); err != nil {
+			t.Fatal(err)
+		}
+		if exits, err := db.Exits(); err != nil || len(exits) != 1 || !proto.Equal(exits[0], exit) {
+			t.Errorf("Expected exit %v, received %v, %v", exit, exits, err)
+		}
+
+		pubKey := []byte("validator")
+		if _, err := db.ValidatorIndex(pubKey); err == nil || !strings.Contains(err.Error(), "does not exist") {
+			t.Errorf("Expected unknown validator error, received %v", err)
+		}
+		if err := db.SaveValidatorIndex(pubKey, 7); err != nil {
+			t.Fatal(err)
+		}
+		if index, err := db.ValidatorIndex(pubKey); err != nil || index != 7 {
+			t.Errorf("Expected validator index 7, received %d, %v", index, err)
+		}
+		if !db.HasAllValidators([][]byte{pubKey}) || db.HasAllValidators([][]byte{pubKey, []byte("other")}) {
+			t.Error("Expected only the saved validator to be found")
+		}
+		if !db.HasAnyValidators([][]byte{[]byte("other"), pubKey}) {
+			t.Error("Expected any of the validators to be found")
+		}
+	})
+}
+
+func TestDatabase_Deposits(t *testing.T) {
+	runDatabaseTest(t, func(t *testing.T, db Database) {
+		ctx := context.Background()
+		db.InsertDeposit(ctx, &pb.Deposit{MerkleTreeIndex: 1}, big.NewInt(10))
+		db.InsertDeposit(ctx, &pb.Deposit{MerkleTreeIndex: 0}, big.NewInt(5))
+		if deposits := db.AllDeposits(ctx, big.NewInt(5)); len(deposits) != 1 {
+			t.Errorf("Expected 1 deposit until block 5, received %d", len(deposits))
+		}
+		db.InsertPendingDeposit(ctx, &pb.Deposit{MerkleTreeIndex: 1}, big.NewInt(10))
+		db.PrunePendingDeposits(ctx, 2)
+		if deposits := db.PendingDeposits(ctx, nil); len(deposits) != 0 {
+			t.Errorf("Expected pending deposits to be pruned, received %v", deposits)
+		}
+
+		if blockNum, err := db.LastProcessedEth1Block(ctx); err != nil || blockNum != nil {
+			t.Errorf("Expected no last processed eth1 block, received %v, %v", blockNum, err)
+		}
+		if err := db.SaveLastProcessedEth1Block(ctx, big.NewInt(12)); err != nil {
+			t.Fatal(err)
+		}
+		if blockNum, err := db.LastProcessedEth1Block(ctx); err != nil || blockNum.Cmp(big.NewInt(12)) != 0 {
+			t.Errorf("Expected last processed eth1 block 12, received %v, %v", blockNum, err)
+		}
+
+		if err := db.VerifyContractAddress(ctx, common.HexToAddress("0x01")); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.VerifyContractAddress(ctx, common.HexToAddress("0x02")); err == nil {
+			t.Error("Expected a different deposit contract address to be rejected")
+		}
+	})
+}
@@ -0,0 +1,54 @@
+package db
+
+import (
+	"errors"
+
+	"github.com/boltdb/bolt"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// BucketStats describes the contents of a bucket of the database.
+type BucketStats struct {
+	Name string
+	Keys int
+	// Size is the number of bytes taken by the keys and values of the bucket.
+	Size int
+}
+
+// BucketStats returns the number of keys and the size of each bucket of the
+// database, ordered by bucket name.
+func (db *BeaconDB) BucketStats() ([]*BucketStats, error) {
+	var stats []*BucketStats
+	err := db.view(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			bucketStats := &BucketStats{Name: string(name)}
+			if err := b.ForEach(func(k []byte, v []byte) error {
+				bucketStats.Keys++
+				bucketStats.Size += len(k) + len(v)
+				return nil
+			}); err != nil {
+				return err
+			}
+			stats = append(stats, bucketStats)
+			return nil
+		})
+	})
+	return stats, err
+}
+
+// HistoricalStateByRoot retrieves a historical state by its hash tree root, which
+// is the state root of the block that it is the post state of.
+func (db *BeaconDB) HistoricalStateByRoot(root [32]byte) (*pb.BeaconState, error) {
+	var beaconState *pb.BeaconState
+	err := db.view(func(tx *bolt.Tx) error {
+		encState := tx.Bucket(chainInfoBucket).Get(root[:])
+		if encState == nil {
+			return errors.New("no historical state saved with this root")
+		}
+
+		var err error
+		beaconState, err = createState(encState)
+		return err
+	})
+	return beaconState, err
+}
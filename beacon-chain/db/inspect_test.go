@@ -0,0 +1,112 @@
+package db
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+func TestNewReadOnlyDB(t *testing.T) {
+	db := setupDB(t)
+	block := &pb.BeaconBlock{Slot: 1}
+	if err := db.SaveBlock(block); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(db.DatabasePath)
+
+	readOnly, err := NewReadOnlyDB(db.DatabasePath)
+	if err != nil {
+		t.Fatalf("Could not open database read-only: %v", err)
+	}
+	root, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !readOnly.HasBlock(root) {
+		t.Error("Expected the saved block to be found")
+	}
+	if err := readOnly.SaveBlock(&pb.BeaconBlock{Slot: 2}); err == nil {
+		t.Error("Expected an error writing to a read-only database")
+	}
+	if err := readOnly.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewReadOnlyDB(db.DatabasePath + "-missing"); err == nil {
+		t.Error("Expected an error opening a database which does not exist")
+	}
+}
+
+func TestNewReadOnlyDB_RefusesOutdatedSchemaVersion(t *testing.T) {
+	dirPath, _, _ := setupLegacyDB(t)
+	defer os.RemoveAll(dirPath)
+
+	if _, err := NewReadOnlyDB(dirPath); err == nil || !strings.Contains(err.Error(), "start the beacon node to migrate it") {
+		t.Errorf("Expected outdated schema version error, received %v", err)
+	}
+}
+
+func TestBucketStats(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	for i := uint64(0); i < 3; i++ {
+		if err := db.SaveBlock(&pb.BeaconBlock{Slot: i}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	stats, err := db.BucketStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var blocks *BucketStats
+	for i, s := range stats {
+		if i > 0 && stats[i-1].Name >= s.Name {
+			t.Errorf("Expected buckets ordered by name, received %s before %s", stats[i-1].Name, s.Name)
+		}
+		if s.Name == string(blockBucket) {
+			blocks = s
+		}
+	}
+	if blocks == nil {
+		t.Fatal("Expected stats of the block bucket")
+	}
+	if blocks.Keys != 3 {
+		t.Errorf("Expected 3 blocks, received %d", blocks.Keys)
+	}
+	if blocks.Size <= 3*32 {
+		t.Errorf("Expected the size to include the encoded blocks, received %d", blocks.Size)
+	}
+}
+
+func TestHistoricalStateByRoot(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	beaconState := &pb.BeaconState{Slot: 5}
+	if err := db.SaveHistoricalState(context.Background(), beaconState); err != nil {
+		t.Fatal(err)
+	}
+	root, err := hashutil.HashProto(beaconState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	retrieved, err := db.HistoricalStateByRoot(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(retrieved, beaconState) {
+		t.Errorf("Expected state %v, received %v", beaconState, retrieved)
+	}
+	if _, err := db.HistoricalStateByRoot([32]byte{'a'}); err == nil {
+		t.Error("Expected an error for an unknown state root")
+	}
+}
@@ -0,0 +1,239 @@
+package db
+
+import (
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/boltdb/bolt"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+)
+
+// Migration upgrades the database by one schema version. Any change to the keys
+// in schema.go or to the encoding of stored values must come with a migration.
+type Migration struct {
+	Version     uint64
+	Description string
+	migrate     func(tx *bolt.Tx) error
+}
+
+// migrations must be ordered by version, starting at 1 with no gaps. Databases
+// created before schema versioning are at version 0.
+var migrations = []*Migration{
+	{
+		Version:     1,
+		Description: "index saved blocks by parent root and by slot",
+		migrate:     indexBlocksByParentAndSlot,
+	},
+	{
+		Version:     2,
+		Description: "store the root of the chain head",
+		migrate:     storeChainHeadRoot,
+	},
+	{
+		Version:     3,
+		Description: "re-key historical states by big-endian slot",
+		migrate:     rekeyHistoricalStates,
+	},
+	{
+		Version:     4,
+		Description: "rebuild the main chain from the chain head",
+		migrate:     rebuildMainChain,
+	},
+}
+
+// LatestSchemaVersion is the schema version of databases created or migrated by
+// this release.
+func LatestSchemaVersion() uint64 {
+	return uint64(len(migrations))
+}
+
+// SchemaVersion returns the schema version of the database.
+func (db *BeaconDB) SchemaVersion() (uint64, error) {
+	var version uint64
+	err := db.view(func(tx *bolt.Tx) error {
+		version = schemaVersion(tx)
+		return nil
+	})
+	return version, err
+}
+
+// PendingMigrations returns the migrations which would be applied when opening
+// the database in the directory, without modifying it. A database which does
+// not exist yet needs no migration.
+func PendingMigrations(dirPath string) ([]*Migration, error) {
+	datafile := path.Join(dirPath, "beaconchain.db")
+	if _, err := os.Stat(datafile); os.IsNotExist(err) {
+		return nil, nil
+	}
+	boltDB, err := openBoltDB(dirPath, true /* readOnly */)
+	if err != nil {
+		return nil, err
+	}
+	defer boltDB.Close()
+
+	var pending []*Migration
+	err = boltDB.View(func(tx *bolt.Tx) error {
+		if tx.Bucket(blockBucket) == nil {
+			return nil
+		}
+		version := schemaVersion(tx)
+		if version > LatestSchemaVersion() {
+			return newerSchemaError(version)
+		}
+		pending = migrations[version:]
+		return nil
+	})
+	return pending, err
+}
+
+// migrate applies the migrations above the schema version of the database in
+// order. Each migration is committed along with its version, so an interrupted
+// upgrade resumes from the last applied migration.
+func (db *BeaconDB) migrate() error {
+	version, err := db.SchemaVersion()
+	if err != nil {
+		return err
+	}
+	if version > LatestSchemaVersion() {
+		return newerSchemaError(version)
+	}
+	for _, m := range migrations[version:] {
+		if err := db.update(func(tx *bolt.Tx) error {
+			if err := m.migrate(tx); err != nil {
+				return err
+			}
+			return tx.Bucket(chainInfoBucket).Put(schemaVersionKey, bytesutil.Bytes8(m.Version))
+		}); err != nil {
+			return fmt.Errorf("could not migrate database to schema version %d: %v", m.Version, err)
+		}
+		log.WithFields(logrus.Fields{
+			"version":     m.Version,
+			"description": m.Description,
+		}).Info("Applied database migration")
+	}
+	return nil
+}
+
+func schemaVersion(tx *bolt.Tx) uint64 {
+	chainInfo := tx.Bucket(chainInfoBucket)
+	if chainInfo == nil {
+		return 0
+	}
+	enc := chainInfo.Get(schemaVersionKey)
+	if enc == nil {
+		return 0
+	}
+	return bytesutil.FromBytes8(enc)
+}
+
+func newerSchemaError(version uint64) error {
+	return fmt.Errorf(
+		"database schema version %d is newer than the latest supported version %d, it was written by a newer release",
+		version,
+		LatestSchemaVersion(),
+	)
+}
+
+// indexBlocksByParentAndSlot adds the parent root and slot index entries of the
+// blocks saved before the indices were introduced.
+func indexBlocksByParentAndSlot(tx *bolt.Tx) error {
+	return tx.Bucket(blockBucket).ForEach(func(root []byte, enc []byte) error {
+		block, err := createBlock(enc)
+		if err != nil {
+			return err
+		}
+		return indexBlock(tx, block, root)
+	})
+}
+
+// storeChainHeadRoot records the root of the chain head, which used to be looked
+// up in the main chain at the chain height.
+func storeChainHeadRoot(tx *bolt.Tx) error {
+	chainInfo := tx.Bucket(chainInfoBucket)
+	if chainInfo.Get(mainChainHeadRootKey) != nil {
+		return nil
+	}
+	height := chainInfo.Get(mainChainHeightKey)
+	if height == nil {
+		return nil
+	}
+	root := tx.Bucket(mainChainBucket).Get(height)
+	if root == nil {
+		return nil
+	}
+	return chainInfo.Put(mainChainHeadRootKey, root)
+}
+
+// rekeyHistoricalStates re-encodes the slot keys of historical states, which
+// used to be little-endian and could not be seeked by slot.
+func rekeyHistoricalStates(tx *bolt.Tx) error {
+	histState := tx.Bucket(histStateBucket)
+	hashesBySlot := make(map[uint64][]byte)
+	if err := histState.ForEach(func(k []byte, v []byte) error {
+		hashesBySlot[decodeToSlotNumber(k)] = append([]byte{}, v...)
+		return nil
+	}); err != nil {
+		return err
+	}
+	for slot := range hashesBySlot {
+		if err := histState.Delete(encodeSlotNumber(slot)); err != nil {
+			return err
+		}
+	}
+	for slot, stateHash := range hashesBySlot {
+		if err := histState.Put(encodeHistoricalStateSlot(slot), stateHash); err != nil {
+			return fmt.Errorf("failed to re-key the historical state of slot %d: %v", slot, err)
+		}
+	}
+	return nil
+}
+
+// rebuildMainChain rewrites the main chain with the ancestors of the chain head.
+// Blocks used to be included in the main chain when saved, so a block of a fork
+// could own the slot of a canonical block.
+func rebuildMainChain(tx *bolt.Tx) error {
+	headRoot := tx.Bucket(chainInfoBucket).Get(mainChainHeadRootKey)
+	if headRoot == nil {
+		return nil
+	}
+	blockBkt := tx.Bucket(blockBucket)
+	canonical := make(map[string][]byte)
+	isCanonical := make(map[string]bool)
+	for root := headRoot; ; {
+		enc := blockBkt.Get(root)
+		if enc == nil {
+			break
+		}
+		block, err := createBlock(enc)
+		if err != nil {
+			return err
+		}
+		canonical[string(encodeSlotNumber(block.Slot))] = append([]byte{}, root...)
+		isCanonical[string(root)] = true
+		root = block.ParentRootHash32
+	}
+
+	mainChain := tx.Bucket(mainChainBucket)
+	var forks [][]byte
+	if err := mainChain.ForEach(func(slot []byte, root []byte) error {
+		if !isCanonical[string(root)] {
+			forks = append(forks, append([]byte{}, slot...))
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, slot := range forks {
+		if err := mainChain.Delete(slot); err != nil {
+			return fmt.Errorf("failed to remove a fork block from the main chain: %v", err)
+		}
+	}
+	for slot, root := range canonical {
+		if err := mainChain.Put([]byte(slot), root); err != nil {
+			return fmt.Errorf("failed to include the block in the main chain bucket: %v", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/boltdb/bolt"
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+// setupLegacyDB saves a chain head with its parent, then reverts the database to
+// how a release without schema versioning would have written it, including a fork
+// block which took over the slot of the parent in the main chain.
+func setupLegacyDB(t *testing.T) (string, *pb.BeaconBlock, *pb.BeaconBlock) {
+	db := setupDB(t)
+	parent := &pb.BeaconBlock{Slot: 1}
+	parentRoot, err := hashutil.HashBeaconBlock(parent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	head := &pb.BeaconBlock{Slot: 2, ParentRootHash32: parentRoot[:]}
+	fork := &pb.BeaconBlock{Slot: 1, RandaoReveal: []byte{'F'}}
+	forkRoot, err := hashutil.HashBeaconBlock(fork)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, block := range []*pb.BeaconBlock{parent, head, fork} {
+		if err := db.SaveBlock(block); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.UpdateChainHead(context.Background(), head, &pb.BeaconState{Slot: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.update(func(tx *bolt.Tx) error {
+		for _, bucket := range [][]byte{blockParentIndexBucket, blockSlotIndexBucket} {
+			if err := tx.DeleteBucket(bucket); err != nil {
+				return err
+			}
+		}
+		histState := tx.Bucket(histStateBucket)
+		stateHash := histState.Get(encodeHistoricalStateSlot(head.Slot))
+		if err := histState.Delete(encodeHistoricalStateSlot(head.Slot)); err != nil {
+			return err
+		}
+		if err := histState.Put(encodeSlotNumber(head.Slot), stateHash); err != nil {
+			return err
+		}
+		if err := tx.Bucket(mainChainBucket).Put(encodeSlotNumber(fork.Slot), forkRoot[:]); err != nil {
+			return err
+		}
+		chainInfo := tx.Bucket(chainInfoBucket)
+		if err := chainInfo.Delete(mainChainHeadRootKey); err != nil {
+			return err
+		}
+		return chainInfo.Delete(schemaVersionKey)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return db.DatabasePath, parent, head
+}
+
+func setSchemaVersion(t *testing.T, db *BeaconDB, version uint64) {
+	if err := db.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(chainInfoBucket).Put(schemaVersionKey, bytesutil.Bytes8(version))
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNewDB_CreatedAtLatestSchemaVersion(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	version, err := db.SchemaVersion()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != LatestSchemaVersion() {
+		t.Errorf("Expected schema version %d, received %d", LatestSchemaVersion(), version)
+	}
+}
+
+func TestNewDB_MigratesLegacyDatabase(t *testing.T) {
+	dirPath, parent, head := setupLegacyDB(t)
+	db, err := NewDB(dirPath)
+	if err != nil {
+		t.Fatalf("Could not open legacy database: %v", err)
+	}
+	defer teardownDB(t, db)
+
+	version, err := db.SchemaVersion()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != LatestSchemaVersion() {
+		t.Errorf("Expected schema version %d, received %d", LatestSchemaVersion(), version)
+	}
+	children, err := db.BlocksByParent(context.Background(), bytesutil.ToBytes32(head.ParentRootHash32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(children) != 1 || !proto.Equal(children[0], head) {
+		t.Errorf("Expected the head to be indexed as the child of %v, received %v", parent, children)
+	}
+	atSlot, err := db.BlocksBySlot(context.Background(), head.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(atSlot) != 1 || !proto.Equal(atSlot[0], head) {
+		t.Errorf("Expected the head to be indexed by slot, received %v", atSlot)
+	}
+	mainBlock, err := db.BlockBySlot(context.Background(), parent.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(mainBlock, parent) {
+		t.Errorf("Expected the parent to replace the fork block in the main chain, received %v", mainBlock)
+	}
+	headRoot, err := hashutil.HashBeaconBlock(head)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.view(func(tx *bolt.Tx) error {
+		if root := tx.Bucket(chainInfoBucket).Get(mainChainHeadRootKey); !bytes.Equal(root, headRoot[:]) {
+			t.Errorf("Expected head root %#x, received %#x", headRoot, root)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	headState, err := db.HistoricalStateFromSlot(context.Background(), head.Slot+10)
+	if err != nil {
+		t.Fatalf("Could not retrieve re-keyed historical state: %v", err)
+	}
+	if headState.Slot != head.Slot {
+		t.Errorf("Expected historical state of slot %d, received %d", head.Slot, headState.Slot)
+	}
+}
+
+func TestNewDB_RefusesNewerSchemaVersion(t *testing.T) {
+	db := setupDB(t)
+	setSchemaVersion(t, db, LatestSchemaVersion()+1)
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(db.DatabasePath)
+
+	if _, err := NewDB(db.DatabasePath); err == nil || !strings.Contains(err.Error(), "newer than the latest supported version") {
+		t.Errorf("Expected newer schema version error, received %v", err)
+	}
+	if _, err := PendingMigrations(db.DatabasePath); err == nil {
+		t.Error("Expected dry run to refuse a newer schema version")
+	}
+}
+
+func TestPendingMigrations_DoesNotModifyDatabase(t *testing.T) {
+	dirPath, _, _ := setupLegacyDB(t)
+	defer os.RemoveAll(dirPath)
+
+	for i := 0; i < 2; i++ {
+		pending, err := PendingMigrations(dirPath)
+		if err != nil {
+			t.Fatalf("Could not list pending migrations: %v", err)
+		}
+		if uint64(len(pending)) != LatestSchemaVersion() {
+			t.Fatalf("Expected %d pending migrations, received %d", LatestSchemaVersion(), len(pending))
+		}
+		for j, m := range pending {
+			if m.Version != uint64(j+1) {
+				t.Errorf("Expected migration %d at position %d, received %d", j+1, j, m.Version)
+			}
+		}
+	}
+
+	pending, err := PendingMigrations(dirPath + "-missing")
+	if err != nil || len(pending) != 0 {
+		t.Errorf("Expected no pending migrations for a new database, received %v, %v", pending, err)
+	}
+}
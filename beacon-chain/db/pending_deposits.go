@@ -3,12 +3,10 @@ package db
 import (
 	"context"
 	"math/big"
-	"sort"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
-	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
 
@@ -28,92 +26,61 @@ type depositContainer struct {
 
 // InsertPendingDeposit into the database. If deposit or block number are nil
 // then this method does nothing.
-func (db *BeaconDB) InsertPendingDeposit(ctx context.Context, d *pb.Deposit, blockNum *big.Int) {
+func (db *BeaconDB) InsertPendingDeposit(ctx context.Context, d *pb.Deposit, blockNum *big.Int) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.InsertPendingDeposit")
 	defer span.End()
-	if d == nil || blockNum == nil {
-		log.WithFields(logrus.Fields{
-			"block":   blockNum,
-			"deposit": d,
-		}).Debug("Ignoring nil deposit insertion")
-		return
+	if isNilDeposit(d, blockNum) {
+		return nil
 	}
 	db.depositsLock.Lock()
 	defer db.depositsLock.Unlock()
-	db.pendingDeposits = append(db.pendingDeposits, &depositContainer{deposit: d, block: blockNum})
-	pendingDepositsCount.Inc()
-}
-
-// PendingDeposits returns a list of deposits until the given block number
-// (inclusive). If no block is specified then this method returns all pending
-// deposits.
-func (db *BeaconDB) PendingDeposits(ctx context.Context, beforeBlk *big.Int) []*pb.Deposit {
-	ctx, span := trace.StartSpan(ctx, "BeaconDB.PendingDeposits")
-	defer span.End()
-	db.depositsLock.RLock()
-	defer db.depositsLock.RUnlock()
-
-	var deposits []*pb.Deposit
-	for _, ctnr := range db.pendingDeposits {
-		if beforeBlk == nil || beforeBlk.Cmp(ctnr.block) > -1 {
-			deposits = append(deposits, ctnr.deposit)
-		}
+	ctnr := &depositContainer{deposit: d, block: blockNum}
+	if err := db.saveDepositContainer(pendingDepositsBucket, ctnr); err != nil {
+		return err
 	}
-	// Sort the deposits by Merkle index.
-	sort.SliceStable(deposits, func(i, j int) bool {
-		return deposits[i].MerkleTreeIndex < deposits[j].MerkleTreeIndex
-	})
-	return deposits
+	db.addPendingDeposit(ctnr)
+	return nil
 }
 
 // RemovePendingDeposit from the database. The deposit is indexed by the
 // MerkleTreeIndex. This method does nothing if deposit ptr is nil.
-func (db *BeaconDB) RemovePendingDeposit(ctx context.Context, d *pb.Deposit) {
+func (db *BeaconDB) RemovePendingDeposit(ctx context.Context, d *pb.Deposit) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.RemovePendingDeposit")
 	defer span.End()
 
 	if d == nil {
 		log.Debug("Ignoring nil deposit removal")
-		return
+		return nil
 	}
 
 	db.depositsLock.Lock()
 	defer db.depositsLock.Unlock()
 
-	idx := -1
-	for i, ctnr := range db.pendingDeposits {
-		if ctnr.deposit.MerkleTreeIndex == d.MerkleTreeIndex {
-			idx = i
-			break
+	if idx := db.pendingDepositIndex(d); idx >= 0 {
+		if err := db.deleteDepositContainers(pendingDepositsBucket, []*depositContainer{db.pendingDeposits[idx]}); err != nil {
+			return err
 		}
+		db.removePendingDeposit(idx)
 	}
-
-	if idx >= 0 {
-		db.pendingDeposits = append(db.pendingDeposits[:idx], db.pendingDeposits[idx+1:]...)
-		pendingDepositsCount.Dec()
-	}
+	return nil
 }
 
 // PrunePendingDeposits removes any deposit which is older than the given deposit merkle tree index.
-func (db *BeaconDB) PrunePendingDeposits(ctx context.Context, merkleTreeIndex uint64) {
+func (db *BeaconDB) PrunePendingDeposits(ctx context.Context, merkleTreeIndex uint64) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.PrunePendingDeposits")
 	defer span.End()
 
 	if merkleTreeIndex == 0 {
 		log.Debug("Ignoring 0 deposit removal")
-		return
+		return nil
 	}
 
 	db.depositsLock.Lock()
 	defer db.depositsLock.Unlock()
 
-	var cleanDeposits []*depositContainer
-	for _, dp := range db.pendingDeposits {
-		if dp.deposit.MerkleTreeIndex >= merkleTreeIndex {
-			cleanDeposits = append(cleanDeposits, dp)
-		}
+	if err := db.deleteDepositContainers(pendingDepositsBucket, db.prunedPendingDeposits(merkleTreeIndex)); err != nil {
+		return err
 	}
-
-	db.pendingDeposits = cleanDeposits
-	pendingDepositsCount.Set(float64(len(db.pendingDeposits)))
+	db.prunePendingDeposits(merkleTreeIndex)
+	return nil
 }
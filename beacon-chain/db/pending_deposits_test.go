@@ -11,7 +11,7 @@ import (
 )
 
 func TestInsertPendingDeposit_OK(t *testing.T) {
-	db := BeaconDB{}
+	db := depositCache{}
 	db.InsertPendingDeposit(context.Background(), &pb.Deposit{}, big.NewInt(111))
 
 	if len(db.pendingDeposits) != 1 {
@@ -20,7 +20,7 @@ func TestInsertPendingDeposit_OK(t *testing.T) {
 }
 
 func TestInsertPendingDeposit_ignoresNilDeposit(t *testing.T) {
-	db := BeaconDB{}
+	db := depositCache{}
 	db.InsertPendingDeposit(context.Background(), nil /*deposit*/, nil /*blockNum*/)
 
 	if len(db.pendingDeposits) > 0 {
@@ -29,7 +29,7 @@ func TestInsertPendingDeposit_ignoresNilDeposit(t *testing.T) {
 }
 
 func TestRemovePendingDeposit_OK(t *testing.T) {
-	db := BeaconDB{}
+	db := depositCache{}
 	depToRemove := &pb.Deposit{MerkleTreeIndex: 1}
 	otherDep := &pb.Deposit{MerkleTreeIndex: 5}
 	db.pendingDeposits = []*depositContainer{
@@ -44,7 +44,7 @@ func TestRemovePendingDeposit_OK(t *testing.T) {
 }
 
 func TestRemovePendingDeposit_IgnoresNilDeposit(t *testing.T) {
-	db := BeaconDB{}
+	db := depositCache{}
 	db.pendingDeposits = []*depositContainer{{deposit: &pb.Deposit{}}}
 	db.RemovePendingDeposit(context.Background(), nil /*deposit*/)
 	if len(db.pendingDeposits) != 1 {
@@ -53,7 +53,7 @@ func TestRemovePendingDeposit_IgnoresNilDeposit(t *testing.T) {
 }
 
 func TestPendingDeposit_RoundTrip(t *testing.T) {
-	db := BeaconDB{}
+	db := depositCache{}
 	dep := &pb.Deposit{MerkleTreeIndex: 123}
 	db.InsertPendingDeposit(context.Background(), dep, big.NewInt(111))
 	db.RemovePendingDeposit(context.Background(), dep)
@@ -63,7 +63,7 @@ func TestPendingDeposit_RoundTrip(t *testing.T) {
 }
 
 func TestPendingDeposits_OK(t *testing.T) {
-	db := BeaconDB{}
+	db := depositCache{}
 
 	db.pendingDeposits = []*depositContainer{
 		{block: big.NewInt(2), deposit: &pb.Deposit{MerkleTreeIndex: 2}},
@@ -88,7 +88,7 @@ func TestPendingDeposits_OK(t *testing.T) {
 }
 
 func TestPrunePendingDeposits_ZeroMerkleIndex(t *testing.T) {
-	db := BeaconDB{}
+	db := depositCache{}
 
 	db.pendingDeposits = []*depositContainer{
 		{block: big.NewInt(2), deposit: &pb.Deposit{MerkleTreeIndex: 2}},
@@ -115,7 +115,7 @@ func TestPrunePendingDeposits_ZeroMerkleIndex(t *testing.T) {
 }
 
 func TestPrunePendingDeposits_OK(t *testing.T) {
-	db := BeaconDB{}
+	db := depositCache{}
 
 	db.pendingDeposits = []*depositContainer{
 		{block: big.NewInt(2), deposit: &pb.Deposit{MerkleTreeIndex: 2}},
@@ -158,3 +158,48 @@ func TestPrunePendingDeposits_OK(t *testing.T) {
 	}
 
 }
+
+func TestDeposits_PersistAcrossRestart(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	deposits := []*pb.Deposit{{MerkleTreeIndex: 0}, {MerkleTreeIndex: 1}, {MerkleTreeIndex: 2}}
+	for i, dep := range deposits {
+		db.InsertDeposit(ctx, dep, big.NewInt(int64(10+i)))
+		db.InsertPendingDeposit(ctx, dep, big.NewInt(int64(10+i)))
+	}
+	db.RemovePendingDeposit(ctx, deposits[2])
+	db.PrunePendingDeposits(ctx, 1)
+	db.MarkPubkeyForChainstart(ctx, "pubkey")
+	if err := db.SaveLastProcessedEth1Block(ctx, big.NewInt(12)); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := NewDB(db.DatabasePath)
+	if err != nil {
+		t.Fatalf("Could not reopen db: %v", err)
+	}
+	defer teardownDB(t, db)
+	if all := db.AllDeposits(ctx, nil); !reflect.DeepEqual(all, deposits) {
+		t.Errorf("Expected deposits %v, received %v", deposits, all)
+	}
+	if beforeBlk := db.AllDeposits(ctx, big.NewInt(11)); len(beforeBlk) != 2 {
+		t.Errorf("Expected 2 deposits until block 11, received %d", len(beforeBlk))
+	}
+	if pending := db.PendingDeposits(ctx, nil); len(pending) != 1 || !proto.Equal(pending[0], deposits[1]) {
+		t.Errorf("Expected pending deposit %v, received %v", deposits[1], pending)
+	}
+	if !db.PubkeyInChainstart(ctx, "pubkey") {
+		t.Error("Expected chainstart pubkey to be persisted")
+	}
+	lastProcessed, err := db.LastProcessedEth1Block(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lastProcessed.Cmp(big.NewInt(12)) != 0 {
+		t.Errorf("Expected last processed eth1 block 12, received %v", lastProcessed)
+	}
+}
@@ -1,6 +1,8 @@
 package db
 
 import (
+	"encoding/binary"
+
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 )
 
@@ -9,26 +11,52 @@ import (
 // using that as the key to store blocks.
 // `block` + hash -> block
 //
+// Every saved block is also indexed by its parent root and by its slot, with
+// one entry per block so that competing blocks of different forks are kept:
+// parent root + block root -> nothing
+// slot + block root -> nothing
+//
+// Historical states are indexed by big-endian slot, so they can be looked up
+// with a cursor seek:
+// slot -> state hash
+//
 // We store the state using the state lookup key, and
 // also the genesis block using the genesis lookup key.
 // The canonical head is stored using the canonical head lookup key.
+//
+// The schema version of the database is stored in the chain info bucket, and
+// existing databases are migrated to the latest version when opened, see migrations.go.
 
 // The fields below define the suffix of keys in the db.
 var (
-	attestationBucket     = []byte("attestation-bucket")
-	blockOperationsBucket = []byte("block-operations-bucket")
-	blockBucket           = []byte("block-bucket")
-	mainChainBucket       = []byte("main-chain-bucket")
-	histStateBucket       = []byte("historical-state-bucket")
-	chainInfoBucket       = []byte("chain-info")
-	validatorBucket       = []byte("validator")
+	attestationBucket         = []byte("attestation-bucket")
+	blockOperationsBucket     = []byte("block-operations-bucket")
+	proposerSlashingBucket    = []byte("proposer-slashing-bucket")
+	attesterSlashingBucket    = []byte("attester-slashing-bucket")
+	blockBucket               = []byte("block-bucket")
+	mainChainBucket           = []byte("main-chain-bucket")
+	blockParentIndexBucket    = []byte("block-parent-index-bucket")
+	blockSlotIndexBucket      = []byte("block-slot-index-bucket")
+	histStateBucket           = []byte("historical-state-bucket")
+	chainInfoBucket           = []byte("chain-info")
+	validatorBucket           = []byte("validator")
+	slasherProposalsBucket    = []byte("slasher-proposals-bucket")
+	slasherAttestationsBucket = []byte("slasher-attestations-bucket")
+	slasherVotesBucket        = []byte("slasher-votes-bucket")
+	depositsBucket            = []byte("deposits-bucket")
+	pendingDepositsBucket     = []byte("pending-deposits-bucket")
+	chainstartPubkeysBucket   = []byte("chainstart-pubkeys-bucket")
 
 	mainChainHeightKey      = []byte("chain-height")
+	mainChainHeadRootKey    = []byte("chain-head-root")
 	stateLookupKey          = []byte("state")
 	finalizedStateLookupKey = []byte("finalized-state")
 	justifiedStateLookupKey = []byte("justified-state")
 	finalizedBlockLookupKey = []byte("finalized-block")
 	justifiedBlockLookupKey = []byte("justified-block")
+	schemaVersionKey        = []byte("schema-version")
+	// The number of the last eth1 block whose deposit logs were processed.
+	lastProcessedEth1BlockKey = []byte("last-processed-eth1-block")
 
 	// DB internal use
 	cleanupHistoryBucket = []byte("cleanup-history-bucket")
@@ -44,3 +72,16 @@ func encodeSlotNumber(number uint64) []byte {
 func decodeToSlotNumber(bytearray []byte) uint64 {
 	return bytesutil.FromBytes8(bytearray)
 }
+
+// encodeHistoricalStateSlot encodes the slot of a historical state as big-endian
+// uint64, so the states are ordered by slot in their bucket.
+func encodeHistoricalStateSlot(slot uint64) []byte {
+	enc := make([]byte, 8)
+	binary.BigEndian.PutUint64(enc, slot)
+	return enc
+}
+
+// decodeHistoricalStateSlot returns the slot of a historical state key.
+func decodeHistoricalStateSlot(enc []byte) uint64 {
+	return binary.BigEndian.Uint64(enc)
+}
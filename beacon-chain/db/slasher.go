@@ -0,0 +1,183 @@
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/boltdb/bolt"
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// ProposalRecord is a block proposal observed by the slasher for a given slot and proposer.
+type ProposalRecord struct {
+	BlockRoot [32]byte
+	Signature []byte
+}
+
+// ValidatorVote is an attestation vote observed by the slasher for a validator.
+type ValidatorVote struct {
+	SourceEpoch     uint64
+	TargetEpoch     uint64
+	DataHash        [32]byte
+	AttestationHash [32]byte
+}
+
+// SaveProposalRecord records the block proposed by a validator at a slot. Only the
+// first proposal seen for a slot and proposer is kept.
+func (db *BeaconDB) SaveProposalRecord(ctx context.Context, slot uint64, proposerIndex uint64, record *ProposalRecord) error {
+	ctx, span := trace.StartSpan(ctx, "beaconDB.SaveProposalRecord")
+	defer span.End()
+
+	key := append(encodeSlotNumber(slot), bytesutil.Bytes8(proposerIndex)...)
+	enc := append(record.BlockRoot[:], record.Signature...)
+	return db.update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(slasherProposalsBucket)
+		if b.Get(key) != nil {
+			return nil
+		}
+		return b.Put(key, enc)
+	})
+}
+
+// ProposalRecord retrieves the block proposed by a validator at a slot. It returns
+// nil if no proposal was recorded.
+func (db *BeaconDB) ProposalRecord(slot uint64, proposerIndex uint64) (*ProposalRecord, error) {
+	key := append(encodeSlotNumber(slot), bytesutil.Bytes8(proposerIndex)...)
+	var record *ProposalRecord
+	err := db.view(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(slasherProposalsBucket).Get(key)
+		if enc == nil {
+			return nil
+		}
+		if len(enc) < 32 {
+			return fmt.Errorf("invalid proposal record length %d", len(enc))
+		}
+		record = &ProposalRecord{
+			BlockRoot: bytesutil.ToBytes32(enc[:32]),
+			Signature: append([]byte{}, enc[32:]...),
+		}
+		return nil
+	})
+	return record, err
+}
+
+// SaveSlashableAttestation stores an attestation observed by the slasher, indexed by
+// its target epoch so it can later be used as evidence in an attester slashing.
+func (db *BeaconDB) SaveSlashableAttestation(ctx context.Context, targetEpoch uint64, att *pb.SlashableAttestation) error {
+	ctx, span := trace.StartSpan(ctx, "beaconDB.SaveSlashableAttestation")
+	defer span.End()
+
+	hash, err := hashutil.HashProto(att)
+	if err != nil {
+		return err
+	}
+	enc, err := proto.Marshal(att)
+	if err != nil {
+		return err
+	}
+	return db.update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(slasherAttestationsBucket)
+		return b.Put(append(bytesutil.Bytes8(targetEpoch), hash[:]...), enc)
+	})
+}
+
+// SlashableAttestation retrieves an attestation observed by the slasher by its target
+// epoch and hash. It returns nil if it was not found.
+func (db *BeaconDB) SlashableAttestation(targetEpoch uint64, hash [32]byte) (*pb.SlashableAttestation, error) {
+	var att *pb.SlashableAttestation
+	err := db.view(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(slasherAttestationsBucket).Get(append(bytesutil.Bytes8(targetEpoch), hash[:]...))
+		if enc == nil {
+			return nil
+		}
+		att = &pb.SlashableAttestation{}
+		return proto.Unmarshal(enc, att)
+	})
+	return att, err
+}
+
+// SaveValidatorVote records the source and target epochs a validator voted for. Only
+// the first vote seen for a validator and target epoch is kept.
+func (db *BeaconDB) SaveValidatorVote(ctx context.Context, validatorIndex uint64, vote *ValidatorVote) error {
+	ctx, span := trace.StartSpan(ctx, "beaconDB.SaveValidatorVote")
+	defer span.End()
+
+	key := append(bytesutil.Bytes8(validatorIndex), bytesutil.Bytes8(vote.TargetEpoch)...)
+	enc := append(bytesutil.Bytes8(vote.SourceEpoch), vote.DataHash[:]...)
+	enc = append(enc, vote.AttestationHash[:]...)
+	return db.update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(slasherVotesBucket)
+		if b.Get(key) != nil {
+			return nil
+		}
+		return b.Put(key, enc)
+	})
+}
+
+// ValidatorVotes retrieves all the votes recorded for a validator.
+func (db *BeaconDB) ValidatorVotes(validatorIndex uint64) ([]*ValidatorVote, error) {
+	prefix := bytesutil.Bytes8(validatorIndex)
+	var votes []*ValidatorVote
+	err := db.view(func(tx *bolt.Tx) error {
+		c := tx.Bucket(slasherVotesBucket).Cursor()
+		for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			if len(k) != 16 || len(v) != 72 {
+				return fmt.Errorf("invalid vote record for validator %d", validatorIndex)
+			}
+			votes = append(votes, &ValidatorVote{
+				SourceEpoch:     bytesutil.FromBytes8(v[:8]),
+				TargetEpoch:     bytesutil.FromBytes8(k[8:]),
+				DataHash:        bytesutil.ToBytes32(v[8:40]),
+				AttestationHash: bytesutil.ToBytes32(v[40:]),
+			})
+		}
+		return nil
+	})
+	return votes, err
+}
+
+// PruneSlasherHistory deletes the proposals, votes and attestations recorded by the
+// slasher before the given epoch.
+func (db *BeaconDB) PruneSlasherHistory(epoch uint64) error {
+	startSlot := epoch * params.BeaconConfig().SlotsPerEpoch
+	return db.update(func(tx *bolt.Tx) error {
+		if err := deleteKeys(tx.Bucket(slasherProposalsBucket), func(k []byte) bool {
+			return decodeToSlotNumber(k[:8]) < startSlot
+		}); err != nil {
+			return err
+		}
+		if err := deleteKeys(tx.Bucket(slasherAttestationsBucket), func(k []byte) bool {
+			return bytesutil.FromBytes8(k[:8]) < epoch
+		}); err != nil {
+			return err
+		}
+		return deleteKeys(tx.Bucket(slasherVotesBucket), func(k []byte) bool {
+			return bytesutil.FromBytes8(k[8:]) < epoch
+		})
+	})
+}
+
+// deleteKeys deletes the keys of a bucket matching the filter.
+func deleteKeys(b *bolt.Bucket, filter func(k []byte) bool) error {
+	var keys [][]byte
+	if err := b.ForEach(func(k, _ []byte) error {
+		if filter(k) {
+			keys = append(keys, append([]byte{}, k...))
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if err := b.Delete(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
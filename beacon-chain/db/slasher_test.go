@@ -0,0 +1,135 @@
+package db
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestDatabase_ProposalRecord(t *testing.T) {
+	runDatabaseTest(t, func(t *testing.T, db Database) {
+		record, err := db.ProposalRecord(10, 1)
+		if err != nil {
+			t.Fatalf("Could not retrieve proposal record: %v", err)
+		}
+		if record != nil {
+			t.Fatalf("Expected no proposal record, received %v", record)
+		}
+
+		first := &ProposalRecord{BlockRoot: [32]byte{'A'}, Signature: []byte{'a'}}
+		if err := db.SaveProposalRecord(context.Background(), 10, 1, first); err != nil {
+			t.Fatalf("Could not save proposal record: %v", err)
+		}
+		second := &ProposalRecord{BlockRoot: [32]byte{'B'}, Signature: []byte{'b'}}
+		if err := db.SaveProposalRecord(context.Background(), 10, 1, second); err != nil {
+			t.Fatalf("Could not save proposal record: %v", err)
+		}
+		record, err = db.ProposalRecord(10, 1)
+		if err != nil {
+			t.Fatalf("Could not retrieve proposal record: %v", err)
+		}
+		if !reflect.DeepEqual(record, first) {
+			t.Errorf("Expected first proposal record %v to be kept, received %v", first, record)
+		}
+	})
+}
+
+func TestDatabase_ValidatorVotes(t *testing.T) {
+	runDatabaseTest(t, func(t *testing.T, db Database) {
+		votes := []*ValidatorVote{
+			{SourceEpoch: 1, TargetEpoch: 2, DataHash: [32]byte{'A'}, AttestationHash: [32]byte{'a'}},
+			{SourceEpoch: 2, TargetEpoch: 3, DataHash: [32]byte{'B'}, AttestationHash: [32]byte{'b'}},
+		}
+		for _, vote := range votes {
+			if err := db.SaveValidatorVote(context.Background(), 5, vote); err != nil {
+				t.Fatalf("Could not save vote: %v", err)
+			}
+		}
+		if err := db.SaveValidatorVote(context.Background(), 6, votes[0]); err != nil {
+			t.Fatalf("Could not save vote: %v", err)
+		}
+
+		saved, err := db.ValidatorVotes(5)
+		if err != nil {
+			t.Fatalf("Could not retrieve votes: %v", err)
+		}
+		if !reflect.DeepEqual(saved, votes) {
+			t.Errorf("Expected votes %v, received %v", votes, saved)
+		}
+	})
+}
+
+func TestDatabase_SlashableAttestation(t *testing.T) {
+	runDatabaseTest(t, func(t *testing.T, db Database) {
+		att := &pb.SlashableAttestation{
+			ValidatorIndices: []uint64{1, 2},
+			Data:             &pb.AttestationData{Slot: 5},
+		}
+		hash, err := hashutil.HashProto(att)
+		if err != nil {
+			t.Fatalf("Could not hash attestation: %v", err)
+		}
+		if err := db.SaveSlashableAttestation(context.Background(), 3, att); err != nil {
+			t.Fatalf("Could not save attestation: %v", err)
+		}
+		saved, err := db.SlashableAttestation(3, hash)
+		if err != nil {
+			t.Fatalf("Could not retrieve attestation: %v", err)
+		}
+		if !reflect.DeepEqual(saved, att) {
+			t.Errorf("Expected attestation %v, received %v", att, saved)
+		}
+	})
+}
+
+func TestDatabase_PruneSlasherHistory(t *testing.T) {
+	runDatabaseTest(t, func(t *testing.T, db Database) {
+		ctx := context.Background()
+		slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+		att := &pb.SlashableAttestation{ValidatorIndices: []uint64{1}}
+		hash, err := hashutil.HashProto(att)
+		if err != nil {
+			t.Fatalf("Could not hash attestation: %v", err)
+		}
+		for _, epoch := range []uint64{1, 2} {
+			if err := db.SaveProposalRecord(ctx, epoch*slotsPerEpoch, 1, &ProposalRecord{}); err != nil {
+				t.Fatal(err)
+			}
+			if err := db.SaveValidatorVote(ctx, 1, &ValidatorVote{TargetEpoch: epoch}); err != nil {
+				t.Fatal(err)
+			}
+			if err := db.SaveSlashableAttestation(ctx, epoch, att); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if err := db.PruneSlasherHistory(2); err != nil {
+			t.Fatalf("Could not prune slasher history: %v", err)
+		}
+
+		if record, err := db.ProposalRecord(slotsPerEpoch, 1); err != nil || record != nil {
+			t.Errorf("Expected pruned proposal record, received %v, %v", record, err)
+		}
+		if record, err := db.ProposalRecord(2*slotsPerEpoch, 1); err != nil || record == nil {
+			t.Errorf("Expected proposal record to be kept, received %v, %v", record, err)
+		}
+		votes, err := db.ValidatorVotes(1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(votes) != 1 || votes[0].TargetEpoch != 2 {
+			t.Errorf("Expected only the vote targeting epoch 2 to be kept, received %v", votes)
+		}
+		if saved, err := db.SlashableAttestation(1, hash); err != nil || saved != nil {
+			t.Errorf("Expected pruned attestation, received %v, %v", saved, err)
+		}
+		if saved, err := db.SlashableAttestation(2, hash); err != nil || saved == nil {
+			t.Errorf("Expected attestation to be kept, received %v, %v", saved, err)
+		}
+	})
+}
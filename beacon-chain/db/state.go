@@ -15,6 +15,7 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"go.opencensus.io/trace"
 )
 
@@ -69,6 +70,9 @@ func (db *BeaconDB) InitializeState(ctx context.Context, genesisTime uint64, dep
 		if err := blockBkt.Put(blockRoot[:], blockEnc); err != nil {
 			return err
 		}
+		if err := indexBlock(tx, genesisBlock, blockRoot[:]); err != nil {
+			return err
+		}
 
 		for i, validator := range beaconState.ValidatorRegistry {
 			h := hashutil.Hash(validator.Pubkey)
@@ -202,12 +206,74 @@ func (db *BeaconDB) SaveFinalizedState(beaconState *pb.BeaconState) error {
 	})
 }
 
+// HistoricalStateRetention determines which historical states are kept once they
+// are older than the finalized state. States which are not kept are regenerated
+// by replaying blocks on top of the closest retained state.
+type HistoricalStateRetention struct {
+	// Archive keeps every historical state.
+	Archive bool
+	// EpochInterval keeps the earliest state of every interval of this many
+	// epochs, and prunes the others.
+	EpochInterval uint64
+}
+
+// interval returns the index of the retention interval the slot belongs to.
+func (r HistoricalStateRetention) interval(slot uint64) uint64 {
+	if slot < params.BeaconConfig().GenesisSlot {
+		return 0
+	}
+	return (slot - params.BeaconConfig().GenesisSlot) / (r.EpochInterval * params.BeaconConfig().SlotsPerEpoch)
+}
+
+// prunes returns whether any historical states are pruned under the policy.
+func (r HistoricalStateRetention) prunes() bool {
+	if r.Archive {
+		return false
+	}
+	return r.EpochInterval > 0 || featureconfig.FeatureConfig().EnableHistoricalStatePruning
+}
+
+// prunedSlots returns which of the sorted slots of the saved historical states
+// are pruned when the state of the finalized slot is saved. Of the states older
+// than the finalized state, the earliest of every interval is kept if the policy
+// has an epoch interval, and all are pruned otherwise.
+func (r HistoricalStateRetention) prunedSlots(slots []uint64, finalizedSlot uint64) []uint64 {
+	if !r.prunes() {
+		return nil
+	}
+	var pruned []uint64
+	var keptInterval uint64
+	var keptAny bool
+	for _, slot := range slots {
+		if slot >= finalizedSlot {
+			break
+		}
+		if r.EpochInterval > 0 {
+			interval := r.interval(slot)
+			if !keptAny || interval != keptInterval {
+				keptInterval = interval
+				keptAny = true
+				continue
+			}
+		}
+		pruned = append(pruned, slot)
+	}
+	return pruned
+}
+
+// SetHistoricalStateRetention sets the policy used to prune historical states
+// when a new finalized state is saved. By default historical states are only
+// pruned if historical state pruning is enabled, in which case none are kept.
+func (db *BeaconDB) SetHistoricalStateRetention(retention HistoricalStateRetention) {
+	db.historicalStateRetention = retention
+}
+
 // SaveHistoricalState saves the last finalized state in the db.
 func (db *BeaconDB) SaveHistoricalState(ctx context.Context, beaconState *pb.BeaconState) error {
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.db.SaveHistoricalState")
 	defer span.End()
 
-	slotBinary := encodeSlotNumber(beaconState.Slot)
+	slotBinary := encodeHistoricalStateSlot(beaconState.Slot)
 	stateHash, err := hashutil.HashProto(beaconState)
 	if err != nil {
 		return err
@@ -269,47 +335,26 @@ func (db *BeaconDB) HistoricalStateFromSlot(ctx context.Context, slot uint64) (*
 	span.AddAttributes(trace.Int64Attribute("slotSinceGenesis", int64(slot)))
 	var beaconState *pb.BeaconState
 	err := db.view(func(tx *bolt.Tx) error {
-		var err error
-		var highestStateSlot uint64
-		var stateExists bool
-		histStateKey := make([]byte, 32)
-
 		chainInfo := tx.Bucket(chainInfoBucket)
-		histState := tx.Bucket(histStateBucket)
-		hsCursor := histState.Cursor()
-
-		for k, v := hsCursor.First(); k != nil; k, v = hsCursor.Next() {
-			slotNumber := decodeToSlotNumber(k)
-			if slotNumber == slot {
-				stateExists = true
-				highestStateSlot = slotNumber
-				histStateKey = v
-				break
-			}
+		hsCursor := tx.Bucket(histStateBucket).Cursor()
+
+		// Historical states are keyed by big-endian slot, so seeking to the input
+		// slot lands on it or on the closest state after it.
+		k, histStateKey := hsCursor.Seek(encodeHistoricalStateSlot(slot))
+		if k == nil {
+			k, histStateKey = hsCursor.Last()
+		} else if decodeHistoricalStateSlot(k) > slot {
+			k, histStateKey = hsCursor.Prev()
 		}
-
-		// If no historical state exists, retrieve and decode the finalized state.
-		if !stateExists {
-			for k, v := hsCursor.First(); k != nil; k, v = hsCursor.Next() {
-				slotNumber := decodeToSlotNumber(k)
-				// find the state with slot closest to the requested slot
-				if slotNumber > highestStateSlot && slotNumber <= slot {
-					stateExists = true
-					highestStateSlot = slotNumber
-					histStateKey = v
-				}
-			}
-
-			if !stateExists {
-				return errors.New("no historical states saved in db")
-			}
+		if k == nil {
+			return errors.New("no historical states saved in db")
 		}
 
-		// If historical state exists, retrieve and decode it.
 		encState := chainInfo.Get(histStateKey)
 		if encState == nil {
 			return errors.New("no historical state saved")
 		}
+		var err error
 		beaconState, err = createState(encState)
 		return err
 	})
@@ -325,8 +370,10 @@ func createState(enc []byte) (*pb.BeaconState, error) {
 	return protoState, nil
 }
 
+// deleteHistoricalStates prunes the historical states older than the input slot
+// according to the retention policy of the database.
 func (db *BeaconDB) deleteHistoricalStates(slot uint64) error {
-	if !featureconfig.FeatureConfig().EnableHistoricalStatePruning {
+	if !db.historicalStateRetention.prunes() {
 		return nil
 	}
 	return db.update(func(tx *bolt.Tx) error {
@@ -334,15 +381,19 @@ func (db *BeaconDB) deleteHistoricalStates(slot uint64) error {
 		chainInfo := tx.Bucket(chainInfoBucket)
 		hsCursor := histState.Cursor()
 
-		for k, v := hsCursor.First(); k != nil; k, v = hsCursor.Next() {
-			keySlotNumber := decodeToSlotNumber(k)
-			if keySlotNumber < slot {
-				if err := histState.Delete(k); err != nil {
-					return err
-				}
-				if err := chainInfo.Delete(v); err != nil {
-					return err
-				}
+		// Slots are collected first, as deleting through a cursor skips over the
+		// following key.
+		var slots []uint64
+		for k, _ := hsCursor.First(); k != nil && decodeHistoricalStateSlot(k) < slot; k, _ = hsCursor.Next() {
+			slots = append(slots, decodeHistoricalStateSlot(k))
+		}
+		for _, prunedSlot := range db.historicalStateRetention.prunedSlots(slots, slot) {
+			k := encodeHistoricalStateSlot(prunedSlot)
+			if err := chainInfo.Delete(histState.Get(k)); err != nil {
+				return err
+			}
+			if err := histState.Delete(k); err != nil {
+				return err
 			}
 		}
 		return nil
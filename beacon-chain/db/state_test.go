@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"context"
 	"crypto/rand"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/boltdb/bolt"
 	"github.com/gogo/protobuf/proto"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
@@ -380,3 +382,127 @@ func TestHistoricalState_Pruning(t *testing.T) {
 
 	}
 }
+
+func TestHistoricalStateFromSlot_ClosestAtOrBelow(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	if _, err := db.HistoricalStateFromSlot(ctx, 10); err == nil {
+		t.Error("Expected an error when no historical states are saved")
+	}
+	// Slots 256 and 1 would be out of order with little-endian keys.
+	for _, slot := range []uint64{1, 20, 256} {
+		if err := db.SaveHistoricalState(ctx, &pb.BeaconState{Slot: slot}); err != nil {
+			t.Fatalf("could not save historical state: %v", err)
+		}
+	}
+
+	tests := []struct {
+		slot     uint64
+		expected uint64
+	}{
+		{slot: 1, expected: 1},
+		{slot: 19, expected: 1},
+		{slot: 20, expected: 20},
+		{slot: 255, expected: 20},
+		{slot: 256, expected: 256},
+		{slot: 1000, expected: 256},
+	}
+	for _, tt := range tests {
+		retState, err := db.HistoricalStateFromSlot(ctx, tt.slot)
+		if err != nil {
+			t.Fatalf("Unable to retrieve state %v", err)
+		}
+		if retState.Slot != tt.expected {
+			t.Errorf("Expected state of slot %d for slot %d, received %d", tt.expected, tt.slot, retState.Slot)
+		}
+	}
+	if _, err := db.HistoricalStateFromSlot(ctx, 0); err == nil {
+		t.Error("Expected an error for a slot before the earliest historical state")
+	}
+}
+
+func historicalStateSlots(t *testing.T, db *BeaconDB) []uint64 {
+	var slots []uint64
+	if err := db.view(func(tx *bolt.Tx) error {
+		return tx.Bucket(histStateBucket).ForEach(func(k []byte, v []byte) error {
+			slots = append(slots, decodeHistoricalStateSlot(k))
+			return nil
+		})
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return slots
+}
+
+func TestHistoricalState_RetentionPolicy(t *testing.T) {
+	epochSize := params.BeaconConfig().SlotsPerEpoch
+	slotGen := func(slot uint64) uint64 {
+		return params.BeaconConfig().GenesisSlot + slot
+	}
+	// A state in the middle of each of the first 8 epochs, and at the start of
+	// epochs 2 and 4.
+	var saved []uint64
+	for epoch := uint64(0); epoch < 8; epoch++ {
+		if epoch == 2 || epoch == 4 {
+			saved = append(saved, slotGen(epoch*epochSize))
+		}
+		saved = append(saved, slotGen(epoch*epochSize+epochSize/2))
+	}
+	finalizedSlot := slotGen(6 * epochSize)
+
+	tests := []struct {
+		name      string
+		retention HistoricalStateRetention
+		expected  []uint64
+	}{
+		{
+			name:      "archive",
+			retention: HistoricalStateRetention{Archive: true},
+			expected:  saved,
+		},
+		{
+			name:      "every 2 epochs",
+			retention: HistoricalStateRetention{EpochInterval: 2},
+			expected: []uint64{
+				slotGen(0*epochSize + epochSize/2),
+				slotGen(2 * epochSize),
+				slotGen(4 * epochSize),
+				slotGen(6*epochSize + epochSize/2),
+				slotGen(7*epochSize + epochSize/2),
+			},
+		},
+		{
+			name:      "pruning",
+			retention: HistoricalStateRetention{},
+			expected: []uint64{
+				slotGen(7*epochSize + epochSize/2),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupDB(t)
+			defer teardownDB(t, db)
+			db.SetHistoricalStateRetention(tt.retention)
+
+			for _, slot := range saved {
+				if err := db.SaveHistoricalState(context.Background(), &pb.BeaconState{Slot: slot}); err != nil {
+					t.Fatalf("could not save historical state: %v", err)
+				}
+			}
+			if err := db.SaveFinalizedState(&pb.BeaconState{Slot: finalizedSlot}); err != nil {
+				t.Fatalf("could not save finalized state: %v", err)
+			}
+			// Pruning again must not remove the states retained the first time.
+			if err := db.SaveFinalizedState(&pb.BeaconState{Slot: finalizedSlot + epochSize}); err != nil {
+				t.Fatalf("could not save finalized state: %v", err)
+			}
+
+			if remaining := historicalStateSlots(t, db); !reflect.DeepEqual(remaining, tt.expected) {
+				t.Errorf("Expected historical states at slots %v, received %v", tt.expected, remaining)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package dbtool
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain/stategenerator"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+var jsonMarshaler = &jsonpb.Marshaler{Indent: "  ", OrigName: true}
+
+// DumpBlockByRoot writes the block with the given hex encoded root as JSON.
+func DumpBlockByRoot(dbPath string, root string, w io.Writer) error {
+	blockRoot, err := parseRoot(root)
+	if err != nil {
+		return err
+	}
+	beaconDB, err := db.NewReadOnlyDB(dbPath)
+	if err != nil {
+		return fmt.Errorf("could not open beacon database: %v", err)
+	}
+	defer beaconDB.Close()
+
+	block, err := beaconDB.Block(blockRoot)
+	if err != nil {
+		return fmt.Errorf("could not read block: %v", err)
+	}
+	if block == nil {
+		return fmt.Errorf("no block saved with root %#x", blockRoot)
+	}
+	return writeJSON(w, block)
+}
+
+// DumpBlockBySlot writes the block of the main chain at the given slot since
+// genesis as JSON.
+func DumpBlockBySlot(dbPath string, slot uint64, w io.Writer) error {
+	beaconDB, err := db.NewReadOnlyDB(dbPath)
+	if err != nil {
+		return fmt.Errorf("could not open beacon database: %v", err)
+	}
+	defer beaconDB.Close()
+
+	block, err := beaconDB.BlockBySlot(context.Background(), params.BeaconConfig().GenesisSlot+slot)
+	if err != nil {
+		return fmt.Errorf("could not read block: %v", err)
+	}
+	if block == nil {
+		return fmt.Errorf("no block in the main chain at slot %d", slot)
+	}
+	return writeJSON(w, block)
+}
+
+// DumpStateByRoot writes the saved state with the given hex encoded root, which
+// is the state root of the block it is the post state of, as JSON.
+func DumpStateByRoot(dbPath string, root string, w io.Writer) error {
+	stateRoot, err := parseRoot(root)
+	if err != nil {
+		return err
+	}
+	beaconDB, err := db.NewReadOnlyDB(dbPath)
+	if err != nil {
+		return fmt.Errorf("could not open beacon database: %v", err)
+	}
+	defer beaconDB.Close()
+
+	beaconState, err := beaconDB.HistoricalStateByRoot(stateRoot)
+	if err != nil {
+		return fmt.Errorf("could not read state: %v", err)
+	}
+	return writeJSON(w, beaconState)
+}
+
+// DumpStateBySlot writes the state of the main chain at the given slot since
+// genesis as JSON. States which are not kept in the database are regenerated
+// from the closest historical state before the slot.
+func DumpStateBySlot(dbPath string, slot uint64, w io.Writer) error {
+	beaconDB, err := db.NewReadOnlyDB(dbPath)
+	if err != nil {
+		return fmt.Errorf("could not open beacon database: %v", err)
+	}
+	defer beaconDB.Close()
+
+	beaconState, err := stategenerator.GenerateStateFromBlock(
+		context.Background(),
+		beaconDB,
+		params.BeaconConfig().GenesisSlot+slot,
+	)
+	if err != nil {
+		return fmt.Errorf("could not generate state: %v", err)
+	}
+	return writeJSON(w, beaconState)
+}
+
+func parseRoot(root string) ([32]byte, error) {
+	if root == "" {
+		return [32]byte{}, errors.New("expected a root to be provided, received nil")
+	}
+	enc, err := hex.DecodeString(strings.TrimPrefix(root, "0x"))
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("could not decode root: %v", err)
+	}
+	if len(enc) != 32 {
+		return [32]byte{}, fmt.Errorf("expected a root of 32 bytes, received %d bytes", len(enc))
+	}
+	var r [32]byte
+	copy(r[:], enc)
+	return r, nil
+}
+
+func writeJSON(w io.Writer, msg proto.Message) error {
+	if err := jsonMarshaler.Marshal(w, msg); err != nil {
+		return fmt.Errorf("could not encode %T: %v", msg, err)
+	}
+	_, err := fmt.Fprintln(w)
+	return err
+}
@@ -0,0 +1,100 @@
+package dbtool
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestDumpBlock(t *testing.T) {
+	dbPath, blocks := setupChain(t, 1, 2, 4)
+	defer os.RemoveAll(dbPath)
+
+	var buf bytes.Buffer
+	if err := DumpBlockBySlot(dbPath, 2, &buf); err != nil {
+		t.Fatalf("Could not dump block: %v", err)
+	}
+	block := &pb.BeaconBlock{}
+	if err := jsonpb.Unmarshal(&buf, block); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(block, blocks[1]) {
+		t.Errorf("Expected block %v, received %v", blocks[1], block)
+	}
+	if err := DumpBlockBySlot(dbPath, 3, &buf); err == nil {
+		t.Error("Expected an error dumping a skipped slot")
+	}
+
+	root, err := hashutil.HashBeaconBlock(blocks[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf.Reset()
+	if err := DumpBlockByRoot(dbPath, fmt.Sprintf("%#x", root), &buf); err != nil {
+		t.Fatalf("Could not dump block: %v", err)
+	}
+	block = &pb.BeaconBlock{}
+	if err := jsonpb.Unmarshal(&buf, block); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(block, blocks[2]) {
+		t.Errorf("Expected block %v, received %v", blocks[2], block)
+	}
+	if err := DumpBlockByRoot(dbPath, "0x1234", &buf); err == nil || !strings.Contains(err.Error(), "32 bytes") {
+		t.Errorf("Expected an error for a short root, received %v", err)
+	}
+}
+
+func TestDumpState(t *testing.T) {
+	dbPath, _ := setupChain(t, 1, 2, 4)
+	defer os.RemoveAll(dbPath)
+
+	var buf bytes.Buffer
+	if err := DumpStateBySlot(dbPath, 3, &buf); err != nil {
+		t.Fatalf("Could not dump state: %v", err)
+	}
+	beaconState := &pb.BeaconState{}
+	if err := jsonpb.Unmarshal(&buf, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	if beaconState.Slot != params.BeaconConfig().GenesisSlot+3 {
+		t.Errorf("Expected state of slot 3, received %d", beaconState.Slot-params.BeaconConfig().GenesisSlot)
+	}
+
+	beaconDB, err := db.NewReadOnlyDB(dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	headState, err := beaconDB.HeadState(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.Close(); err != nil {
+		t.Fatal(err)
+	}
+	root, err := hashutil.HashProto(headState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf.Reset()
+	if err := DumpStateByRoot(dbPath, fmt.Sprintf("%x", root), &buf); err != nil {
+		t.Fatalf("Could not dump state: %v", err)
+	}
+	beaconState = &pb.BeaconState{}
+	if err := jsonpb.Unmarshal(&buf, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(beaconState, headState) {
+		t.Error("Expected the dumped state to equal the head state")
+	}
+}
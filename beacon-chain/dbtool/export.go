@@ -0,0 +1,331 @@
+package dbtool
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/attestation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+// chainExport is the portable file written by Export. Blocks and states are
+// encoded with the JSON mapping of their protobuf messages.
+type chainExport struct {
+	FinalizedBlock json.RawMessage   `json:"finalized_block"`
+	FinalizedState json.RawMessage   `json:"finalized_state"`
+	Blocks         []json.RawMessage `json:"blocks"`
+}
+
+// Export writes the finalized block and state of the database at dbPath to the
+// given file, along with the ancestors of the chain head after them up to the end
+// slot since genesis. An end slot of 0 exports the blocks up to the chain head.
+func Export(dbPath string, file string, endSlot uint64) error {
+	if file == "" {
+		return errors.New("expected a path to the export file to be provided, received nil")
+	}
+	beaconDB, err := db.NewReadOnlyDB(dbPath)
+	if err != nil {
+		return fmt.Errorf("could not open beacon database: %v", err)
+	}
+	defer beaconDB.Close()
+
+	finalized, err := beaconDB.FinalizedBlock()
+	if err != nil {
+		return fmt.Errorf("could not read finalized block: %v", err)
+	}
+	finalizedState, err := beaconDB.FinalizedState()
+	if err != nil {
+		return fmt.Errorf("could not read finalized state: %v", err)
+	}
+	head, err := beaconDB.ChainHead()
+	if err != nil {
+		return fmt.Errorf("could not read chain head: %v", err)
+	}
+	end := head.Slot
+	if endSlot != 0 {
+		end = params.BeaconConfig().GenesisSlot + endSlot
+		if end < finalized.Slot || end > head.Slot {
+			return fmt.Errorf(
+				"end slot %d is not between the finalized slot %d and the head slot %d",
+				endSlot,
+				finalized.Slot-params.BeaconConfig().GenesisSlot,
+				head.Slot-params.BeaconConfig().GenesisSlot,
+			)
+		}
+	}
+
+	export := &chainExport{Blocks: []json.RawMessage{}}
+	if export.FinalizedBlock, err = marshalJSON(finalized); err != nil {
+		return err
+	}
+	if export.FinalizedState, err = marshalJSON(finalizedState); err != nil {
+		return err
+	}
+	blocks, err := chainSinceFinalized(beaconDB, finalized, head)
+	if err != nil {
+		return err
+	}
+	for _, block := range blocks {
+		if block.Slot > end {
+			break
+		}
+		enc, err := marshalJSON(block)
+		if err != nil {
+			return err
+		}
+		export.Blocks = append(export.Blocks, enc)
+	}
+	enc, err := json.Marshal(export)
+	if err != nil {
+		return fmt.Errorf("could not encode export: %v", err)
+	}
+
+	f, err := os.OpenFile(file, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0600)
+	if err != nil {
+		return fmt.Errorf("could not create export file: %v", err)
+	}
+	if _, err := f.Write(enc); err != nil {
+		f.Close()
+		return fmt.Errorf("could not write export file: %v", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("could not write export file: %v", err)
+	}
+	log.WithFields(logrus.Fields{
+		"path":          file,
+		"finalizedSlot": finalized.Slot - params.BeaconConfig().GenesisSlot,
+		"endSlot":       end - params.BeaconConfig().GenesisSlot,
+		"blocks":        len(export.Blocks),
+	}).Info("Exported beacon chain")
+	return nil
+}
+
+// chainSinceFinalized returns the blocks after the finalized block up to the head
+// block, in slot order. They are found by following the parent roots from the
+// head, so the exported blocks always form a chain descending from the finalized
+// block, and a broken link fails the export.
+func chainSinceFinalized(beaconDB db.Database, finalized *pb.BeaconBlock, head *pb.BeaconBlock) ([]*pb.BeaconBlock, error) {
+	finalizedRoot, err := hashutil.HashBeaconBlock(finalized)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash finalized block: %v", err)
+	}
+	var blocks []*pb.BeaconBlock
+	block := head
+	for {
+		root, err := hashutil.HashBeaconBlock(block)
+		if err != nil {
+			return nil, fmt.Errorf("could not hash block: %v", err)
+		}
+		if root == finalizedRoot {
+			break
+		}
+		if block.Slot <= finalized.Slot {
+			return nil, fmt.Errorf(
+				"chain head does not descend from the finalized block, reached block %#x at slot %d",
+				root,
+				block.Slot-params.BeaconConfig().GenesisSlot,
+			)
+		}
+		blocks = append(blocks, block)
+		parentRoot := bytesutil.ToBytes32(block.ParentRootHash32)
+		parent, err := beaconDB.Block(parentRoot)
+		if err != nil {
+			return nil, fmt.Errorf("could not read parent block %#x: %v", parentRoot, err)
+		}
+		if parent == nil {
+			return nil, fmt.Errorf(
+				"parent block %#x of the block at slot %d is missing",
+				parentRoot,
+				block.Slot-params.BeaconConfig().GenesisSlot,
+			)
+		}
+		block = parent
+	}
+	for i, j := 0, len(blocks)-1; i < j; i, j = i+1, j-1 {
+		blocks[i], blocks[j] = blocks[j], blocks[i]
+	}
+	return blocks, nil
+}
+
+// Import loads a file written by Export into the database at dbPath, which must
+// not hold a chain yet. The finalized block and state of the file become the
+// justified and finalized checkpoints, then the blocks are processed by the chain
+// service as if they were received from peers. The proof of work chain blocks
+// referenced by the states are fetched from the web3 service.
+func Import(dbPath string, file string, web3Service *powchain.Web3Service) error {
+	if file == "" {
+		return errors.New("expected a path to the export file to be provided, received nil")
+	}
+	enc, err := ioutil.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("could not read export file: %v", err)
+	}
+	export := &chainExport{}
+	if err := json.Unmarshal(enc, export); err != nil {
+		return fmt.Errorf("could not decode export file: %v", err)
+	}
+	finalized := &pb.BeaconBlock{}
+	if err := unmarshalJSON(export.FinalizedBlock, finalized); err != nil {
+		return err
+	}
+	finalizedState := &pb.BeaconState{}
+	if err := unmarshalJSON(export.FinalizedState, finalizedState); err != nil {
+		return err
+	}
+	blocks := make([]*pb.BeaconBlock, len(export.Blocks))
+	for i, blockEnc := range export.Blocks {
+		blocks[i] = &pb.BeaconBlock{}
+		if err := unmarshalJSON(blockEnc, blocks[i]); err != nil {
+			return err
+		}
+	}
+
+	ctx := context.Background()
+	beaconDB, err := db.NewDB(dbPath)
+	if err != nil {
+		return fmt.Errorf("could not open beacon database: %v", err)
+	}
+	defer beaconDB.Close()
+
+	headState, err := beaconDB.HeadState(ctx)
+	if err != nil {
+		return fmt.Errorf("could not read head state: %v", err)
+	}
+	if headState != nil {
+		return fmt.Errorf("database at %s already holds a chain, import into a new data directory", dbPath)
+	}
+	if err := saveFinalizedCheckpoint(ctx, beaconDB, finalized, finalizedState); err != nil {
+		return err
+	}
+	if err := replayBlocks(ctx, beaconDB, web3Service, blocks); err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"path":          file,
+		"finalizedSlot": finalized.Slot - params.BeaconConfig().GenesisSlot,
+		"blocks":        len(blocks),
+	}).Info("Imported beacon chain")
+	return nil
+}
+
+// NewWeb3Service connects to the proof of work chain node at the endpoint, to
+// fetch the blocks referenced by imported states.
+func NewWeb3Service(endpoint string) (*powchain.Web3Service, error) {
+	rpcClient, err := gethRPC.Dial(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to proof of work chain node: %v", err)
+	}
+	powClient := ethclient.NewClient(rpcClient)
+	return powchain.NewWeb3Service(context.Background(), &powchain.Web3ServiceConfig{
+		Endpoint:        endpoint,
+		DepositContract: common.Address{},
+		Client:          powClient,
+		Reader:          powClient,
+		Logger:          powClient,
+		BlockFetcher:    powClient,
+		ContractBackend: powClient,
+	})
+}
+
+// saveFinalizedCheckpoint saves the block and state as the chain head and as the
+// justified and finalized checkpoints, as initial sync does with the finalized
+// state received from a peer.
+func saveFinalizedCheckpoint(ctx context.Context, beaconDB db.Database, block *pb.BeaconBlock, beaconState *pb.BeaconState) error {
+	if err := beaconDB.SaveBlock(block); err != nil {
+		return fmt.Errorf("could not save finalized block: %v", err)
+	}
+	for i, validator := range beaconState.ValidatorRegistry {
+		if err := beaconDB.SaveValidatorIndex(validator.Pubkey, i); err != nil {
+			return fmt.Errorf("could not save validator index: %v", err)
+		}
+	}
+	if err := beaconDB.UpdateChainHead(ctx, block, beaconState); err != nil {
+		return fmt.Errorf("could not set chain head: %v", err)
+	}
+	if err := beaconDB.SaveJustifiedBlock(block); err != nil {
+		return fmt.Errorf("could not save justified block: %v", err)
+	}
+	if err := beaconDB.SaveJustifiedState(beaconState); err != nil {
+		return fmt.Errorf("could not save justified state: %v", err)
+	}
+	if err := beaconDB.SaveFinalizedBlock(block); err != nil {
+		return fmt.Errorf("could not save finalized block: %v", err)
+	}
+	if err := beaconDB.SaveFinalizedState(beaconState); err != nil {
+		return fmt.Errorf("could not save finalized state: %v", err)
+	}
+	return nil
+}
+
+// replayBlocks processes the blocks in order with a chain service, then runs the
+// fork choice rule as regular sync does for blocks received from peers.
+func replayBlocks(ctx context.Context, beaconDB db.Database, web3Service *powchain.Web3Service, blocks []*pb.BeaconBlock) error {
+	opsService := operations.NewOpsPoolService(ctx, &operations.Config{
+		BeaconDB: beaconDB,
+		P2P:      &noopBroadcaster{},
+	})
+	opsService.Start()
+	defer opsService.Stop()
+	chainService, err := blockchain.NewChainService(ctx, &blockchain.Config{
+		BeaconDB:       beaconDB,
+		Web3Service:    web3Service,
+		AttsService:    attestation.NewAttestationService(ctx, &attestation.Config{BeaconDB: beaconDB}),
+		OpsPoolService: opsService,
+		P2p:            &noopBroadcaster{},
+	})
+	if err != nil {
+		return fmt.Errorf("could not set up chain service: %v", err)
+	}
+	for _, block := range blocks {
+		beaconState, err := chainService.ReceiveBlock(ctx, block)
+		if err != nil {
+			return fmt.Errorf("could not process block at slot %d: %v", block.Slot-params.BeaconConfig().GenesisSlot, err)
+		}
+		if err := beaconDB.UpdateChainHead(ctx, block, beaconState); err != nil {
+			return fmt.Errorf("could not update chain head: %v", err)
+		}
+		if err := chainService.ApplyForkChoiceRule(ctx, block, beaconState); err != nil {
+			return fmt.Errorf("could not apply fork choice rule: %v", err)
+		}
+	}
+	return nil
+}
+
+func marshalJSON(msg proto.Message) (json.RawMessage, error) {
+	var buf bytes.Buffer
+	if err := (&jsonpb.Marshaler{OrigName: true}).Marshal(&buf, msg); err != nil {
+		return nil, fmt.Errorf("could not encode %T: %v", msg, err)
+	}
+	return buf.Bytes(), nil
+}
+
+func unmarshalJSON(enc json.RawMessage, msg proto.Message) error {
+	if err := jsonpb.Unmarshal(bytes.NewReader(enc), msg); err != nil {
+		return fmt.Errorf("could not decode %T: %v", msg, err)
+	}
+	return nil
+}
+
+// noopBroadcaster drops the messages of the chain service, as imported blocks are
+// not announced to peers.
+type noopBroadcaster struct{}
+
+func (n *noopBroadcaster) Broadcast(_ context.Context, _ proto.Message) {}
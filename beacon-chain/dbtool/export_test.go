@@ -0,0 +1,321 @@
+package dbtool
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/gogo/protobuf/proto"
+	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func init() {
+	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{})
+}
+
+type mockPOWClient struct{}
+
+func (m *mockPOWClient) SubscribeNewHead(ctx context.Context, ch chan<- *gethTypes.Header) (ethereum.Subscription, error) {
+	return new(event.Feed).Subscribe(ch), nil
+}
+
+func (m *mockPOWClient) BlockByHash(ctx context.Context, hash common.Hash) (*gethTypes.Block, error) {
+	head := &gethTypes.Header{Number: big.NewInt(0), Difficulty: big.NewInt(100)}
+	return gethTypes.NewBlockWithHeader(head), nil
+}
+
+func (m *mockPOWClient) BlockByNumber(ctx context.Context, number *big.Int) (*gethTypes.Block, error) {
+	head := &gethTypes.Header{Number: big.NewInt(0), Difficulty: big.NewInt(100)}
+	return gethTypes.NewBlockWithHeader(head), nil
+}
+
+func (m *mockPOWClient) HeaderByNumber(ctx context.Context, number *big.Int) (*gethTypes.Header, error) {
+	return &gethTypes.Header{Number: big.NewInt(0), Difficulty: big.NewInt(100)}, nil
+}
+
+func (m *mockPOWClient) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- gethTypes.Log) (ethereum.Subscription, error) {
+	return new(event.Feed).Subscribe(ch), nil
+}
+
+func (m *mockPOWClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]gethTypes.Log, error) {
+	return nil, nil
+}
+
+func (m *mockPOWClient) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return []byte{}, nil
+}
+
+func (m *mockPOWClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{}, nil
+}
+
+func setupWeb3Service(t *testing.T) *powchain.Web3Service {
+	client := &mockPOWClient{}
+	web3Service, err := powchain.NewWeb3Service(context.Background(), &powchain.Web3ServiceConfig{
+		Endpoint:        "ws://127.0.0.1",
+		DepositContract: common.Address{},
+		Client:          client,
+		Reader:          client,
+		Logger:          client,
+	})
+	if err != nil {
+		t.Fatalf("Unable to set up web3 service: %v", err)
+	}
+	return web3Service
+}
+
+// setupChain saves a genesis state and processes blocks at the given slots since
+// genesis on top of it, then closes the database so it can be opened read-only.
+func setupChain(t *testing.T, slots ...uint64) (string, []*pb.BeaconBlock) {
+	ctx := context.Background()
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	deposits := make([]*pb.Deposit, 100)
+	for i := 0; i < len(deposits); i++ {
+		depositData, err := helpers.EncodeDepositData(
+			&pb.DepositInput{Pubkey: []byte(strconv.Itoa(i))},
+			params.BeaconConfig().MaxDepositAmount,
+			time.Now().Unix(),
+		)
+		if err != nil {
+			t.Fatalf("Could not encode deposit input: %v", err)
+		}
+		deposits[i] = &pb.Deposit{DepositData: depositData}
+	}
+	eth1Data := &pb.Eth1Data{
+		DepositRootHash32: []byte{},
+		BlockHash32:       []byte{},
+	}
+	genesisState, err := state.GenesisBeaconState(deposits, 0, eth1Data)
+	if err != nil {
+		t.Fatalf("Could not generate genesis state: %v", err)
+	}
+	stateRoot, err := hashutil.HashProto(genesisState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis := b.NewGenesisBlock(stateRoot[:])
+	if err := saveFinalizedCheckpoint(ctx, beaconDB, genesis, genesisState); err != nil {
+		t.Fatal(err)
+	}
+
+	blocks := make([]*pb.BeaconBlock, len(slots))
+	parent := genesis
+	for i, slot := range slots {
+		parentRoot, err := hashutil.HashBeaconBlock(parent)
+		if err != nil {
+			t.Fatal(err)
+		}
+		blocks[i] = &pb.BeaconBlock{
+			Slot:             params.BeaconConfig().GenesisSlot + slot,
+			ParentRootHash32: parentRoot[:],
+			RandaoReveal:     []byte{byte(slot)},
+			Eth1Data: &pb.Eth1Data{
+				DepositRootHash32: []byte{1},
+				BlockHash32:       []byte{2},
+			},
+			Body: &pb.BeaconBlockBody{},
+		}
+		parent = blocks[i]
+	}
+	if err := replayBlocks(ctx, beaconDB, setupWeb3Service(t), blocks); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return beaconDB.DatabasePath, blocks
+}
+
+func TestExportImport(t *testing.T) {
+	dbPath, blocks := setupChain(t, 1, 2, 4, 5)
+	defer os.RemoveAll(dbPath)
+	tmp := testutil.TempDir()
+	file := path.Join(tmp, "chain.json")
+	defer os.RemoveAll(file)
+
+	if err := Export(dbPath, file, 0); err != nil {
+		t.Fatalf("Could not export chain: %v", err)
+	}
+	if err := Export(dbPath, file, 0); err == nil {
+		t.Error("Expected an error overwriting an existing export file")
+	}
+
+	importPath := path.Join(tmp, "imported")
+	defer os.RemoveAll(importPath)
+	if err := Import(importPath, file, setupWeb3Service(t)); err != nil {
+		t.Fatalf("Could not import chain: %v", err)
+	}
+	if err := Import(importPath, file, setupWeb3Service(t)); err == nil || !strings.Contains(err.Error(), "already holds a chain") {
+		t.Errorf("Expected an error importing into a database which holds a chain, received %v", err)
+	}
+
+	exported, err := db.NewReadOnlyDB(dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer exported.Close()
+	imported, err := db.NewReadOnlyDB(importPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer imported.Close()
+
+	head, err := imported.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(head, blocks[len(blocks)-1]) {
+		t.Errorf("Expected imported head %v, received %v", blocks[len(blocks)-1], head)
+	}
+	for _, block := range blocks {
+		canonical, err := imported.BlockBySlot(context.Background(), block.Slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(canonical, block) {
+			t.Errorf("Expected block %v in the imported main chain, received %v", block, canonical)
+		}
+	}
+	exportedState, err := exported.HeadState(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	importedState, err := imported.HeadState(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(importedState, exportedState) {
+		t.Error("Expected the imported head state to equal the exported head state")
+	}
+	if index, err := imported.ValidatorIndex([]byte(strconv.Itoa(3))); err != nil || index != 3 {
+		t.Errorf("Expected validator index 3 to be imported, received %d, %v", index, err)
+	}
+}
+
+func TestExport_EndSlot(t *testing.T) {
+	dbPath, _ := setupChain(t, 1, 2, 4)
+	defer os.RemoveAll(dbPath)
+	file := path.Join(testutil.TempDir(), "chain-end-slot.json")
+	defer os.RemoveAll(file)
+
+	if err := Export(dbPath, file, 9); err == nil || !strings.Contains(err.Error(), "is not between the finalized slot") {
+		t.Errorf("Expected an error for an end slot after the head, received %v", err)
+	}
+	if err := Export(dbPath, file, 2); err != nil {
+		t.Fatal(err)
+	}
+	enc, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	export := &chainExport{}
+	if err := json.Unmarshal(enc, export); err != nil {
+		t.Fatal(err)
+	}
+	if len(export.Blocks) != 2 {
+		t.Errorf("Expected 2 exported blocks up to slot 2, received %d", len(export.Blocks))
+	}
+	finalized := &pb.BeaconBlock{}
+	if err := unmarshalJSON(export.FinalizedBlock, finalized); err != nil {
+		t.Fatal(err)
+	}
+	if finalized.Slot != params.BeaconConfig().GenesisSlot {
+		t.Errorf("Expected the genesis block to be exported as finalized, received slot %d", finalized.Slot)
+	}
+}
+
+func TestExport_FollowsParentLinks(t *testing.T) {
+	dbPath, blocks := setupChain(t, 1, 2, 4)
+	defer os.RemoveAll(dbPath)
+	ctx := context.Background()
+
+	// A block at slot 3 which the chain head does not descend from is left in the
+	// main chain index.
+	parentRoot, err := hashutil.HashBeaconBlock(blocks[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	fork := &pb.BeaconBlock{
+		Slot:             params.BeaconConfig().GenesisSlot + 3,
+		ParentRootHash32: parentRoot[:],
+		RandaoReveal:     []byte{'F'},
+	}
+	beaconDB, err := db.NewDB(dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveBlock(fork); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.ReorgMainChain(ctx, nil, []*pb.BeaconBlock{fork}); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.Close(); err != nil {
+		t.Fatal(err)
+	}
+	file := path.Join(testutil.TempDir(), "chain-parent-links.json")
+	defer os.RemoveAll(file)
+	if err := Export(dbPath, file, 0); err != nil {
+		t.Fatal(err)
+	}
+	enc, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	export := &chainExport{}
+	if err := json.Unmarshal(enc, export); err != nil {
+		t.Fatal(err)
+	}
+	if len(export.Blocks) != len(blocks) {
+		t.Fatalf("Expected %d exported blocks, received %d", len(blocks), len(export.Blocks))
+	}
+	for i, blockEnc := range export.Blocks {
+		block := &pb.BeaconBlock{}
+		if err := unmarshalJSON(blockEnc, block); err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(block, blocks[i]) {
+			t.Errorf("Expected exported block %v, received %v", blocks[i], block)
+		}
+	}
+
+	// An ancestor of the chain head missing from the database fails the export.
+	beaconDB, err = db.NewDB(dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.DeleteBlock(blocks[1]); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.Close(); err != nil {
+		t.Fatal(err)
+	}
+	brokenFile := path.Join(testutil.TempDir(), "chain-broken-link.json")
+	defer os.RemoveAll(brokenFile)
+	if err := Export(dbPath, brokenFile, 0); err == nil || !strings.Contains(err.Error(), "is missing") {
+		t.Errorf("Expected an error for a missing parent block, received %v", err)
+	}
+}
@@ -0,0 +1,106 @@
+// Package dbtool contains the commands which look inside the beacon chain database
+// of a node, and move a chain between data directories.
+package dbtool
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "dbtool")
+
+// Inspect writes the size of each bucket of the database at dbPath, along with
+// the slots and roots of the head, justified and finalized blocks and states.
+func Inspect(dbPath string, w io.Writer) error {
+	beaconDB, err := db.NewReadOnlyDB(dbPath)
+	if err != nil {
+		return fmt.Errorf("could not open beacon database: %v", err)
+	}
+	defer beaconDB.Close()
+
+	version, err := beaconDB.SchemaVersion()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "Database %s at schema version %d\n\n", dbPath, version)
+
+	stats, err := beaconDB.BucketStats()
+	if err != nil {
+		return fmt.Errorf("could not read buckets: %v", err)
+	}
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "BUCKET\tKEYS\tBYTES")
+	for _, s := range stats {
+		fmt.Fprintf(tw, "%s\t%d\t%d\n", s.Name, s.Keys, s.Size)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	fmt.Fprintln(w)
+
+	headState, err := beaconDB.HeadState(context.Background())
+	if err != nil {
+		return fmt.Errorf("could not read head state: %v", err)
+	}
+	if headState == nil {
+		fmt.Fprintln(w, "No chain head saved, the chain has not started")
+		return nil
+	}
+	head, err := beaconDB.ChainHead()
+	if err != nil {
+		return fmt.Errorf("could not read chain head: %v", err)
+	}
+	justified, err := beaconDB.JustifiedBlock()
+	if err != nil {
+		return fmt.Errorf("could not read justified block: %v", err)
+	}
+	justifiedState, err := beaconDB.JustifiedState()
+	if err != nil {
+		return fmt.Errorf("could not read justified state: %v", err)
+	}
+	finalized, err := beaconDB.FinalizedBlock()
+	if err != nil {
+		return fmt.Errorf("could not read finalized block: %v", err)
+	}
+	finalizedState, err := beaconDB.FinalizedState()
+	if err != nil {
+		return fmt.Errorf("could not read finalized state: %v", err)
+	}
+
+	tw = tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "CHECKPOINT\tBLOCK SLOT\tBLOCK ROOT\tSTATE SLOT\tSTATE ROOT")
+	for _, c := range []struct {
+		name  string
+		block *pb.BeaconBlock
+		state *pb.BeaconState
+	}{
+		{"head", head, headState},
+		{"justified", justified, justifiedState},
+		{"finalized", finalized, finalizedState},
+	} {
+		blockRoot, err := hashutil.HashBeaconBlock(c.block)
+		if err != nil {
+			return fmt.Errorf("could not hash %s block: %v", c.name, err)
+		}
+		stateRoot, err := hashutil.HashProto(c.state)
+		if err != nil {
+			return fmt.Errorf("could not hash %s state: %v", c.name, err)
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%#x\t%d\t%#x\n",
+			c.name,
+			c.block.Slot-params.BeaconConfig().GenesisSlot,
+			blockRoot,
+			c.state.Slot-params.BeaconConfig().GenesisSlot,
+			stateRoot,
+		)
+	}
+	return tw.Flush()
+}
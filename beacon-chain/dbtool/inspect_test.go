@@ -0,0 +1,56 @@
+package dbtool
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+func TestInspect(t *testing.T) {
+	dbPath, blocks := setupChain(t, 1, 2, 4)
+	defer os.RemoveAll(dbPath)
+
+	var buf bytes.Buffer
+	if err := Inspect(dbPath, &buf); err != nil {
+		t.Fatalf("Could not inspect database: %v", err)
+	}
+	output := buf.String()
+	if !regexp.MustCompile(`(?m)^block-bucket\s+4\s+\d+$`).MatchString(output) {
+		t.Errorf("Expected 4 blocks in the block bucket, received:\n%s", output)
+	}
+	headRoot, err := hashutil.HashBeaconBlock(blocks[len(blocks)-1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !regexp.MustCompile(fmt.Sprintf(`(?m)^head\s+4\s+%#x\s+4\s`, headRoot)).MatchString(output) {
+		t.Errorf("Expected the head at slot 4 with root %#x, received:\n%s", headRoot, output)
+	}
+	if !regexp.MustCompile(`(?m)^finalized\s+0\s`).MatchString(output) {
+		t.Errorf("Expected the genesis block to be finalized, received:\n%s", output)
+	}
+}
+
+func TestInspect_ChainNotStarted(t *testing.T) {
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.Close(); err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(beaconDB.DatabasePath)
+
+	var buf bytes.Buffer
+	if err := Inspect(beaconDB.DatabasePath, &buf); err != nil {
+		t.Fatalf("Could not inspect database: %v", err)
+	}
+	if !strings.Contains(buf.String(), "the chain has not started") {
+		t.Errorf("Expected the chain not to be started, received:\n%s", buf.String())
+	}
+}
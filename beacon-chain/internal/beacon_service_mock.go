@@ -112,6 +112,21 @@ func (mr *MockBeaconServiceServerMockRecorder) PendingDeposits(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingDeposits", reflect.TypeOf((*MockBeaconServiceServer)(nil).PendingDeposits), arg0, arg1)
 }
 
+// SyncStatus mocks base method
+func (m *MockBeaconServiceServer) SyncStatus(arg0 context.Context, arg1 *types.Empty) (*v10.SyncStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncStatus", arg0, arg1)
+	ret0, _ := ret[0].(*v10.SyncStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncStatus indicates an expected call of SyncStatus
+func (mr *MockBeaconServiceServerMockRecorder) SyncStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncStatus", reflect.TypeOf((*MockBeaconServiceServer)(nil).SyncStatus), arg0, arg1)
+}
+
 // WaitForChainStart mocks base method
 func (m *MockBeaconServiceServer) WaitForChainStart(arg0 *types.Empty, arg1 v10.BeaconService_WaitForChainStartServer) error {
 	m.ctrl.T.Helper()
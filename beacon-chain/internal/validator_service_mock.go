@@ -9,7 +9,8 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
-	v1 "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	v1 "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	v10 "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	metadata "google.golang.org/grpc/metadata"
 )
 
@@ -37,10 +38,10 @@ func (m *MockValidatorServiceServer) EXPECT() *MockValidatorServiceServerMockRec
 }
 
 // CommitteeAssignment mocks base method
-func (m *MockValidatorServiceServer) CommitteeAssignment(arg0 context.Context, arg1 *v1.CommitteeAssignmentsRequest) (*v1.CommitteeAssignmentResponse, error) {
+func (m *MockValidatorServiceServer) CommitteeAssignment(arg0 context.Context, arg1 *v10.CommitteeAssignmentsRequest) (*v10.CommitteeAssignmentResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CommitteeAssignment", arg0, arg1)
-	ret0, _ := ret[0].(*v1.CommitteeAssignmentResponse)
+	ret0, _ := ret[0].(*v10.CommitteeAssignmentResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -51,11 +52,41 @@ func (mr *MockValidatorServiceServerMockRecorder) CommitteeAssignment(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitteeAssignment", reflect.TypeOf((*MockValidatorServiceServer)(nil).CommitteeAssignment), arg0, arg1)
 }
 
+// ProposeExit mocks base method
+func (m *MockValidatorServiceServer) ProposeExit(arg0 context.Context, arg1 *v1.VoluntaryExit) (*v10.ProposeExitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProposeExit", arg0, arg1)
+	ret0, _ := ret[0].(*v10.ProposeExitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProposeExit indicates an expected call of ProposeExit
+func (mr *MockValidatorServiceServerMockRecorder) ProposeExit(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposeExit", reflect.TypeOf((*MockValidatorServiceServer)(nil).ProposeExit), arg0, arg1)
+}
+
+// ValidatorAttestations mocks base method
+func (m *MockValidatorServiceServer) ValidatorAttestations(arg0 context.Context, arg1 *v10.ValidatorAttestationsRequest) (*v10.ValidatorAttestationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatorAttestations", arg0, arg1)
+	ret0, _ := ret[0].(*v10.ValidatorAttestationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidatorAttestations indicates an expected call of ValidatorAttestations
+func (mr *MockValidatorServiceServerMockRecorder) ValidatorAttestations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorAttestations", reflect.TypeOf((*MockValidatorServiceServer)(nil).ValidatorAttestations), arg0, arg1)
+}
+
 // ValidatorIndex mocks base method
-func (m *MockValidatorServiceServer) ValidatorIndex(arg0 context.Context, arg1 *v1.ValidatorIndexRequest) (*v1.ValidatorIndexResponse, error) {
+func (m *MockValidatorServiceServer) ValidatorIndex(arg0 context.Context, arg1 *v10.ValidatorIndexRequest) (*v10.ValidatorIndexResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ValidatorIndex", arg0, arg1)
-	ret0, _ := ret[0].(*v1.ValidatorIndexResponse)
+	ret0, _ := ret[0].(*v10.ValidatorIndexResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -67,10 +98,10 @@ func (mr *MockValidatorServiceServerMockRecorder) ValidatorIndex(arg0, arg1 inte
 }
 
 // ValidatorPerformance mocks base method
-func (m *MockValidatorServiceServer) ValidatorPerformance(arg0 context.Context, arg1 *v1.ValidatorPerformanceRequest) (*v1.ValidatorPerformanceResponse, error) {
+func (m *MockValidatorServiceServer) ValidatorPerformance(arg0 context.Context, arg1 *v10.ValidatorPerformanceRequest) (*v10.ValidatorPerformanceResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ValidatorPerformance", arg0, arg1)
-	ret0, _ := ret[0].(*v1.ValidatorPerformanceResponse)
+	ret0, _ := ret[0].(*v10.ValidatorPerformanceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -82,10 +113,10 @@ func (mr *MockValidatorServiceServerMockRecorder) ValidatorPerformance(arg0, arg
 }
 
 // ValidatorStatus mocks base method
-func (m *MockValidatorServiceServer) ValidatorStatus(arg0 context.Context, arg1 *v1.ValidatorIndexRequest) (*v1.ValidatorStatusResponse, error) {
+func (m *MockValidatorServiceServer) ValidatorStatus(arg0 context.Context, arg1 *v10.ValidatorIndexRequest) (*v10.ValidatorStatusResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ValidatorStatus", arg0, arg1)
-	ret0, _ := ret[0].(*v1.ValidatorStatusResponse)
+	ret0, _ := ret[0].(*v10.ValidatorStatusResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -97,7 +128,7 @@ func (mr *MockValidatorServiceServerMockRecorder) ValidatorStatus(arg0, arg1 int
 }
 
 // WaitForActivation mocks base method
-func (m *MockValidatorServiceServer) WaitForActivation(arg0 *v1.ValidatorActivationRequest, arg1 v1.ValidatorService_WaitForActivationServer) error {
+func (m *MockValidatorServiceServer) WaitForActivation(arg0 *v10.ValidatorActivationRequest, arg1 v10.ValidatorService_WaitForActivationServer) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "WaitForActivation", arg0, arg1)
 	ret0, _ := ret[0].(error)
@@ -162,7 +193,7 @@ func (mr *MockValidatorService_WaitForActivationServerMockRecorder) RecvMsg(arg0
 }
 
 // Send mocks base method
-func (m *MockValidatorService_WaitForActivationServer) Send(arg0 *v1.ValidatorActivationResponse) error {
+func (m *MockValidatorService_WaitForActivationServer) Send(arg0 *v10.ValidatorActivationResponse) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Send", arg0)
 	ret0, _ := ret[0].(error)
@@ -2,9 +2,12 @@
 package main
 
 import (
+	"fmt"
 	"os"
+	"path"
 	"runtime"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/dbtool"
 	"github.com/prysmaticlabs/prysm/beacon-chain/node"
 	"github.com/prysmaticlabs/prysm/beacon-chain/utils"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
@@ -24,6 +27,10 @@ func startNode(ctx *cli.Context) error {
 	}
 	logrus.SetLevel(level)
 
+	if ctx.GlobalBool(utils.DBMigrateDryRunFlag.Name) {
+		return node.LogPendingMigrations(ctx)
+	}
+
 	beacon, err := node.NewBeaconNode(ctx)
 	if err != nil {
 		return err
@@ -32,6 +39,66 @@ func startNode(ctx *cli.Context) error {
 	return nil
 }
 
+func beaconDBPath(ctx *cli.Context) string {
+	return path.Join(ctx.String(cmd.DataDirFlag.Name), node.BeaconChainDBName)
+}
+
+func inspectDB(ctx *cli.Context) error {
+	if err := dbtool.Inspect(beaconDBPath(ctx), os.Stdout); err != nil {
+		return fmt.Errorf("could not inspect database: %v", err)
+	}
+	return nil
+}
+
+func dumpBlock(ctx *cli.Context) error {
+	var err error
+	if ctx.IsSet(utils.SlotFlag.Name) {
+		err = dbtool.DumpBlockBySlot(beaconDBPath(ctx), ctx.Uint64(utils.SlotFlag.Name), os.Stdout)
+	} else {
+		err = dbtool.DumpBlockByRoot(beaconDBPath(ctx), ctx.String(utils.RootFlag.Name), os.Stdout)
+	}
+	if err != nil {
+		return fmt.Errorf("could not dump block: %v", err)
+	}
+	return nil
+}
+
+func dumpState(ctx *cli.Context) error {
+	var err error
+	if ctx.IsSet(utils.SlotFlag.Name) {
+		err = dbtool.DumpStateBySlot(beaconDBPath(ctx), ctx.Uint64(utils.SlotFlag.Name), os.Stdout)
+	} else {
+		err = dbtool.DumpStateByRoot(beaconDBPath(ctx), ctx.String(utils.RootFlag.Name), os.Stdout)
+	}
+	if err != nil {
+		return fmt.Errorf("could not dump state: %v", err)
+	}
+	return nil
+}
+
+func exportChain(ctx *cli.Context) error {
+	if err := dbtool.Export(
+		beaconDBPath(ctx),
+		ctx.String(utils.ChainFileFlag.Name),
+		ctx.Uint64(utils.EndSlotFlag.Name),
+	); err != nil {
+		return fmt.Errorf("could not export chain: %v", err)
+	}
+	return nil
+}
+
+func importChain(ctx *cli.Context) error {
+	featureconfig.ConfigureBeaconFeatures(ctx)
+	web3Service, err := dbtool.NewWeb3Service(ctx.String(utils.Web3ProviderFlag.Name))
+	if err != nil {
+		return err
+	}
+	if err := dbtool.Import(beaconDBPath(ctx), ctx.String(utils.ChainFileFlag.Name), web3Service); err != nil {
+		return fmt.Errorf("could not import chain: %v", err)
+	}
+	return nil
+}
+
 func main() {
 	customFormatter := new(prefixed.TextFormatter)
 	customFormatter.TimestampFormat = "2006-01-02 15:04:05"
@@ -43,6 +110,73 @@ func main() {
 	app.Usage = "this is a beacon chain implementation for Ethereum 2.0"
 	app.Action = startNode
 	app.Version = version.GetVersion()
+	app.Commands = []cli.Command{
+		{
+			Name:     "db",
+			Category: "db",
+			Usage:    "defines commands which look inside the beacon chain database and move a chain between data directories",
+			Subcommands: cli.Commands{
+				cli.Command{
+					Name:  "inspect",
+					Usage: "list bucket sizes and the head, justified and finalized checkpoints",
+					Description: `lists the number of keys and the size of each bucket of the database, along with the slots
+and roots of the head, justified and finalized blocks and states`,
+					Flags: []cli.Flag{
+						cmd.DataDirFlag,
+					},
+					Action: inspectDB,
+				},
+				cli.Command{
+					Name:        "block",
+					Usage:       "print a block as JSON",
+					Description: `prints the block with the given root, or the block of the main chain at the given slot, as JSON`,
+					Flags: []cli.Flag{
+						cmd.DataDirFlag,
+						utils.RootFlag,
+						utils.SlotFlag,
+					},
+					Action: dumpBlock,
+				},
+				cli.Command{
+					Name:  "state",
+					Usage: "print a state as JSON",
+					Description: `prints the saved state with the given root, or the state of the main chain at the given slot,
+as JSON. States which are no longer kept in the database are regenerated by replaying blocks`,
+					Flags: []cli.Flag{
+						cmd.DataDirFlag,
+						utils.RootFlag,
+						utils.SlotFlag,
+					},
+					Action: dumpState,
+				},
+				cli.Command{
+					Name:  "export",
+					Usage: "export the chain since the finalized state to a file",
+					Description: `exports the finalized block and state, and the blocks of the main chain after them, to a
+JSON file which can be imported into another data directory`,
+					Flags: []cli.Flag{
+						cmd.DataDirFlag,
+						utils.ChainFileFlag,
+						utils.EndSlotFlag,
+					},
+					Action: exportChain,
+				},
+				cli.Command{
+					Name:  "import",
+					Usage: "import an exported chain into a new data directory",
+					Description: `imports a chain exported to a JSON file into a new data directory, by processing its blocks
+on top of its finalized state as if they were received from peers. The proof of work chain blocks
+referenced by the states are fetched from the web3 provider`,
+					Flags: []cli.Flag{
+						cmd.DataDirFlag,
+						utils.ChainFileFlag,
+						utils.Web3ProviderFlag,
+					},
+					Action: importChain,
+				},
+			},
+		},
+	}
 
 	app.Flags = []cli.Flag{
 		utils.NoCustomConfigFlag,
@@ -52,6 +186,10 @@ func main() {
 		utils.CertFlag,
 		utils.KeyFlag,
 		utils.EnableDBCleanup,
+		utils.EnableSlasherFlag,
+		utils.DBMigrateDryRunFlag,
+		utils.ArchiveFlag,
+		utils.HistoricalStateIntervalFlag,
 		cmd.BootstrapNode,
 		cmd.RelayNode,
 		cmd.P2PPort,
@@ -19,6 +19,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
+	"github.com/prysmaticlabs/prysm/beacon-chain/slasher"
 	rbcsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/beacon-chain/utils"
 	"github.com/prysmaticlabs/prysm/shared"
@@ -36,7 +37,10 @@ import (
 
 var log = logrus.WithField("prefix", "node")
 
-const beaconChainDBName = "beaconchaindata"
+// BeaconChainDBName is the directory within the data directory holding the
+// beacon chain database.
+const BeaconChainDBName = "beaconchaindata"
+
 const testSkipPowFlag = "test-skip-pow"
 
 // BeaconNode defines a struct that handles the services running a random beacon chain
@@ -105,6 +109,12 @@ func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if ctx.GlobalBool(utils.EnableSlasherFlag.Name) {
+		if err := beacon.registerSlasherService(); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := beacon.registerRPCService(ctx); err != nil {
 		return nil, err
 	}
@@ -167,20 +177,46 @@ func (b *BeaconNode) Close() {
 
 func (b *BeaconNode) startDB(ctx *cli.Context) error {
 	baseDir := ctx.GlobalString(cmd.DataDirFlag.Name)
-	dbPath := path.Join(baseDir, beaconChainDBName)
+	dbPath := path.Join(baseDir, BeaconChainDBName)
 	if b.ctx.GlobalBool(cmd.ClearDBFlag.Name) {
 		if err := db.ClearDB(dbPath); err != nil {
 			return err
 		}
 	}
 
-	db, err := db.NewDB(dbPath)
+	beaconDB, err := db.NewDB(dbPath)
 	if err != nil {
 		return err
 	}
 
+	beaconDB.SetHistoricalStateRetention(db.HistoricalStateRetention{
+		Archive:       ctx.GlobalBool(utils.ArchiveFlag.Name),
+		EpochInterval: ctx.GlobalUint64(utils.HistoricalStateIntervalFlag.Name),
+	})
+
 	log.Infof("Checking db at %s", dbPath)
-	b.db = db
+	b.db = beaconDB
+	return nil
+}
+
+// LogPendingMigrations reports the migrations which would be applied to the
+// database in the data directory when starting the node, without applying them.
+func LogPendingMigrations(ctx *cli.Context) error {
+	dbPath := path.Join(ctx.GlobalString(cmd.DataDirFlag.Name), BeaconChainDBName)
+	pending, err := db.PendingMigrations(dbPath)
+	if err != nil {
+		return fmt.Errorf("could not check database at %s: %v", dbPath, err)
+	}
+	if len(pending) == 0 {
+		log.Infof("Database at %s is at the latest schema version %d", dbPath, db.LatestSchemaVersion())
+		return nil
+	}
+	for _, m := range pending {
+		log.WithFields(logrus.Fields{
+			"version":     m.Version,
+			"description": m.Description,
+		}).Info("Pending database migration")
+	}
 	return nil
 }
 
@@ -238,6 +274,26 @@ func (b *BeaconNode) registerOperationService() error {
 	return b.services.RegisterService(operationService)
 }
 
+func (b *BeaconNode) registerSlasherService() error {
+	var p2pService *p2p.Server
+	if err := b.services.FetchService(&p2pService); err != nil {
+		return err
+	}
+
+	var operationService *operations.Service
+	if err := b.services.FetchService(&operationService); err != nil {
+		return err
+	}
+
+	slasherService := slasher.NewSlasherService(context.Background(), &slasher.Config{
+		BeaconDB:         b.db,
+		P2P:              p2pService,
+		OperationService: operationService,
+	})
+
+	return b.services.RegisterService(slasherService)
+}
+
 func (b *BeaconNode) registerPOWChainService(cliCtx *cli.Context) error {
 	if cliCtx.GlobalBool(testSkipPowFlag) {
 		return b.services.RegisterService(&powchain.Web3Service{})
@@ -337,6 +393,11 @@ func (b *BeaconNode) registerRPCService(ctx *cli.Context) error {
 		return err
 	}
 
+	var syncService *rbcsync.Service
+	if err := b.services.FetchService(&syncService); err != nil {
+		return err
+	}
+
 	port := ctx.GlobalString(utils.RPCPort.Name)
 	cert := ctx.GlobalString(utils.CertFlag.Name)
 	key := ctx.GlobalString(utils.KeyFlag.Name)
@@ -348,6 +409,7 @@ func (b *BeaconNode) registerRPCService(ctx *cli.Context) error {
 		ChainService:     chainService,
 		OperationService: operationService,
 		POWChainService:  web3Service,
+		SyncService:      syncService,
 	})
 
 	return b.services.RegisterService(rpcService)
@@ -24,6 +24,9 @@ var topicMappings = map[pb.Topic]proto.Message{
 	pb.Topic_ATTESTATION_ANNOUNCE:                &pb.AttestationAnnounce{},
 	pb.Topic_ATTESTATION_REQUEST:                 &pb.AttestationRequest{},
 	pb.Topic_ATTESTATION_RESPONSE:                &pb.AttestationResponse{},
+	pb.Topic_VOLUNTARY_EXIT:                      &pb.VoluntaryExit{},
+	pb.Topic_PROPOSER_SLASHING:                   &pb.ProposerSlashing{},
+	pb.Topic_ATTESTER_SLASHING:                   &pb.AttesterSlashing{},
 }
 
 func configureP2P(ctx *cli.Context) (*p2p.Server, error) {
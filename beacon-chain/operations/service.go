@@ -3,12 +3,17 @@ package operations
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
+	"sync"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bitutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	handler "github.com/prysmaticlabs/prysm/shared/messagehandler"
@@ -25,7 +30,10 @@ var log = logrus.WithField("prefix", "operation")
 type OperationFeeds interface {
 	IncomingAttFeed() *event.Feed
 	IncomingExitFeed() *event.Feed
+	IncomingProposerSlashingFeed() *event.Feed
+	IncomingAttesterSlashingFeed() *event.Feed
 	IncomingProcessedBlockFeed() *event.Feed
+	IncomingOrphanedBlockFeed() *event.Feed
 }
 
 // Service represents a service that handles the internal
@@ -33,20 +41,27 @@ type OperationFeeds interface {
 type Service struct {
 	ctx                        context.Context
 	cancel                     context.CancelFunc
-	beaconDB                   *db.BeaconDB
+	beaconDB                   db.Database
 	incomingExitFeed           *event.Feed
 	incomingValidatorExits     chan *pb.VoluntaryExit
+	incomingPropSlashingFeed   *event.Feed
+	incomingPropSlashings      chan *pb.ProposerSlashing
+	incomingAttSlashingFeed    *event.Feed
+	incomingAttSlashings       chan *pb.AttesterSlashing
 	incomingAttFeed            *event.Feed
 	incomingAtt                chan *pb.Attestation
 	incomingProcessedBlockFeed *event.Feed
 	incomingProcessedBlock     chan *pb.BeaconBlock
+	incomingOrphanedBlockFeed  *event.Feed
+	incomingOrphanedBlock      chan *pb.BeaconBlock
+	attestationLock            sync.Mutex
 	p2p                        p2p.Broadcaster
 	error                      error
 }
 
 // Config options for the service.
 type Config struct {
-	BeaconDB *db.BeaconDB
+	BeaconDB db.Database
 	P2P      p2p.Broadcaster
 }
 
@@ -60,10 +75,16 @@ func NewOpsPoolService(ctx context.Context, cfg *Config) *Service {
 		beaconDB:                   cfg.BeaconDB,
 		incomingExitFeed:           new(event.Feed),
 		incomingValidatorExits:     make(chan *pb.VoluntaryExit, params.BeaconConfig().DefaultBufferSize),
+		incomingPropSlashingFeed:   new(event.Feed),
+		incomingPropSlashings:      make(chan *pb.ProposerSlashing, params.BeaconConfig().DefaultBufferSize),
+		incomingAttSlashingFeed:    new(event.Feed),
+		incomingAttSlashings:       make(chan *pb.AttesterSlashing, params.BeaconConfig().DefaultBufferSize),
 		incomingAttFeed:            new(event.Feed),
 		incomingAtt:                make(chan *pb.Attestation, params.BeaconConfig().DefaultBufferSize),
 		incomingProcessedBlockFeed: new(event.Feed),
 		incomingProcessedBlock:     make(chan *pb.BeaconBlock, params.BeaconConfig().DefaultBufferSize),
+		incomingOrphanedBlockFeed:  new(event.Feed),
+		incomingOrphanedBlock:      make(chan *pb.BeaconBlock, params.BeaconConfig().DefaultBufferSize),
 		p2p:                        cfg.P2P,
 	}
 }
@@ -97,6 +118,18 @@ func (s *Service) IncomingExitFeed() *event.Feed {
 	return s.incomingExitFeed
 }
 
+// IncomingProposerSlashingFeed returns a feed that any service can send incoming proposer slashings into.
+// The beacon block operation pool service will subscribe to this feed in order to relay incoming slashings.
+func (s *Service) IncomingProposerSlashingFeed() *event.Feed {
+	return s.incomingPropSlashingFeed
+}
+
+// IncomingAttesterSlashingFeed returns a feed that any service can send incoming attester slashings into.
+// The beacon block operation pool service will subscribe to this feed in order to relay incoming slashings.
+func (s *Service) IncomingAttesterSlashingFeed() *event.Feed {
+	return s.incomingAttSlashingFeed
+}
+
 // IncomingAttFeed returns a feed that any service can send incoming p2p attestations into.
 // The beacon block operation pool service will subscribe to this feed in order to relay incoming attestations.
 func (s *Service) IncomingAttFeed() *event.Feed {
@@ -109,28 +142,71 @@ func (s *Service) IncomingProcessedBlockFeed() *event.Feed {
 	return s.incomingProcessedBlockFeed
 }
 
-// PendingAttestations returns the attestations that have not seen on the beacon chain, the attestations are
-// returns in slot ascending order and up to MaxAttestations capacity. The attestations get
-// deleted in DB after they have been retrieved.
+// IncomingOrphanedBlockFeed returns a feed that the block chain service sends the blocks orphaned
+// by a chain reorganization into. The beacon block operation pool service will subscribe to this
+// feed in order to put the attestations of these blocks back in the pool.
+func (s *Service) IncomingOrphanedBlockFeed() *event.Feed {
+	return s.incomingOrphanedBlockFeed
+}
+
+// PendingAttestations returns the aggregated attestations that have not been seen on the beacon
+// chain, in slot ascending order. Attestations with the same attestation data are aggregated as
+// they are received, so several of them are only returned for the same data when their
+// participants overlap. Choosing which of them fit in a block is left to the block proposer.
 func (s *Service) PendingAttestations() ([]*pb.Attestation, error) {
-	var attestations []*pb.Attestation
-	attestationsFromDB, err := s.beaconDB.Attestations()
+	attestations, err := s.beaconDB.Attestations()
 	if err != nil {
 		return nil, fmt.Errorf("could not retrieve attestations from DB")
 	}
-	sort.Slice(attestationsFromDB, func(i, j int) bool {
-		return attestationsFromDB[i].Data.Slot < attestationsFromDB[j].Data.Slot
+	sort.SliceStable(attestations, func(i, j int) bool {
+		return attestations[i].Data.Slot < attestations[j].Data.Slot
 	})
-	for i := range attestationsFromDB {
-		// Stop the max attestation number per beacon block is reached.
-		if uint64(i) == params.BeaconConfig().MaxAttestations {
-			break
-		}
-		attestations = append(attestations, attestationsFromDB[i])
-	}
 	return attestations, nil
 }
 
+// PendingExits returns the exit requests that have not been seen on the beacon chain, in
+// ascending validator index order. Whether they can be included in a block still has to be
+// checked against the state the block is built on.
+func (s *Service) PendingExits() ([]*pb.VoluntaryExit, error) {
+	exits, err := s.beaconDB.Exits()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve exits from DB: %v", err)
+	}
+	sort.Slice(exits, func(i, j int) bool {
+		return exits[i].ValidatorIndex < exits[j].ValidatorIndex
+	})
+	return exits, nil
+}
+
+// PendingProposerSlashings returns the proposer slashings that have not been seen on the beacon
+// chain, in ascending proposer index order. Whether they can be included in a block still has to
+// be checked against the state the block is built on.
+func (s *Service) PendingProposerSlashings() ([]*pb.ProposerSlashing, error) {
+	slashings, err := s.beaconDB.ProposerSlashings()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve proposer slashings from DB: %v", err)
+	}
+	sort.Slice(slashings, func(i, j int) bool {
+		return slashings[i].ProposerIndex < slashings[j].ProposerIndex
+	})
+	return slashings, nil
+}
+
+// PendingAttesterSlashings returns the attester slashings that have not been seen on the beacon
+// chain, in ascending slot order of their first slashable attestation. Whether they can be
+// included in a block still has to be checked against the state the block is built on.
+func (s *Service) PendingAttesterSlashings() ([]*pb.AttesterSlashing, error) {
+	slashings, err := s.beaconDB.AttesterSlashings()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve attester slashings from DB: %v", err)
+	}
+	sort.SliceStable(slashings, func(i, j int) bool {
+		return slashings[i].GetSlashableAttestation_1().GetData().GetSlot() <
+			slashings[j].GetSlashableAttestation_1().GetData().GetSlot()
+	})
+	return slashings, nil
+}
+
 // saveOperations saves the newly broadcasted beacon block operations
 // that was received from sync service.
 func (s *Service) saveOperations() {
@@ -139,6 +215,12 @@ func (s *Service) saveOperations() {
 	defer incomingSub.Unsubscribe()
 	incomingAttSub := s.incomingAttFeed.Subscribe(s.incomingAtt)
 	defer incomingAttSub.Unsubscribe()
+	incomingPropSlashingSub := s.incomingPropSlashingFeed.Subscribe(s.incomingPropSlashings)
+	defer incomingPropSlashingSub.Unsubscribe()
+	incomingAttSlashingSub := s.incomingAttSlashingFeed.Subscribe(s.incomingAttSlashings)
+	defer incomingAttSlashingSub.Unsubscribe()
+	incomingOrphanedBlockSub := s.incomingOrphanedBlockFeed.Subscribe(s.incomingOrphanedBlock)
+	defer incomingOrphanedBlockSub.Unsubscribe()
 
 	for {
 		select {
@@ -153,11 +235,18 @@ func (s *Service) saveOperations() {
 			handler.SafelyHandleMessage(s.ctx, s.HandleValidatorExits, exit)
 		case attestation := <-s.incomingAtt:
 			handler.SafelyHandleMessage(s.ctx, s.HandleAttestations, attestation)
+		case slashing := <-s.incomingPropSlashings:
+			handler.SafelyHandleMessage(s.ctx, s.HandleProposerSlashing, slashing)
+		case slashing := <-s.incomingAttSlashings:
+			handler.SafelyHandleMessage(s.ctx, s.HandleAttesterSlashing, slashing)
+		case block := <-s.incomingOrphanedBlock:
+			handler.SafelyHandleMessage(s.ctx, s.handleOrphanedBlock, block)
 		}
 	}
 }
 
-// HandleValidatorExits processes a validator exit operation.
+// HandleValidatorExits processes a validator exit operation, saving it in the pool
+// and broadcasting it to peers.
 func (s *Service) HandleValidatorExits(ctx context.Context, message proto.Message) error {
 	ctx, span := trace.StartSpan(ctx, "operations.HandleValidatorExits")
 	defer span.End()
@@ -167,27 +256,79 @@ func (s *Service) HandleValidatorExits(ctx context.Context, message proto.Messag
 	if err != nil {
 		return err
 	}
+	if s.beaconDB.HasExit(hash) {
+		return nil
+	}
 	if err := s.beaconDB.SaveExit(ctx, exit); err != nil {
 		return err
 	}
 	log.Infof("Exit request %#x saved in DB", hash)
+	s.p2p.Broadcast(ctx, exit)
+	return nil
+}
+
+// HandleProposerSlashing processes a proposer slashing, saving it in the pool until
+// it is included in a block and broadcasting it to peers.
+func (s *Service) HandleProposerSlashing(ctx context.Context, message proto.Message) error {
+	ctx, span := trace.StartSpan(ctx, "operations.HandleProposerSlashing")
+	defer span.End()
+
+	slashing := message.(*pb.ProposerSlashing)
+	hash, err := hashutil.HashProto(slashing)
+	if err != nil {
+		return err
+	}
+	if s.beaconDB.HasProposerSlashing(hash) {
+		return nil
+	}
+	if err := s.beaconDB.SaveProposerSlashing(ctx, slashing); err != nil {
+		return err
+	}
+	log.WithField("proposerIndex", slashing.ProposerIndex).Infof("Proposer slashing %#x saved in DB", hash)
+	s.p2p.Broadcast(ctx, slashing)
+	return nil
+}
+
+// HandleAttesterSlashing processes an attester slashing, saving it in the pool until
+// it is included in a block and broadcasting it to peers.
+func (s *Service) HandleAttesterSlashing(ctx context.Context, message proto.Message) error {
+	ctx, span := trace.StartSpan(ctx, "operations.HandleAttesterSlashing")
+	defer span.End()
+
+	slashing := message.(*pb.AttesterSlashing)
+	hash, err := hashutil.HashProto(slashing)
+	if err != nil {
+		return err
+	}
+	if s.beaconDB.HasAttesterSlashing(hash) {
+		return nil
+	}
+	if err := s.beaconDB.SaveAttesterSlashing(ctx, slashing); err != nil {
+		return err
+	}
+	log.Infof("Attester slashing %#x saved in DB", hash)
+	s.p2p.Broadcast(ctx, slashing)
 	return nil
 }
 
-// HandleAttestations processes a received attestation message.
+// HandleAttestations processes a received attestation message. The attestation is aggregated
+// with the pending attestations of the same attestation data it does not share participants
+// with, and aggregates it fully covers are dropped from the pool. An attestation whose
+// participants are all covered by a pending aggregate is ignored, and one whose aggregate
+// signature does not verify against the head state is rejected.
 func (s *Service) HandleAttestations(ctx context.Context, message proto.Message) error {
 	ctx, span := trace.StartSpan(ctx, "operations.HandleAttestations")
 	defer span.End()
 
-	attestation := message.(*pb.Attestation)
-	hash, err := hashutil.HashProto(attestation)
+	aggregate, err := s.poolAttestation(ctx, message.(*pb.Attestation))
 	if err != nil {
 		return err
 	}
-	if s.beaconDB.HasAttestation(hash) {
+	if aggregate == nil {
 		return nil
 	}
-	if err := s.beaconDB.SaveAttestation(ctx, attestation); err != nil {
+	hash, err := hashutil.HashProto(aggregate)
+	if err != nil {
 		return err
 	}
 	s.p2p.Broadcast(ctx, &pb.AttestationAnnounce{
@@ -196,6 +337,134 @@ func (s *Service) HandleAttestations(ctx context.Context, message proto.Message)
 	return nil
 }
 
+// handleOrphanedBlock puts the attestations of a block orphaned by a chain reorganization
+// back in the pool, so they can be included in a block of the new canonical chain.
+func (s *Service) handleOrphanedBlock(ctx context.Context, message proto.Message) error {
+	block := message.(*pb.BeaconBlock)
+	headState, err := s.beaconDB.HeadState(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	attestations := block.GetBody().GetAttestations()
+	for _, attestation := range attestations {
+		// The new canonical chain may have included the attestation already.
+		if headState != nil && isIncluded(headState, attestation) {
+			continue
+		}
+		if _, err := s.poolAttestation(ctx, attestation); err != nil {
+			// Attestations which can no longer be verified against the head state are dropped.
+			if _, ok := err.(*blocks.SignatureVerificationErr); ok {
+				log.WithError(err).Debug("Could not re-queue attestation of orphaned block")
+				continue
+			}
+			return fmt.Errorf("could not re-queue attestation: %v", err)
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"slot":         block.Slot - params.BeaconConfig().GenesisSlot,
+		"attestations": len(attestations),
+	}).Debug("Re-queued attestations of orphaned block")
+	return nil
+}
+
+// isIncluded returns true if every participant of the attestation is covered by the
+// pending attestations of the same attestation data in the state.
+func isIncluded(state *pb.BeaconState, attestation *pb.Attestation) bool {
+	var included []byte
+	for _, pending := range state.LatestAttestations {
+		if proto.Equal(pending.Data, attestation.Data) {
+			included = bitutil.Or(included, pending.AggregationBitfield)
+		}
+	}
+	return included != nil && bitutil.IsSubset(attestation.AggregationBitfield, included)
+}
+
+// poolAttestation saves an attestation in the pool, aggregating it with the pending
+// attestations of the same attestation data. It returns the saved aggregate, or nil
+// if the attestation was already pooled or is covered by a pending aggregate. The
+// aggregate signature is verified against the head state first, so an invalid
+// attestation can neither poison the pending aggregates nor evict them.
+func (s *Service) poolAttestation(ctx context.Context, attestation *pb.Attestation) (*pb.Attestation, error) {
+	hash, err := hashutil.HashProto(attestation)
+	if err != nil {
+		return nil, err
+	}
+	headState, err := s.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	if headState == nil {
+		return nil, errors.New("no head state to verify the attestation against")
+	}
+	if err := blocks.VerifyAttestationSignature(headState, attestation); err != nil {
+		return nil, err
+	}
+	s.attestationLock.Lock()
+	defer s.attestationLock.Unlock()
+	if s.beaconDB.HasAttestation(hash) {
+		return nil, nil
+	}
+	pending, err := s.pendingAttestationsByData(attestation.Data)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range pending {
+		if bitutil.IsSubset(attestation.AggregationBitfield, a.AggregationBitfield) {
+			log.WithField("slot", attestation.Data.Slot-params.BeaconConfig().GenesisSlot).Debug(
+				"Attestation participants already included in a pending aggregate")
+			return nil, nil
+		}
+	}
+
+	// Prefer extending the aggregates which already cover the most participants.
+	sort.SliceStable(pending, func(i, j int) bool {
+		return bitutil.BitSetCount(pending[i].AggregationBitfield) > bitutil.BitSetCount(pending[j].AggregationBitfield)
+	})
+	aggregate := attestation
+	var remaining []*pb.Attestation
+	for _, a := range pending {
+		if bitutil.Overlaps(aggregate.AggregationBitfield, a.AggregationBitfield) {
+			remaining = append(remaining, a)
+			continue
+		}
+		aggregate, err = helpers.AggregateAttestation(aggregate, a)
+		if err != nil {
+			return nil, fmt.Errorf("could not aggregate attestation: %v", err)
+		}
+		if err := s.beaconDB.DeleteAttestation(a); err != nil {
+			return nil, err
+		}
+	}
+	// Aggregates which only include participants of the new aggregate are redundant.
+	for _, a := range remaining {
+		if bitutil.IsSubset(a.AggregationBitfield, aggregate.AggregationBitfield) {
+			if err := s.beaconDB.DeleteAttestation(a); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := s.beaconDB.SaveAttestation(ctx, aggregate); err != nil {
+		return nil, err
+	}
+	return aggregate, nil
+}
+
+// pendingAttestationsByData returns the pending attestations for the given attestation data.
+func (s *Service) pendingAttestationsByData(data *pb.AttestationData) ([]*pb.Attestation, error) {
+	attestations, err := s.beaconDB.Attestations()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve attestations from DB: %v", err)
+	}
+	var pending []*pb.Attestation
+	for _, a := range attestations {
+		if proto.Equal(a.Data, data) {
+			pending = append(pending, a)
+		}
+	}
+	return pending, nil
+}
+
 // removeOperations removes the processed operations from operation pool and DB.
 func (s *Service) removeOperations() {
 	incomingBlockSub := s.incomingProcessedBlockFeed.Subscribe(s.incomingProcessedBlock)
@@ -231,21 +500,70 @@ func (s *Service) handleProcessedBlock(_ context.Context, message proto.Message)
 	if err := s.removePendingAttestations(block.Body.Attestations); err != nil {
 		return fmt.Errorf("could not remove processed attestations from DB: %v", err)
 	}
+	if err := s.removePendingProposerSlashings(block.Body.ProposerSlashings); err != nil {
+		return fmt.Errorf("could not remove processed proposer slashings from DB: %v", err)
+	}
+	if err := s.removePendingAttesterSlashings(block.Body.AttesterSlashings); err != nil {
+		return fmt.Errorf("could not remove processed attester slashings from DB: %v", err)
+	}
+	if err := s.removePendingExits(block.Body.VoluntaryExits); err != nil {
+		return fmt.Errorf("could not remove processed exits from DB: %v", err)
+	}
 	return nil
 }
 
-// removePendingAttestations removes a list of attestations from DB.
+// removePendingProposerSlashings removes a list of proposer slashings from DB.
+func (s *Service) removePendingProposerSlashings(slashings []*pb.ProposerSlashing) error {
+	for _, slashing := range slashings {
+		if err := s.beaconDB.DeleteProposerSlashing(slashing); err != nil {
+			return err
+		}
+		log.WithField("proposerIndex", slashing.ProposerIndex).Debug("Proposer slashing removed")
+	}
+	return nil
+}
+
+// removePendingAttesterSlashings removes a list of attester slashings from DB.
+func (s *Service) removePendingAttesterSlashings(slashings []*pb.AttesterSlashing) error {
+	for _, slashing := range slashings {
+		if err := s.beaconDB.DeleteAttesterSlashing(slashing); err != nil {
+			return err
+		}
+		log.Debug("Attester slashing removed")
+	}
+	return nil
+}
+
+// removePendingExits removes a list of exit requests from DB.
+func (s *Service) removePendingExits(exits []*pb.VoluntaryExit) error {
+	for _, exit := range exits {
+		if err := s.beaconDB.DeleteExit(exit); err != nil {
+			return err
+		}
+		log.WithField("validatorIndex", exit.ValidatorIndex).Debug("Exit request removed")
+	}
+	return nil
+}
+
+// removePendingAttestations removes the pending attestations whose participants were all
+// included by a list of attestations from DB. Pending aggregates which still carry
+// participants missing from the list are kept.
 func (s *Service) removePendingAttestations(attestations []*pb.Attestation) error {
+	s.attestationLock.Lock()
+	defer s.attestationLock.Unlock()
 	for _, attestation := range attestations {
-		hash, err := hashutil.HashProto(attestation)
+		pending, err := s.pendingAttestationsByData(attestation.Data)
 		if err != nil {
 			return err
 		}
-		if s.beaconDB.HasAttestation(hash) {
-			if err := s.beaconDB.DeleteAttestation(attestation); err != nil {
+		for _, a := range pending {
+			if !bitutil.IsSubset(a.AggregationBitfield, attestation.AggregationBitfield) {
+				continue
+			}
+			if err := s.beaconDB.DeleteAttestation(a); err != nil {
 				return err
 			}
-			log.WithField("slot", attestation.Data.Slot-params.BeaconConfig().GenesisSlot).Debug("Attestation removed")
+			log.WithField("slot", a.Data.Slot-params.BeaconConfig().GenesisSlot).Debug("Attestation removed")
 		}
 	}
 	return nil
@@ -253,6 +571,8 @@ func (s *Service) removePendingAttestations(attestations []*pb.Attestation) erro
 
 // removeEpochOldAttestations removes attestations that's older than one epoch length from current slot.
 func (s *Service) removeEpochOldAttestations(slot uint64) error {
+	s.attestationLock.Lock()
+	defer s.attestationLock.Unlock()
 	attestations, err := s.beaconDB.Attestations()
 	if err != nil {
 		return err
@@ -1,15 +1,25 @@
 package operations
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
 	"errors"
 	"fmt"
 	"reflect"
+	"sort"
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
+	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -30,6 +40,7 @@ func (mb *mockBroadcaster) Broadcast(_ context.Context, _ proto.Message) {
 
 func init() {
 	logrus.SetLevel(logrus.DebugLevel)
+	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{})
 }
 
 func TestStop_OK(t *testing.T) {
@@ -88,7 +99,11 @@ func TestIncomingExits_Ok(t *testing.T) {
 	hook := logTest.NewGlobal()
 	beaconDB := internal.SetupDB(t)
 	defer internal.TeardownDB(t, beaconDB)
-	service := NewOpsPoolService(context.Background(), &Config{BeaconDB: beaconDB})
+	broadcaster := &mockBroadcaster{}
+	service := NewOpsPoolService(context.Background(), &Config{
+		BeaconDB: beaconDB,
+		P2P:      broadcaster,
+	})
 
 	exit := &pb.VoluntaryExit{Epoch: 100}
 	hash, err := hashutil.HashProto(exit)
@@ -102,6 +117,191 @@ func TestIncomingExits_Ok(t *testing.T) {
 
 	want := fmt.Sprintf("Exit request %#x saved in DB", hash)
 	testutil.AssertLogsContain(t, hook, want)
+	if !broadcaster.broadcastCalled {
+		t.Error("Exit request was not broadcasted")
+	}
+}
+
+func TestPendingExits_SortedByValidatorIndex(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	service := NewOpsPoolService(context.Background(), &Config{BeaconDB: beaconDB})
+
+	for _, index := range []uint64{5, 1, 3} {
+		if err := beaconDB.SaveExit(context.Background(), &pb.VoluntaryExit{ValidatorIndex: index}); err != nil {
+			t.Fatalf("Failed to save exit request: %v", err)
+		}
+	}
+	exits, err := service.PendingExits()
+	if err != nil {
+		t.Fatalf("Could not retrieve exit requests: %v", err)
+	}
+	for i, want := range []uint64{1, 3, 5} {
+		if exits[i].ValidatorIndex != want {
+			t.Errorf("Expected exit of validator %d at position %d, received %d", want, i, exits[i].ValidatorIndex)
+		}
+	}
+}
+
+func TestReceiveBlkRemoveExits_Ok(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	s := NewOpsPoolService(context.Background(), &Config{BeaconDB: db})
+
+	exits := []*pb.VoluntaryExit{
+		{ValidatorIndex: 1},
+		{ValidatorIndex: 2},
+	}
+	for _, exit := range exits {
+		if err := db.SaveExit(context.Background(), exit); err != nil {
+			t.Fatalf("Failed to save exit request: %v", err)
+		}
+	}
+
+	block := &pb.BeaconBlock{
+		Body: &pb.BeaconBlockBody{
+			VoluntaryExits: exits[:1],
+		},
+	}
+	if err := s.handleProcessedBlock(context.Background(), block); err != nil {
+		t.Error(err)
+	}
+
+	pending, err := s.PendingExits()
+	if err != nil {
+		t.Fatalf("Could not retrieve exit requests: %v", err)
+	}
+	if !reflect.DeepEqual(pending, exits[1:]) {
+		t.Errorf("Expected remaining exit requests %v, received %v", exits[1:], pending)
+	}
+}
+
+func TestIncomingSlashings_Ok(t *testing.T) {
+	hook := logTest.NewGlobal()
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	broadcaster := &mockBroadcaster{}
+	service := NewOpsPoolService(context.Background(), &Config{
+		BeaconDB: beaconDB,
+		P2P:      broadcaster,
+	})
+
+	proposerSlashing := &pb.ProposerSlashing{ProposerIndex: 1}
+	proposerHash, err := hashutil.HashProto(proposerSlashing)
+	if err != nil {
+		t.Fatalf("Could not hash proposer slashing: %v", err)
+	}
+	if err := service.HandleProposerSlashing(context.Background(), proposerSlashing); err != nil {
+		t.Error(err)
+	}
+	testutil.AssertLogsContain(t, hook, fmt.Sprintf("Proposer slashing %#x saved in DB", proposerHash))
+
+	attesterSlashing := &pb.AttesterSlashing{
+		SlashableAttestation_1: &pb.SlashableAttestation{ValidatorIndices: []uint64{1}},
+	}
+	attesterHash, err := hashutil.HashProto(attesterSlashing)
+	if err != nil {
+		t.Fatalf("Could not hash attester slashing: %v", err)
+	}
+	if err := service.HandleAttesterSlashing(context.Background(), attesterSlashing); err != nil {
+		t.Error(err)
+	}
+	testutil.AssertLogsContain(t, hook, fmt.Sprintf("Attester slashing %#x saved in DB", attesterHash))
+
+	if !beaconDB.HasProposerSlashing(proposerHash) {
+		t.Error("Expected proposer slashing to be saved")
+	}
+	if !beaconDB.HasAttesterSlashing(attesterHash) {
+		t.Error("Expected attester slashing to be saved")
+	}
+	if !broadcaster.broadcastCalled {
+		t.Error("Expected slashings to be broadcast")
+	}
+}
+
+func TestPendingSlashings_Sorted(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	service := NewOpsPoolService(context.Background(), &Config{BeaconDB: beaconDB})
+
+	for _, index := range []uint64{5, 1, 3} {
+		if err := beaconDB.SaveProposerSlashing(context.Background(), &pb.ProposerSlashing{ProposerIndex: index}); err != nil {
+			t.Fatalf("Failed to save proposer slashing: %v", err)
+		}
+		attesterSlashing := &pb.AttesterSlashing{
+			SlashableAttestation_1: &pb.SlashableAttestation{
+				Data: &pb.AttestationData{Slot: index},
+			},
+		}
+		if err := beaconDB.SaveAttesterSlashing(context.Background(), attesterSlashing); err != nil {
+			t.Fatalf("Failed to save attester slashing: %v", err)
+		}
+	}
+	proposerSlashings, err := service.PendingProposerSlashings()
+	if err != nil {
+		t.Fatalf("Could not retrieve proposer slashings: %v", err)
+	}
+	attesterSlashings, err := service.PendingAttesterSlashings()
+	if err != nil {
+		t.Fatalf("Could not retrieve attester slashings: %v", err)
+	}
+	for i, want := range []uint64{1, 3, 5} {
+		if proposerSlashings[i].ProposerIndex != want {
+			t.Errorf("Expected slashing of proposer %d at position %d, received %d",
+				want, i, proposerSlashings[i].ProposerIndex)
+		}
+		if slot := attesterSlashings[i].SlashableAttestation_1.Data.Slot; slot != want {
+			t.Errorf("Expected attester slashing for slot %d at position %d, received %d", want, i, slot)
+		}
+	}
+}
+
+func TestReceiveBlkRemoveSlashings_Ok(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	s := NewOpsPoolService(context.Background(), &Config{BeaconDB: db})
+
+	proposerSlashings := []*pb.ProposerSlashing{
+		{ProposerIndex: 1},
+		{ProposerIndex: 2},
+	}
+	attesterSlashings := []*pb.AttesterSlashing{
+		{SlashableAttestation_1: &pb.SlashableAttestation{ValidatorIndices: []uint64{1}}},
+		{SlashableAttestation_1: &pb.SlashableAttestation{ValidatorIndices: []uint64{2}}},
+	}
+	for i := range proposerSlashings {
+		if err := db.SaveProposerSlashing(context.Background(), proposerSlashings[i]); err != nil {
+			t.Fatalf("Failed to save proposer slashing: %v", err)
+		}
+		if err := db.SaveAttesterSlashing(context.Background(), attesterSlashings[i]); err != nil {
+			t.Fatalf("Failed to save attester slashing: %v", err)
+		}
+	}
+
+	block := &pb.BeaconBlock{
+		Body: &pb.BeaconBlockBody{
+			ProposerSlashings: proposerSlashings[:1],
+			AttesterSlashings: attesterSlashings[:1],
+		},
+	}
+	if err := s.handleProcessedBlock(context.Background(), block); err != nil {
+		t.Error(err)
+	}
+
+	pendingProposerSlashings, err := s.PendingProposerSlashings()
+	if err != nil {
+		t.Fatalf("Could not retrieve proposer slashings: %v", err)
+	}
+	if !reflect.DeepEqual(pendingProposerSlashings, proposerSlashings[1:]) {
+		t.Errorf("Expected remaining proposer slashings %v, received %v", proposerSlashings[1:], pendingProposerSlashings)
+	}
+	pendingAttesterSlashings, err := s.PendingAttesterSlashings()
+	if err != nil {
+		t.Fatalf("Could not retrieve attester slashings: %v", err)
+	}
+	if !reflect.DeepEqual(pendingAttesterSlashings, attesterSlashings[1:]) {
+		t.Errorf("Expected remaining attester slashings %v, received %v", attesterSlashings[1:], pendingAttesterSlashings)
+	}
 }
 
 func TestIncomingAttestation_OK(t *testing.T) {
@@ -112,12 +312,9 @@ func TestIncomingAttestation_OK(t *testing.T) {
 		BeaconDB: beaconDB,
 		P2P:      broadcaster,
 	})
+	beaconState, privKeys := setupHeadState(t, beaconDB)
 
-	attestation := &pb.Attestation{
-		AggregationBitfield: []byte{'A'},
-		Data: &pb.AttestationData{
-			Slot: 100,
-		}}
+	attestation := signedAttestation(t, beaconState, privKeys, attestationData(t, beaconState, 'A'), []byte{'A'})
 	if err := service.HandleAttestations(context.Background(), attestation); err != nil {
 		t.Error(err)
 	}
@@ -132,9 +329,8 @@ func TestRetrieveAttestations_OK(t *testing.T) {
 	defer internal.TeardownDB(t, beaconDB)
 	service := NewOpsPoolService(context.Background(), &Config{BeaconDB: beaconDB})
 
-	// Save 140 attestations for test. During 1st retrieval we should get slot:0 - slot:128 attestations,
-	// 2nd retrieval we should get slot:128 - slot:140 attestations.
-	// Max attestation config value is set to 128.
+	// Save 140 attestations for test. All of them should be retrieved in slot order, selecting
+	// up to MaxAttestations of them for a block is left to the proposer.
 	origAttestations := make([]*pb.Attestation, 140)
 	for i := 0; i < len(origAttestations); i++ {
 		origAttestations[i] = &pb.Attestation{
@@ -147,14 +343,304 @@ func TestRetrieveAttestations_OK(t *testing.T) {
 			t.Fatalf("Failed to save attestation: %v", err)
 		}
 	}
-	// Test we can retrieve attestations from slot0 - slot127 (Max attestation amount).
 	attestations, err := service.PendingAttestations()
 	if err != nil {
 		t.Fatalf("Could not retrieve attestations: %v", err)
 	}
-	if !reflect.DeepEqual(attestations, origAttestations[0:params.BeaconConfig().MaxAttestations]) {
-		t.Errorf("Retrieved attestations did not match prev generated attestations for the first %d",
-			params.BeaconConfig().MaxAttestations)
+	if !reflect.DeepEqual(attestations, origAttestations) {
+		t.Error("Retrieved attestations did not match prev generated attestations")
+	}
+}
+
+// setupHeadState saves a genesis head state with enough validators for every
+// committee to have eight members, and returns it along with their keys.
+func setupHeadState(t *testing.T, beaconDB *db.BeaconDB) (*pb.BeaconState, []*bls.SecretKey) {
+	deposits := make([]*pb.Deposit, 8*params.BeaconConfig().SlotsPerEpoch)
+	privKeys := make([]*bls.SecretKey, len(deposits))
+	for i := 0; i < len(deposits); i++ {
+		priv, err := bls.RandKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		depositData, err := helpers.EncodeDepositData(
+			&pb.DepositInput{Pubkey: priv.PublicKey().Marshal()},
+			params.BeaconConfig().MaxDepositAmount,
+			0,
+		)
+		if err != nil {
+			t.Fatalf("Could not encode deposit input: %v", err)
+		}
+		deposits[i] = &pb.Deposit{DepositData: depositData}
+		privKeys[i] = priv
+	}
+	beaconState, err := state.GenesisBeaconState(deposits, 0, nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate genesis state: %v", err)
+	}
+	genesis := b.NewGenesisBlock([]byte{})
+	if err := beaconDB.SaveBlock(genesis); err != nil {
+		t.Fatalf("Could not save genesis block: %v", err)
+	}
+	if err := beaconDB.UpdateChainHead(context.Background(), genesis, beaconState); err != nil {
+		t.Fatalf("Could not save genesis state: %v", err)
+	}
+	return beaconState, privKeys
+}
+
+// attestationData returns attestation data for the first committee at the slot
+// after genesis.
+func attestationData(t *testing.T, beaconState *pb.BeaconState, root byte) *pb.AttestationData {
+	slot := params.BeaconConfig().GenesisSlot + 1
+	committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, slot, false)
+	if err != nil {
+		t.Fatalf("Could not get committees at slot %d: %v", slot, err)
+	}
+	return &pb.AttestationData{
+		Slot:                  slot,
+		Shard:                 committees[0].Shard,
+		BeaconBlockRootHash32: []byte{root},
+	}
+}
+
+// signedAttestation returns an attestation of the data by the committee members in
+// the bitfield, signed with their keys.
+func signedAttestation(
+	t *testing.T, beaconState *pb.BeaconState, privKeys []*bls.SecretKey, data *pb.AttestationData, bitfield []byte,
+) *pb.Attestation {
+	participants, err := helpers.AttestationParticipants(beaconState, data, bitfield)
+	if err != nil {
+		t.Fatalf("Could not get attestation participants: %v", err)
+	}
+	msg, err := hashutil.HashAttestationDataAndCustodyBit(data, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := forkutil.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(data.Slot), params.BeaconConfig().DomainAttestation)
+	var sigs []*bls.Signature
+	for _, idx := range participants {
+		sigs = append(sigs, privKeys[idx].Sign(msg[:], domain))
+	}
+	return &pb.Attestation{
+		Data:                data,
+		AggregationBitfield: bitfield,
+		CustodyBitfield:     make([]byte, len(bitfield)),
+		AggregateSignature:  bls.AggregateSignatures(sigs).Marshal(),
+	}
+}
+
+func TestIncomingAttestations_Aggregates(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	service := NewOpsPoolService(context.Background(), &Config{
+		BeaconDB: beaconDB,
+		P2P:      &mockBroadcaster{},
+	})
+	beaconState, privKeys := setupHeadState(t, beaconDB)
+
+	data := attestationData(t, beaconState, 'A')
+	otherData := attestationData(t, beaconState, 'B')
+	incoming := []*pb.Attestation{
+		signedAttestation(t, beaconState, privKeys, data, []byte{128}),
+		signedAttestation(t, beaconState, privKeys, otherData, []byte{128}),
+		signedAttestation(t, beaconState, privKeys, data, []byte{64}),
+		signedAttestation(t, beaconState, privKeys, data, []byte{32}),
+		// Already covered by the aggregate of the previous attestations.
+		signedAttestation(t, beaconState, privKeys, data, []byte{64}),
+		// Overlaps the aggregate, so it is kept as a separate aggregate.
+		signedAttestation(t, beaconState, privKeys, data, []byte{48}),
+		// Overlaps neither aggregate, so it extends the one with the most participants.
+		signedAttestation(t, beaconState, privKeys, data, []byte{8}),
+	}
+	for _, att := range incoming {
+		if err := service.HandleAttestations(context.Background(), att); err != nil {
+			t.Fatalf("Could not handle attestation: %v", err)
+		}
+	}
+
+	pending, err := service.pendingAttestationsByData(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var bitfields [][]byte
+	for _, a := range pending {
+		bitfields = append(bitfields, a.AggregationBitfield)
+	}
+	want := [][]byte{{48}, {232}}
+	sort.Slice(bitfields, func(i, j int) bool { return bitfields[i][0] < bitfields[j][0] })
+	if !reflect.DeepEqual(bitfields, want) {
+		t.Errorf("Expected pending aggregates with bitfields %v, received %v", want, bitfields)
+	}
+	pending, err = service.pendingAttestationsByData(otherData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 1 || !proto.Equal(pending[0], incoming[1]) {
+		t.Errorf("Expected attestation %v to be pending unchanged, received %v", incoming[1], pending)
+	}
+}
+
+func TestIncomingAttestations_RejectsInvalidSignature(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	broadcaster := &mockBroadcaster{}
+	service := NewOpsPoolService(context.Background(), &Config{
+		BeaconDB: beaconDB,
+		P2P:      broadcaster,
+	})
+	beaconState, privKeys := setupHeadState(t, beaconDB)
+
+	data := attestationData(t, beaconState, 'A')
+	pooled := signedAttestation(t, beaconState, privKeys, data, []byte{128})
+	if err := service.HandleAttestations(context.Background(), pooled); err != nil {
+		t.Fatalf("Could not handle attestation: %v", err)
+	}
+	broadcaster.broadcastCalled = false
+	// Signed by the participant of the pooled attestation instead of its own.
+	forged := signedAttestation(t, beaconState, privKeys, data, []byte{64})
+	forged.AggregateSignature = pooled.AggregateSignature
+	if err := service.HandleAttestations(context.Background(), forged); err == nil {
+		t.Fatal("Expected an attestation with an invalid signature to be rejected")
+	}
+
+	pending, err := service.pendingAttestationsByData(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 1 || !proto.Equal(pending[0], pooled) {
+		t.Errorf("Expected only attestation %v to be pending, received %v", pooled, pending)
+	}
+	if broadcaster.broadcastCalled {
+		t.Error("Attestation with an invalid signature should not be broadcasted")
+	}
+}
+
+func TestHandleOrphanedBlock_RequeuesAttestations(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	broadcaster := &mockBroadcaster{}
+	service := NewOpsPoolService(context.Background(), &Config{
+		BeaconDB: beaconDB,
+		P2P:      broadcaster,
+	})
+	beaconState, privKeys := setupHeadState(t, beaconDB)
+
+	data := attestationData(t, beaconState, 'A')
+	pooled := signedAttestation(t, beaconState, privKeys, data, []byte{128})
+	if err := beaconDB.SaveAttestation(context.Background(), pooled); err != nil {
+		t.Fatalf("Failed to save attestation: %v", err)
+	}
+	otherData := attestationData(t, beaconState, 'B')
+	forged := signedAttestation(t, beaconState, privKeys, otherData, []byte{64})
+	forged.AggregateSignature = pooled.AggregateSignature
+	block := &pb.BeaconBlock{
+		Slot: data.Slot + 1,
+		Body: &pb.BeaconBlockBody{
+			Attestations: []*pb.Attestation{
+				signedAttestation(t, beaconState, privKeys, data, []byte{64}),
+				signedAttestation(t, beaconState, privKeys, otherData, []byte{128}),
+				// Attestations which do not verify are not re-queued.
+				forged,
+			},
+		},
+	}
+	if err := service.handleOrphanedBlock(context.Background(), block); err != nil {
+		t.Fatalf("Could not handle orphaned block: %v", err)
+	}
+
+	pending, err := service.pendingAttestationsByData(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 1 || !bytes.Equal(pending[0].AggregationBitfield, []byte{192}) {
+		t.Errorf("Expected the orphaned attestation to be aggregated with the pooled one, received %v", pending)
+	}
+	pending, err = service.pendingAttestationsByData(otherData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 1 || !proto.Equal(pending[0], block.Body.Attestations[1]) {
+		t.Errorf("Expected attestation %v to be re-queued, received %v", block.Body.Attestations[1], pending)
+	}
+	if broadcaster.broadcastCalled {
+		t.Error("Re-queued attestations should not be broadcasted")
+	}
+}
+
+func TestHandleOrphanedBlock_SkipsIncludedAttestations(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	service := NewOpsPoolService(context.Background(), &Config{BeaconDB: beaconDB})
+	beaconState, privKeys := setupHeadState(t, beaconDB)
+
+	data := attestationData(t, beaconState, 'A')
+	otherData := attestationData(t, beaconState, 'B')
+	// The new canonical chain included the participants of the first attestation
+	// over two pending attestations, and only part of those of the second one.
+	beaconState.LatestAttestations = []*pb.PendingAttestation{
+		{Data: data, AggregationBitfield: []byte{128}},
+		{Data: data, AggregationBitfield: []byte{64}},
+		{Data: otherData, AggregationBitfield: []byte{128}},
+	}
+	genesis, err := beaconDB.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.UpdateChainHead(context.Background(), genesis, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	block := &pb.BeaconBlock{
+		Slot: data.Slot + 1,
+		Body: &pb.BeaconBlockBody{
+			Attestations: []*pb.Attestation{
+				signedAttestation(t, beaconState, privKeys, data, []byte{192}),
+				signedAttestation(t, beaconState, privKeys, otherData, []byte{192}),
+			},
+		},
+	}
+	if err := service.handleOrphanedBlock(context.Background(), block); err != nil {
+		t.Fatalf("Could not handle orphaned block: %v", err)
+	}
+
+	pending, err := service.pendingAttestationsByData(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 0 {
+		t.Errorf("Expected the included attestation not to be re-queued, received %v", pending)
+	}
+	pending, err = service.pendingAttestationsByData(otherData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 1 || !proto.Equal(pending[0], block.Body.Attestations[1]) {
+		t.Errorf("Expected attestation %v to be re-queued, received %v", block.Body.Attestations[1], pending)
+	}
+}
+
+func TestRemoveProcessedAttestations_KeepsUncoveredAggregates(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	s := NewOpsPoolService(context.Background(), &Config{BeaconDB: beaconDB})
+
+	data := &pb.AttestationData{Slot: 100, Shard: 1}
+	covered := &pb.Attestation{Data: data, AggregationBitfield: []byte{128}}
+	uncovered := &pb.Attestation{Data: data, AggregationBitfield: []byte{96}}
+	for _, a := range []*pb.Attestation{covered, uncovered} {
+		if err := beaconDB.SaveAttestation(context.Background(), a); err != nil {
+			t.Fatalf("Failed to save attestation: %v", err)
+		}
+	}
+
+	included := &pb.Attestation{Data: data, AggregationBitfield: []byte{192}}
+	if err := s.removePendingAttestations([]*pb.Attestation{included}); err != nil {
+		t.Fatalf("Could not remove pending attestations: %v", err)
+	}
+	pending, err := s.PendingAttestations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 1 || !proto.Equal(pending[0], uncovered) {
+		t.Errorf("Expected only attestation %v to remain, received %v", uncovered, pending)
 	}
 }
 
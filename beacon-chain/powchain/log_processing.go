@@ -41,28 +41,30 @@ func (w *Web3Service) HasChainStartLogOccurred() (bool, uint64, error) {
 }
 
 // ProcessLog is the main method which handles the processing of all
-// logs from the deposit contract on the ETH1.0 chain.
-func (w *Web3Service) ProcessLog(depositLog gethTypes.Log) {
+// logs from the deposit contract on the ETH1.0 chain. It returns an error
+// if the log could not be saved to the db, so it can be processed again.
+func (w *Web3Service) ProcessLog(depositLog gethTypes.Log) error {
 	// Process logs according to their event signature.
 	if depositLog.Topics[0] == hashutil.Hash(depositEventSignature) {
-		w.ProcessDepositLog(depositLog)
-		return
+		return w.ProcessDepositLog(depositLog)
 	}
 	if depositLog.Topics[0] == hashutil.Hash(chainStartEventSignature) && !w.chainStarted {
 		w.ProcessChainStartLog(depositLog)
-		return
+		return nil
 	}
 	log.Debugf("Log is not of a valid event signature %#x", depositLog.Topics[0])
+	return nil
 }
 
 // ProcessDepositLog processes the log which had been received from
 // the ETH1.0 chain by trying to ascertain which participant deposited
-// in the contract.
-func (w *Web3Service) ProcessDepositLog(depositLog gethTypes.Log) {
+// in the contract. The Merkle index of the log is only marked as seen
+// once the deposit is saved to the db.
+func (w *Web3Service) ProcessDepositLog(depositLog gethTypes.Log) error {
 	_, depositData, merkleTreeIndex, _, err := contracts.UnpackDepositLogData(depositLog.Data)
 	if err != nil {
 		log.Errorf("Could not unpack log %v", err)
-		return
+		return nil
 	}
 	// If we have already seen this Merkle index, skip processing the log.
 	// This can happen sometimes when we receive the same log twice from the
@@ -70,44 +72,46 @@ func (w *Web3Service) ProcessDepositLog(depositLog gethTypes.Log) {
 	// with the same log twice, causing an inconsistent state root.
 	index := binary.LittleEndian.Uint64(merkleTreeIndex)
 	if int64(index) <= w.lastReceivedMerkleIndex {
-		return
+		return nil
 	}
-	w.lastReceivedMerkleIndex = int64(index)
 
 	// We then decode the deposit input in order to create a deposit object
 	// we can store in our persistent DB.
 	depositInput, err := helpers.DecodeDepositInput(depositData)
 	if err != nil {
 		log.Errorf("Could not decode deposit input %v", err)
-		return
+		w.lastReceivedMerkleIndex = int64(index)
+		return nil
 	}
 
 	deposit := &pb.Deposit{
 		DepositData:     depositData,
 		MerkleTreeIndex: index,
 	}
+	blockNumber := big.NewInt(int64(depositLog.BlockNumber))
 
 	validData := true
 
 	// Make sure duplicates are rejected pre-chainstart.
-	if !w.chainStarted {
-		var pubkey = fmt.Sprintf("#%x", depositInput.Pubkey)
-		if w.beaconDB.PubkeyInChainstart(w.ctx, pubkey) {
-			log.Warnf("Pubkey %#x has already been submitted for chainstart", pubkey)
-			validData = false
-		} else {
-			w.beaconDB.MarkPubkeyForChainstart(w.ctx, pubkey)
-		}
+	pubkey := fmt.Sprintf("#%x", depositInput.Pubkey)
+	if !w.chainStarted && w.beaconDB.PubkeyInChainstart(w.ctx, pubkey) {
+		log.Warnf("Pubkey %#x has already been submitted for chainstart", pubkey)
+		validData = false
 	}
 
 	// We always store all historical deposits in the DB.
-	w.beaconDB.InsertDeposit(w.ctx, deposit, big.NewInt(int64(depositLog.BlockNumber)))
+	if err := w.beaconDB.InsertDeposit(w.ctx, deposit, blockNumber); err != nil {
+		return fmt.Errorf("could not insert deposit: %v", err)
+	}
 
 	if validData {
 		if !w.chainStarted {
+			if err := w.beaconDB.MarkPubkeyForChainstart(w.ctx, pubkey); err != nil {
+				return fmt.Errorf("could not mark pubkey for chainstart: %v", err)
+			}
 			w.chainStartDeposits = append(w.chainStartDeposits, depositData)
-		} else {
-			w.beaconDB.InsertPendingDeposit(w.ctx, deposit, big.NewInt(int64(depositLog.BlockNumber)))
+		} else if err := w.beaconDB.InsertPendingDeposit(w.ctx, deposit, blockNumber); err != nil {
+			return fmt.Errorf("could not insert pending deposit: %v", err)
 		}
 		log.WithFields(logrus.Fields{
 			"publicKey":       fmt.Sprintf("%#x", depositInput.Pubkey),
@@ -115,6 +119,8 @@ func (w *Web3Service) ProcessDepositLog(depositLog gethTypes.Log) {
 		}).Info("Deposit registered from deposit contract")
 		validDepositsCount.Inc()
 	}
+	w.lastReceivedMerkleIndex = int64(index)
+	return nil
 }
 
 // ProcessChainStartLog processes the log which had been received from
@@ -134,6 +140,7 @@ func (w *Web3Service) ProcessChainStartLog(depositLog gethTypes.Log) {
 
 	timestamp := binary.LittleEndian.Uint64(timestampData)
 	w.chainStarted = true
+	w.chainStartBlock = big.NewInt(int64(depositLog.BlockNumber))
 	w.depositRoot = chainStartDepositRoot[:]
 	chainStartTime := time.Unix(int64(timestamp), 0)
 
@@ -164,22 +171,45 @@ func (w *Web3Service) processPastLogs() error {
 		},
 	}
 
-	logs, err := w.logger.FilterLogs(w.ctx, query)
+	// Resume from the block after the last one processed before a restart, the
+	// deposits up to it being already stored in the db.
+	lastProcessed, err := w.beaconDB.LastProcessedEth1Block(w.ctx)
 	if err != nil {
-		return err
+		return fmt.Errorf("could not get last processed eth1 block: %v", err)
+	}
+	if lastProcessed != nil {
+		if err := w.restoreDeposits(); err != nil {
+			return fmt.Errorf("could not restore deposits from db: %v", err)
+		}
+		query.FromBlock = big.NewInt(0).Add(lastProcessed, big.NewInt(1))
+		log.WithField("fromBlock", query.FromBlock).Info("Resuming deposit log processing")
 	}
 
-	for _, log := range logs {
-		w.ProcessLog(log)
+	if lastProcessed == nil || lastProcessed.Cmp(w.blockHeight) < 0 {
+		logs, err := w.logger.FilterLogs(w.ctx, query)
+		if err != nil {
+			return err
+		}
+
+		for _, log := range logs {
+			if err := w.ProcessLog(log); err != nil {
+				return fmt.Errorf("could not process log: %v", err)
+			}
+		}
 	}
 	w.lastRequestedBlock.Set(w.blockHeight)
+	if err := w.saveLastProcessedEth1Block(w.blockHeight); err != nil {
+		return err
+	}
 
 	currentState, err := w.beaconDB.HeadState(w.ctx)
 	if err != nil {
 		return fmt.Errorf("could not get head state: %v", err)
 	}
 	if currentState != nil && currentState.DepositIndex > 0 {
-		w.beaconDB.PrunePendingDeposits(w.ctx, currentState.DepositIndex)
+		if err := w.beaconDB.PrunePendingDeposits(w.ctx, currentState.DepositIndex); err != nil {
+			return fmt.Errorf("could not prune pending deposits: %v", err)
+		}
 	}
 
 	return nil
@@ -195,7 +225,7 @@ func (w *Web3Service) requestBatchedLogs() error {
 		Addresses: []common.Address{
 			w.depositContractAddress,
 		},
-		FromBlock: w.lastRequestedBlock.Add(w.lastRequestedBlock, big.NewInt(1)),
+		FromBlock: big.NewInt(0).Add(w.lastRequestedBlock, big.NewInt(1)),
 		ToBlock:   requestedBlock,
 	}
 	logs, err := w.logger.FilterLogs(w.ctx, query)
@@ -207,10 +237,80 @@ func (w *Web3Service) requestBatchedLogs() error {
 	if len(logs) > 0 {
 		log.Debug("Processing Batched Logs")
 		for _, log := range logs {
-			w.ProcessLog(log)
+			if err := w.ProcessLog(log); err != nil {
+				return fmt.Errorf("could not process log: %v", err)
+			}
 		}
 	}
 
 	w.lastRequestedBlock.Set(requestedBlock)
+	if err := w.saveLastProcessedEth1Block(requestedBlock); err != nil {
+		return err
+	}
+	return nil
+}
+
+// saveLastProcessedEth1Block saves the block up to which the logs were processed,
+// to resume from after a restart. Until the beacon chain is initialized from the
+// ChainStart log, the saved block stays before it, so the ChainStart log is
+// processed again after a restart.
+func (w *Web3Service) saveLastProcessedEth1Block(blockNum *big.Int) error {
+	if w.chainStartBlock != nil {
+		currentState, err := w.beaconDB.HeadState(w.ctx)
+		if err != nil {
+			return fmt.Errorf("could not get head state: %v", err)
+		}
+		if currentState != nil {
+			w.chainStartBlock = nil
+		} else if blockNum.Cmp(w.chainStartBlock) >= 0 {
+			if w.chainStartBlock.Sign() == 0 {
+				return nil
+			}
+			blockNum = big.NewInt(0).Sub(w.chainStartBlock, big.NewInt(1))
+		}
+	}
+	if err := w.beaconDB.SaveLastProcessedEth1Block(w.ctx, blockNum); err != nil {
+		return fmt.Errorf("could not save last processed eth1 block: %v", err)
+	}
+	return nil
+}
+
+// restoreDeposits rebuilds the log processing state from the deposits stored in
+// the db, as if their logs had been processed again. The chainstart deposits are
+// the first deposits of the pubkeys marked for chainstart, like when their logs
+// are first processed, and if the beacon chain has started, the deposit trie is
+// generated from them like on the ChainStart log.
+func (w *Web3Service) restoreDeposits() error {
+	seen := make(map[string]bool)
+	for _, deposit := range w.beaconDB.AllDeposits(w.ctx, nil) {
+		if int64(deposit.MerkleTreeIndex) > w.lastReceivedMerkleIndex {
+			w.lastReceivedMerkleIndex = int64(deposit.MerkleTreeIndex)
+		}
+		depositInput, err := helpers.DecodeDepositInput(deposit.DepositData)
+		if err != nil {
+			return fmt.Errorf("could not decode deposit input: %v", err)
+		}
+		pubkey := fmt.Sprintf("#%x", depositInput.Pubkey)
+		if !seen[pubkey] && w.beaconDB.PubkeyInChainstart(w.ctx, pubkey) {
+			w.chainStartDeposits = append(w.chainStartDeposits, deposit.DepositData)
+		}
+		seen[pubkey] = true
+	}
+
+	currentState, err := w.beaconDB.HeadState(w.ctx)
+	if err != nil {
+		return fmt.Errorf("could not get head state: %v", err)
+	}
+	if currentState == nil {
+		return nil
+	}
+	w.chainStarted = true
+	if len(w.chainStartDeposits) > 0 {
+		depositTrie, err := trieutil.GenerateTrieFromItems(w.chainStartDeposits, int(params.BeaconConfig().DepositContractTreeDepth))
+		if err != nil {
+			return fmt.Errorf("could not generate deposit trie: %v", err)
+		}
+		w.depositTrie = depositTrie
+	}
 	return nil
 }
@@ -4,18 +4,24 @@ import (
 	"bytes"
 	"context"
 	"encoding/binary"
+	"fmt"
+	"math/big"
+	"reflect"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/gogo/protobuf/proto"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/ssz"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
 
@@ -32,7 +38,7 @@ func TestProcessDepositLog_OK(t *testing.T) {
 		Reader:          &goodReader{},
 		Logger:          &goodLogger{},
 		ContractBackend: testAcc.backend,
-		BeaconDB:        &db.BeaconDB{},
+		BeaconDB:        db.NewInMemoryDB(),
 	})
 	if err != nil {
 		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
@@ -94,7 +100,7 @@ func TestProcessDepositLog_InsertsPendingDeposit(t *testing.T) {
 		Reader:          &goodReader{},
 		Logger:          &goodLogger{},
 		ContractBackend: testAcc.backend,
-		BeaconDB:        &db.BeaconDB{},
+		BeaconDB:        db.NewInMemoryDB(),
 	})
 	if err != nil {
 		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
@@ -238,7 +244,7 @@ func TestProcessChainStartLog_8DuplicatePubkeys(t *testing.T) {
 		Reader:          &goodReader{},
 		Logger:          &goodLogger{},
 		ContractBackend: testAcc.backend,
-		BeaconDB:        &db.BeaconDB{},
+		BeaconDB:        db.NewInMemoryDB(),
 	})
 	if err != nil {
 		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
@@ -323,7 +329,7 @@ func TestProcessChainStartLog_8UniquePubkeys(t *testing.T) {
 		Reader:          &goodReader{},
 		Logger:          &goodLogger{},
 		ContractBackend: testAcc.backend,
-		BeaconDB:        &db.BeaconDB{},
+		BeaconDB:        db.NewInMemoryDB(),
 	})
 	if err != nil {
 		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
@@ -535,7 +541,7 @@ func TestETH1DataGenesis_OK(t *testing.T) {
 		Reader:          &goodReader{},
 		Logger:          testAcc.backend,
 		ContractBackend: testAcc.backend,
-		BeaconDB:        &db.BeaconDB{},
+		BeaconDB:        db.NewInMemoryDB(),
 	})
 	if err != nil {
 		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
@@ -626,3 +632,298 @@ func TestETH1DataGenesis_OK(t *testing.T) {
 			web3Service.ChainStartETH1Data(), expectedETH1Data)
 	}
 }
+
+// insertDeposits stores a deposit for each pubkey, in eth1 blocks 5 and onwards,
+// and marks the chainstart pubkeys like processing their logs would.
+func insertDeposits(t *testing.T, beaconDB *db.BeaconDB, pubkeys []string, chainstartPubkeys []string) [][]byte {
+	var deposits [][]byte
+	for i, pubkey := range pubkeys {
+		depositData, err := helpers.EncodeDepositData(&pb.DepositInput{Pubkey: []byte(pubkey)}, params.BeaconConfig().MaxDepositAmount, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		deposit := &pb.Deposit{DepositData: depositData, MerkleTreeIndex: uint64(i)}
+		if err := beaconDB.InsertDeposit(context.Background(), deposit, big.NewInt(int64(5+i))); err != nil {
+			t.Fatal(err)
+		}
+		deposits = append(deposits, depositData)
+	}
+	for _, pubkey := range chainstartPubkeys {
+		if err := beaconDB.MarkPubkeyForChainstart(context.Background(), fmt.Sprintf("#%x", pubkey)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return deposits
+}
+
+type queryRecordingLogger struct {
+	goodLogger
+	queries []ethereum.FilterQuery
+}
+
+func (l *queryRecordingLogger) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]gethTypes.Log, error) {
+	l.queries = append(l.queries, q)
+	return nil, nil
+}
+
+func TestProcessPastLogs_ResumesFromLastProcessedBlock(t *testing.T) {
+	testAcc, err := setup()
+	if err != nil {
+		t.Fatalf("Unable to set up simulated backend %v", err)
+	}
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("Could not set up simulated beacon DB: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
+	logger := &queryRecordingLogger{}
+	web3Service, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
+		Endpoint:        "ws://127.0.0.1",
+		DepositContract: testAcc.contractAddr,
+		Reader:          &goodReader{},
+		Logger:          logger,
+		ContractBackend: testAcc.backend,
+		BeaconDB:        beaconDB,
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+
+	// The deposit at index 1 reuses the pubkey of the first one, and is not a
+	// chainstart deposit.
+	insertDeposits(t, beaconDB, []string{"A", "A", "B"}, []string{"A", "B"})
+	if err := beaconDB.SaveLastProcessedEth1Block(context.Background(), big.NewInt(10)); err != nil {
+		t.Fatal(err)
+	}
+	web3Service.blockHeight = big.NewInt(20)
+
+	if err := web3Service.processPastLogs(); err != nil {
+		t.Fatalf("Could not process past logs: %v", err)
+	}
+	if len(logger.queries) != 1 || logger.queries[0].FromBlock.Cmp(big.NewInt(11)) != 0 {
+		t.Errorf("Expected logs to be requested from block 11, received queries %v", logger.queries)
+	}
+	if web3Service.lastReceivedMerkleIndex != 2 {
+		t.Errorf("Expected last received merkle index 2, received %d", web3Service.lastReceivedMerkleIndex)
+	}
+	if len(web3Service.ChainStartDeposits()) != 2 {
+		t.Errorf("Expected 2 chainstart deposits, received %d", len(web3Service.ChainStartDeposits()))
+	}
+	lastProcessed, err := beaconDB.LastProcessedEth1Block(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lastProcessed.Cmp(big.NewInt(20)) != 0 {
+		t.Errorf("Expected last processed eth1 block 20, received %v", lastProcessed)
+	}
+}
+
+func TestProcessPastLogs_RestoresChainStartDepositTrie(t *testing.T) {
+	testAcc, err := setup()
+	if err != nil {
+		t.Fatalf("Unable to set up simulated backend %v", err)
+	}
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("Could not set up simulated beacon DB: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
+	web3Service, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
+		Endpoint:        "ws://127.0.0.1",
+		DepositContract: testAcc.contractAddr,
+		Reader:          &goodReader{},
+		Logger:          &queryRecordingLogger{},
+		ContractBackend: testAcc.backend,
+		BeaconDB:        beaconDB,
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+
+	// Only the first deposits of A and B were chainstart deposits, C deposited
+	// after the chain started.
+	deposits := insertDeposits(t, beaconDB, []string{"A", "A", "B", "C"}, []string{"A", "B"})
+	if err := beaconDB.SaveState(context.Background(), &pb.BeaconState{Slot: params.BeaconConfig().GenesisSlot}); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveLastProcessedEth1Block(context.Background(), big.NewInt(10)); err != nil {
+		t.Fatal(err)
+	}
+	web3Service.blockHeight = big.NewInt(20)
+
+	if err := web3Service.processPastLogs(); err != nil {
+		t.Fatalf("Could not process past logs: %v", err)
+	}
+	chainStartDeposits := [][]byte{deposits[0], deposits[2]}
+	if !reflect.DeepEqual(web3Service.ChainStartDeposits(), chainStartDeposits) {
+		t.Errorf("Expected chainstart deposits %#x, received %#x", chainStartDeposits, web3Service.ChainStartDeposits())
+	}
+	chainStartTrie, err := trieutil.GenerateTrieFromItems(chainStartDeposits, int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if web3Service.DepositRoot() != chainStartTrie.Root() {
+		t.Errorf("Expected deposit root %#x of the chainstart deposits, received %#x", chainStartTrie.Root(), web3Service.DepositRoot())
+	}
+}
+
+func TestProcessPastLogs_ReprocessesChainStartUntilStateInitialized(t *testing.T) {
+	testAcc, err := setup()
+	if err != nil {
+		t.Fatalf("Unable to set up simulated backend %v", err)
+	}
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("Could not set up simulated beacon DB: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
+	newService := func() *Web3Service {
+		web3Service, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
+			Endpoint:        "ws://127.0.0.1",
+			DepositContract: testAcc.contractAddr,
+			Reader:          &goodReader{},
+			Logger:          testAcc.backend,
+			ContractBackend: testAcc.backend,
+			BeaconDB:        beaconDB,
+		})
+		if err != nil {
+			t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+		}
+		return web3Service
+	}
+
+	// The deposits after the chainstart ones are in later blocks than the ChainStart log.
+	for i := 0; i < depositsReqForChainStart+2; i++ {
+		serializedData := new(bytes.Buffer)
+		if err := ssz.Encode(serializedData, &pb.DepositInput{Pubkey: []byte{byte(i)}}); err != nil {
+			t.Fatalf("Could not serialize data %v", err)
+		}
+		testAcc.txOpts.Value = amount32Eth
+		if _, err := testAcc.contract.Deposit(testAcc.txOpts, serializedData.Bytes()); err != nil {
+			t.Fatalf("Could not deposit to deposit contract %v", err)
+		}
+		testAcc.backend.Commit()
+	}
+	chainStartIterator, err := testAcc.contract.FilterChainStart(nil)
+	if err != nil {
+		t.Fatalf("Could not create chainstart iterator: %v", err)
+	}
+	defer chainStartIterator.Close()
+	if !chainStartIterator.Next() {
+		t.Fatal("Expected a ChainStart log")
+	}
+	chainStartLog := chainStartIterator.Event
+	chainStartBlock := big.NewInt(int64(chainStartLog.Raw.BlockNumber))
+	blockHeight := big.NewInt(0).Add(chainStartBlock, big.NewInt(10))
+
+	web3Service := newService()
+	web3Service.blockHeight = blockHeight
+	if err := web3Service.processPastLogs(); err != nil {
+		t.Fatalf("Could not process past logs: %v", err)
+	}
+	if !web3Service.chainStarted {
+		t.Fatal("Expected the ChainStart log to be processed")
+	}
+	lastProcessed, err := beaconDB.LastProcessedEth1Block(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := big.NewInt(0).Sub(chainStartBlock, big.NewInt(1)); lastProcessed.Cmp(want) != 0 {
+		t.Errorf("Expected last processed eth1 block %v before the ChainStart log, received %v", want, lastProcessed)
+	}
+
+	// After a restart before the genesis state was saved, the ChainStart log is
+	// processed again.
+	web3Service = newService()
+	web3Service.blockHeight = blockHeight
+	chainStartChan := make(chan time.Time, 1)
+	sub := web3Service.ChainStartFeed().Subscribe(chainStartChan)
+	defer sub.Unsubscribe()
+	if err := web3Service.processPastLogs(); err != nil {
+		t.Fatalf("Could not process past logs: %v", err)
+	}
+	select {
+	case <-chainStartChan:
+	default:
+		t.Error("Expected the ChainStart log to be sent after a restart")
+	}
+	expectedETH1Data := &pb.Eth1Data{
+		BlockHash32:       chainStartLog.Raw.BlockHash[:],
+		DepositRootHash32: chainStartLog.DepositRoot[:],
+	}
+	if !proto.Equal(expectedETH1Data, web3Service.ChainStartETH1Data()) {
+		t.Errorf("Expected chainstart eth1data %v, received %v", expectedETH1Data, web3Service.ChainStartETH1Data())
+	}
+	if len(web3Service.ChainStartDeposits()) != depositsReqForChainStart {
+		t.Errorf("Expected %d chainstart deposits, received %d", depositsReqForChainStart, len(web3Service.ChainStartDeposits()))
+	}
+
+	// Once the genesis state is saved, the last processed block moves past it.
+	if err := beaconDB.SaveState(context.Background(), &pb.BeaconState{Slot: params.BeaconConfig().GenesisSlot}); err != nil {
+		t.Fatal(err)
+	}
+	if err := web3Service.saveLastProcessedEth1Block(blockHeight); err != nil {
+		t.Fatal(err)
+	}
+	lastProcessed, err = beaconDB.LastProcessedEth1Block(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lastProcessed.Cmp(blockHeight) != 0 {
+		t.Errorf("Expected last processed eth1 block %v, received %v", blockHeight, lastProcessed)
+	}
+}
+
+func TestProcessDepositLog_ReturnsDBError(t *testing.T) {
+	testAcc, err := setup()
+	if err != nil {
+		t.Fatalf("Unable to set up simulated backend %v", err)
+	}
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("Could not set up simulated beacon DB: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
+	web3Service, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
+		Endpoint:        "ws://127.0.0.1",
+		DepositContract: testAcc.contractAddr,
+		Reader:          &goodReader{},
+		Logger:          &goodLogger{},
+		ContractBackend: testAcc.backend,
+		BeaconDB:        beaconDB,
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+
+	var stub [48]byte
+	copy(stub[:], []byte("testing"))
+	serializedData := new(bytes.Buffer)
+	if err := ssz.Encode(serializedData, &pb.DepositInput{Pubkey: stub[:]}); err != nil {
+		t.Fatalf("Could not serialize data %v", err)
+	}
+	testAcc.txOpts.Value = amount32Eth
+	if _, err := testAcc.contract.Deposit(testAcc.txOpts, serializedData.Bytes()); err != nil {
+		t.Fatalf("Could not deposit to deposit contract %v", err)
+	}
+	testAcc.backend.Commit()
+	logs, err := testAcc.backend.FilterLogs(web3Service.ctx, ethereum.FilterQuery{
+		Addresses: []common.Address{web3Service.depositContractAddress},
+	})
+	if err != nil {
+		t.Fatalf("Unable to retrieve logs %v", err)
+	}
+
+	if err := beaconDB.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := web3Service.ProcessLog(logs[0]); err == nil {
+		t.Fatal("Expected processing the deposit log to fail on a closed db")
+	}
+	if web3Service.lastReceivedMerkleIndex != -1 {
+		t.Errorf("Expected the deposit log to be processed again, last received merkle index is %d", web3Service.lastReceivedMerkleIndex)
+	}
+	if len(web3Service.ChainStartDeposits()) != 0 {
+		t.Errorf("Expected no chainstart deposits, received %d", len(web3Service.ChainStartDeposits()))
+	}
+}
@@ -90,7 +90,8 @@ type Web3Service struct {
 	chainStartDeposits      [][]byte
 	chainStarted            bool
 	chainStartETH1Data      *pb.Eth1Data
-	beaconDB                *db.BeaconDB
+	chainStartBlock         *big.Int // the ETH1.0 block of the ChainStart log, until the genesis state is saved.
+	beaconDB                db.Database
 	lastReceivedMerkleIndex int64 // Keeps track of the last received index to prevent log spam.
 	isRunning               bool
 	runError                error
@@ -106,7 +107,7 @@ type Web3ServiceConfig struct {
 	Logger          bind.ContractFilterer
 	BlockFetcher    POWBlockFetcher
 	ContractBackend bind.ContractBackend
-	BeaconDB        *db.BeaconDB
+	BeaconDB        db.Database
 }
 
 // NewWeb3Service sets up a new instance with an ethclient when
@@ -17,7 +17,7 @@ import (
 // AttesterServer defines a server implementation of the gRPC Attester service,
 // providing RPC methods for validators acting as attesters to broadcast votes on beacon blocks.
 type AttesterServer struct {
-	beaconDB         *db.BeaconDB
+	beaconDB         db.Database
 	operationService operationService
 }
 
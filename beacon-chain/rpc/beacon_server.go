@@ -20,11 +20,12 @@ import (
 // providing RPC endpoints for obtaining the canonical beacon chain head,
 // fetching latest observed attestations, and more.
 type BeaconServer struct {
-	beaconDB            *db.BeaconDB
+	beaconDB            db.Database
 	ctx                 context.Context
 	powChainService     powChainService
 	chainService        chainService
 	operationService    operationService
+	syncService         syncService
 	incomingAttestation chan *pbp2p.Attestation
 	canonicalStateChan  chan *pbp2p.BeaconState
 	chainStartChan      chan time.Time
@@ -76,6 +77,23 @@ func (bs *BeaconServer) CanonicalHead(ctx context.Context, req *ptypes.Empty) (*
 	return block, nil
 }
 
+// SyncStatus reports whether the beacon node is still syncing with the network
+// along with the slot of its canonical head, so validator clients can pick the
+// healthiest of several beacon nodes.
+func (bs *BeaconServer) SyncStatus(ctx context.Context, req *ptypes.Empty) (*pb.SyncStatusResponse, error) {
+	block, err := bs.beaconDB.ChainHead()
+	if err != nil {
+		return nil, fmt.Errorf("could not get canonical head block: %v", err)
+	}
+	resp := &pb.SyncStatusResponse{
+		Syncing: bs.syncService.Status() != nil,
+	}
+	if block != nil {
+		resp.HeadSlot = block.Slot
+	}
+	return resp, nil
+}
+
 // LatestAttestation streams the latest processed attestations to the rpc clients.
 func (bs *BeaconServer) LatestAttestation(req *ptypes.Empty, stream pb.BeaconService_LatestAttestationServer) error {
 	sub := bs.operationService.IncomingAttFeed().Subscribe(bs.incomingAttestation)
@@ -116,18 +134,33 @@ func (bs *BeaconServer) Eth1Data(ctx context.Context, _ *ptypes.Empty) (*pb.Eth1
 	if err != nil {
 		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
 	}
+	eth1Data, err := eth1DataVote(ctx, bs.beaconDB, bs.powChainService, beaconState)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Eth1DataResponse{Eth1Data: eth1Data}, nil
+}
+
+// eth1DataVote returns the Ethereum 1.0 data a block built on top of the given
+// state should vote for.
+func eth1DataVote(
+	ctx context.Context,
+	beaconDB db.Database,
+	powChainService powChainService,
+	beaconState *pbp2p.BeaconState,
+) (*pbp2p.Eth1Data, error) {
 	// Fetch the current canonical chain height from the eth1.0 chain.
-	currentHeight := bs.powChainService.LatestBlockHeight()
+	currentHeight := powChainService.LatestBlockHeight()
 	eth1FollowDistance := int64(params.BeaconConfig().Eth1FollowDistance)
 
 	stateLatestEth1Hash := bytesutil.ToBytes32(beaconState.LatestEth1Data.BlockHash32)
 	// If latest ETH1 block hash is empty, send a default response
 	if stateLatestEth1Hash == [32]byte{} {
-		return bs.defaultDataResponse(ctx, currentHeight, eth1FollowDistance)
+		return defaultEth1Data(ctx, beaconDB, powChainService, currentHeight, eth1FollowDistance)
 	}
 	// Fetch the height of the block pointed to by the beacon state's latest_eth1_data.block_hash
 	// in the canonical, eth1.0 chain.
-	_, stateLatestEth1Height, err := bs.powChainService.BlockExists(ctx, stateLatestEth1Hash)
+	_, stateLatestEth1Height, err := powChainService.BlockExists(ctx, stateLatestEth1Hash)
 	if err != nil {
 		return nil, fmt.Errorf("could not verify block with hash exists in Eth1 chain: %#x: %v", stateLatestEth1Hash, err)
 	}
@@ -137,7 +170,7 @@ func (bs *BeaconServer) Eth1Data(ctx context.Context, _ *ptypes.Empty) (*pb.Eth1
 	for _, vote := range beaconState.Eth1DataVotes {
 		eth1Hash := bytesutil.ToBytes32(vote.Eth1Data.BlockHash32)
 		// Verify the block from the vote's block hash exists in the eth1.0 chain and fetch its height.
-		blockExists, blockHeight, err := bs.powChainService.BlockExists(ctx, eth1Hash)
+		blockExists, blockHeight, err := powChainService.BlockExists(ctx, eth1Hash)
 		if err != nil {
 			log.Debugf("Could not verify block with hash exists in Eth1 chain: %#x: %v", eth1Hash, err)
 			continue
@@ -189,39 +222,55 @@ func (bs *BeaconServer) Eth1Data(ctx context.Context, _ *ptypes.Empty) (*pb.Eth1
 	// Let deposit_root be the deposit root of the eth1.0 deposit contract in the
 	// post-state of the block referenced by block_hash.
 	if len(dataVotes) == 0 {
-		return bs.defaultDataResponse(ctx, currentHeight, eth1FollowDistance)
+		return defaultEth1Data(ctx, beaconDB, powChainService, currentHeight, eth1FollowDistance)
 	}
 
-	return &pb.Eth1DataResponse{
-		Eth1Data: &pbp2p.Eth1Data{
-			BlockHash32:       bestVote.Eth1Data.BlockHash32,
-			DepositRootHash32: bestVote.Eth1Data.DepositRootHash32,
-		},
+	return &pbp2p.Eth1Data{
+		BlockHash32:       bestVote.Eth1Data.BlockHash32,
+		DepositRootHash32: bestVote.Eth1Data.DepositRootHash32,
 	}, nil
 }
 
 // PendingDeposits returns a list of pending deposits that are ready for
 // inclusion in the next beacon block.
 func (bs *BeaconServer) PendingDeposits(ctx context.Context, _ *ptypes.Empty) (*pb.PendingDepositsResponse, error) {
-	bNum := bs.powChainService.LatestBlockHeight()
+	if bs.powChainService.LatestBlockHeight() == nil {
+		return nil, errors.New("latest PoW block number is unknown")
+	}
+	beaconState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
+	}
+	deposits, err := pendingDeposits(ctx, bs.beaconDB, bs.powChainService, beaconState)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PendingDepositsResponse{PendingDeposits: deposits}, nil
+}
+
+// pendingDeposits returns the deposits, along with their Merkle proofs, which are
+// ready for inclusion in a block built on top of the given state.
+func pendingDeposits(
+	ctx context.Context,
+	beaconDB db.Database,
+	powChainService powChainService,
+	beaconState *pbp2p.BeaconState,
+) ([]*pbp2p.Deposit, error) {
+	bNum := powChainService.LatestBlockHeight()
 	if bNum == nil {
 		return nil, errors.New("latest PoW block number is unknown")
 	}
 	// Only request deposits that have passed the ETH1 follow distance window.
-	bNum = bNum.Sub(bNum, big.NewInt(int64(params.BeaconConfig().Eth1FollowDistance)))
-	allDeps := bs.beaconDB.AllDeposits(ctx, bNum)
+	bNum = big.NewInt(0).Sub(bNum, big.NewInt(int64(params.BeaconConfig().Eth1FollowDistance)))
+	allDeps := beaconDB.AllDeposits(ctx, bNum)
 	if len(allDeps) == 0 {
-		return &pb.PendingDepositsResponse{PendingDeposits: nil}, nil
+		return nil, nil
 	}
 
 	// Need to fetch if the deposits up to the state's latest eth 1 data matches
 	// the number of all deposits in this RPC call. If not, then we return nil.
-	beaconState, err := bs.beaconDB.HeadState(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
-	}
 	h := bytesutil.ToBytes32(beaconState.LatestEth1Data.BlockHash32)
-	_, latestEth1DataHeight, err := bs.powChainService.BlockExists(ctx, h)
+	_, latestEth1DataHeight, err := powChainService.BlockExists(ctx, h)
 	if err != nil {
 		return nil, fmt.Errorf("could not fetch eth1data height: %v", err)
 	}
@@ -229,9 +278,9 @@ func (bs *BeaconServer) PendingDeposits(ctx context.Context, _ *ptypes.Empty) (*
 	// If this doesn't match the number of deposits stored in the cache, the generated trie will not be the same and
 	// root will fail to verify. This can happen in a scenario where we perhaps have a deposit from height 101,
 	// so we want to avoid any possible mismatches in these lengths.
-	upToLatestEth1DataDeposits := bs.beaconDB.AllDeposits(ctx, latestEth1DataHeight)
+	upToLatestEth1DataDeposits := beaconDB.AllDeposits(ctx, latestEth1DataHeight)
 	if len(upToLatestEth1DataDeposits) != len(allDeps) {
-		return &pb.PendingDepositsResponse{PendingDeposits: nil}, nil
+		return nil, nil
 	}
 	depositData := [][]byte{}
 	for i := range upToLatestEth1DataDeposits {
@@ -243,7 +292,7 @@ func (bs *BeaconServer) PendingDeposits(ctx context.Context, _ *ptypes.Empty) (*
 		return nil, fmt.Errorf("could not generate historical deposit trie from deposits: %v", err)
 	}
 
-	allPendingDeps := bs.beaconDB.PendingDeposits(ctx, bNum)
+	allPendingDeps := beaconDB.PendingDeposits(ctx, bNum)
 
 	// Deposits need to be received in order of merkle index root, so this has to make sure
 	// deposits are sorted from lowest to highest.
@@ -265,25 +314,31 @@ func (bs *BeaconServer) PendingDeposits(ctx context.Context, _ *ptypes.Empty) (*
 		}
 	}
 	// Limit the return of pending deposits to not be more than max deposits allowed in block.
-	var pendingDeposits []*pbp2p.Deposit
+	var deposits []*pbp2p.Deposit
 	for i := 0; i < len(pendingDeps) && i < int(params.BeaconConfig().MaxDeposits); i++ {
-		pendingDeposits = append(pendingDeposits, pendingDeps[i])
+		deposits = append(deposits, pendingDeps[i])
 	}
-	return &pb.PendingDepositsResponse{PendingDeposits: pendingDeposits}, nil
+	return deposits, nil
 }
 
-func (bs *BeaconServer) defaultDataResponse(ctx context.Context, currentHeight *big.Int, eth1FollowDistance int64) (*pb.Eth1DataResponse, error) {
+func defaultEth1Data(
+	ctx context.Context,
+	beaconDB db.Database,
+	powChainService powChainService,
+	currentHeight *big.Int,
+	eth1FollowDistance int64,
+) (*pbp2p.Eth1Data, error) {
 	ancestorHeight := big.NewInt(0).Sub(currentHeight, big.NewInt(eth1FollowDistance))
-	blockHash, err := bs.powChainService.BlockHashByHeight(ctx, ancestorHeight)
+	blockHash, err := powChainService.BlockHashByHeight(ctx, ancestorHeight)
 	if err != nil {
 		return nil, fmt.Errorf("could not fetch ETH1_FOLLOW_DISTANCE ancestor: %v", err)
 	}
 	// Fetch all historical deposits up to an ancestor height.
-	allDeposits := bs.beaconDB.AllDeposits(ctx, ancestorHeight)
+	allDeposits := beaconDB.AllDeposits(ctx, ancestorHeight)
 	depositData := [][]byte{}
 	// If there are less than or equal to len(ChainStartDeposits) historical deposits, then we just fetch the default
 	// deposit root obtained from constructing the Merkle trie with the ChainStart deposits.
-	chainStartDeposits := bs.powChainService.ChainStartDeposits()
+	chainStartDeposits := powChainService.ChainStartDeposits()
 	if len(allDeposits) <= len(chainStartDeposits) {
 		depositData = chainStartDeposits
 	} else {
@@ -296,11 +351,9 @@ func (bs *BeaconServer) defaultDataResponse(ctx context.Context, currentHeight *
 		return nil, fmt.Errorf("could not generate historical deposit trie from deposits: %v", err)
 	}
 	depositRoot := depositTrie.Root()
-	return &pb.Eth1DataResponse{
-		Eth1Data: &pbp2p.Eth1Data{
-			DepositRootHash32: depositRoot[:],
-			BlockHash32:       blockHash[:],
-		},
+	return &pbp2p.Eth1Data{
+		DepositRootHash32: depositRoot[:],
+		BlockHash32:       blockHash[:],
 	}, nil
 }
 
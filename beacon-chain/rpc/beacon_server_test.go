@@ -734,3 +734,50 @@ func Benchmark_Eth1Data(b *testing.B) {
 		}
 	}
 }
+
+type mockSyncService struct {
+	err error
+}
+
+func (m *mockSyncService) Status() error {
+	return m.err
+}
+
+func TestSyncStatus(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	head := &pbp2p.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot + 5}
+	if err := db.SaveBlock(head); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(ctx, head, &pbp2p.BeaconState{Slot: head.Slot}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		syncErr error
+		syncing bool
+	}{
+		{name: "synced", syncErr: nil, syncing: false},
+		{name: "syncing", syncErr: errors.New("node is not synced"), syncing: true},
+	}
+	for _, tt := range tests {
+		beaconServer := &BeaconServer{
+			beaconDB:    db,
+			syncService: &mockSyncService{err: tt.syncErr},
+		}
+		resp, err := beaconServer.SyncStatus(ctx, &ptypes.Empty{})
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if resp.Syncing != tt.syncing {
+			t.Errorf("%s: expected syncing %t, received %t", tt.name, tt.syncing, resp.Syncing)
+		}
+		if resp.HeadSlot != head.Slot {
+			t.Errorf("%s: expected head slot %d, received %d", tt.name, head.Slot, resp.HeadSlot)
+		}
+	}
+}
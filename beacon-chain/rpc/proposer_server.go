@@ -2,24 +2,29 @@ package rpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bitutil"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
 )
 
 // ProposerServer defines a server implementation of the gRPC Proposer service,
 // providing RPC endpoints for computing state transitions and state roots, proposing
 // beacon blocks to a beacon node, and more.
 type ProposerServer struct {
-	beaconDB           *db.BeaconDB
+	beaconDB           db.Database
 	chainService       chainService
 	powChainService    powChainService
 	operationService   operationService
@@ -81,11 +86,6 @@ func (ps *ProposerServer) PendingAttestations(ctx context.Context, req *pb.Pendi
 	if err != nil {
 		return nil, fmt.Errorf("could not retrieve beacon state: %v", err)
 	}
-	atts, err := ps.operationService.PendingAttestations()
-	if err != nil {
-		return nil, fmt.Errorf("could not retrieve pending attestations from operations service: %v", err)
-	}
-
 	head, err := ps.beaconDB.ChainHead()
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve chain head: %v", err)
@@ -109,6 +109,28 @@ func (ps *ProposerServer) PendingAttestations(ctx context.Context, req *pb.Pendi
 	if currentSlot == 0 {
 		currentSlot = beaconState.Slot
 	}
+	atts, err := ps.attestationsForInclusion(beaconState, currentSlot, req.FilterReadyForInclusion)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PendingAttestationsResponse{
+		PendingAttestations: atts,
+	}, nil
+}
+
+// attestationsForInclusion returns the pending attestations which are still within their
+// validity window at the given slot and vote on the canonical chain with the justified epoch
+// expected by the given state. If readyForInclusion is set, only attestations satisfying
+// attestation.slot + MIN_ATTESTATION_INCLUSION_DELAY <= slot are returned.
+func (ps *ProposerServer) attestationsForInclusion(
+	beaconState *pbp2p.BeaconState,
+	currentSlot uint64,
+	readyForInclusion bool,
+) ([]*pbp2p.Attestation, error) {
+	atts, err := ps.operationService.PendingAttestations()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve pending attestations from operations service: %v", err)
+	}
 
 	// Remove any attestation from the list if their slot is before the start of
 	// the previous epoch or does not match the current state previous justified
@@ -134,20 +156,274 @@ func (ps *ProposerServer) PendingAttestations(ctx context.Context, req *pb.Pendi
 	}
 	atts = attsWithinBoundary
 
-	if req.FilterReadyForInclusion {
+	if readyForInclusion {
 		var attsReadyForInclusion []*pbp2p.Attestation
 		for _, val := range atts {
 			if val.Data.Slot+params.BeaconConfig().MinAttestationInclusionDelay <= currentSlot {
 				attsReadyForInclusion = append(attsReadyForInclusion, val)
 			}
 		}
-		return &pb.PendingAttestationsResponse{
-			PendingAttestations: attsReadyForInclusion,
-		}, nil
+		atts = attsReadyForInclusion
 	}
-	return &pb.PendingAttestationsResponse{
-		PendingAttestations: atts,
-	}, nil
+	return packAttestations(beaconState, atts)
+}
+
+// packAttestations selects up to MAX_ATTESTATIONS of the given attestations, preferring the
+// aggregates which include the most validators whose attestation for the same data is not yet
+// in the state nor in one of the aggregates already selected. Ties are broken in favor of the
+// attestation coming first, so attestations given in slot order keep the oldest ones first.
+// Attestations which add no such validator are left out.
+func packAttestations(beaconState *pbp2p.BeaconState, atts []*pbp2p.Attestation) ([]*pbp2p.Attestation, error) {
+	// Participants already covered for each attestation data, keyed by its hash.
+	covered := make(map[[32]byte][]byte)
+	for _, a := range beaconState.LatestAttestations {
+		h, err := hashutil.HashProto(a.Data)
+		if err != nil {
+			return nil, fmt.Errorf("could not hash attestation data: %v", err)
+		}
+		covered[h] = bitutil.Or(covered[h], a.AggregationBitfield)
+	}
+	keys := make([][32]byte, len(atts))
+	for i, a := range atts {
+		h, err := hashutil.HashProto(a.Data)
+		if err != nil {
+			return nil, fmt.Errorf("could not hash attestation data: %v", err)
+		}
+		keys[i] = h
+	}
+
+	remaining := make([]int, len(atts))
+	for i := range remaining {
+		remaining[i] = i
+	}
+	var packed []*pbp2p.Attestation
+	for len(remaining) > 0 && uint64(len(packed)) < params.BeaconConfig().MaxAttestations {
+		best, bestGain := -1, 0
+		for i, idx := range remaining {
+			gain := bitutil.BitSetCount(bitutil.AndNot(atts[idx].AggregationBitfield, covered[keys[idx]]))
+			if gain > bestGain {
+				best, bestGain = i, gain
+			}
+		}
+		// The remaining attestations only include participants which are already covered.
+		if best < 0 {
+			break
+		}
+		idx := remaining[best]
+		remaining = append(remaining[:best], remaining[best+1:]...)
+		covered[keys[idx]] = bitutil.Or(covered[keys[idx]], atts[idx].AggregationBitfield)
+		packed = append(packed, atts[idx])
+	}
+	return packed, nil
+}
+
+// PendingBlockOperations retrieves the proposer slashings, attester slashings and exits kept in the
+// beacon node's operations pool which can be included in a block at the requested slot on top of the
+// current head. Each operation is verified, signatures included, against the head state advanced to
+// that slot, and at most one of them is selected per slashed or exiting validator.
+// No more than MAX_PROPOSER_SLASHINGS, MAX_ATTESTER_SLASHINGS and MAX_VOLUNTARY_EXITS are returned.
+func (ps *ProposerServer) PendingBlockOperations(
+	ctx context.Context,
+	req *pb.PendingBlockOperationsRequest,
+) (*pb.PendingBlockOperationsResponse, error) {
+	beaconState, err := ps.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve beacon state: %v", err)
+	}
+	if beaconState.Slot < req.Slot {
+		head, err := ps.beaconDB.ChainHead()
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve chain head: %v", err)
+		}
+		blockRoot, err := hashutil.HashBeaconBlock(head)
+		if err != nil {
+			return nil, fmt.Errorf("could not hash beacon block: %v", err)
+		}
+		for beaconState.Slot < req.Slot {
+			beaconState, err = state.ExecuteStateTransition(
+				ctx, beaconState, nil /* block */, blockRoot, &state.TransitionConfig{},
+			)
+			if err != nil {
+				return nil, fmt.Errorf("could not execute head transition: %v", err)
+			}
+		}
+	}
+
+	return ps.blockOperations(beaconState)
+}
+
+// blockOperations selects the pending proposer slashings, attester slashings and exits which
+// can be included in a block processed on top of the given state.
+func (ps *ProposerServer) blockOperations(beaconState *pbp2p.BeaconState) (*pb.PendingBlockOperationsResponse, error) {
+	proposerSlashings, err := ps.operationService.PendingProposerSlashings()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve pending proposer slashings from operations service: %v", err)
+	}
+	attesterSlashings, err := ps.operationService.PendingAttesterSlashings()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve pending attester slashings from operations service: %v", err)
+	}
+	exits, err := ps.operationService.PendingExits()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve pending exits from operations service: %v", err)
+	}
+
+	// A validator slashed or exited by one selected operation is not acted upon by
+	// another, as that would at best waste room in the block.
+	slashed := make(map[uint64]bool)
+	res := &pb.PendingBlockOperationsResponse{}
+	for _, slashing := range proposerSlashings {
+		if uint64(len(res.ProposerSlashings)) == params.BeaconConfig().MaxProposerSlashings {
+			break
+		}
+		if slashed[slashing.ProposerIndex] {
+			continue
+		}
+		if err := blocks.VerifyProposerSlashing(beaconState, slashing); err != nil {
+			log.Debugf("Not including slashing of proposer %d: %v", slashing.ProposerIndex, err)
+			continue
+		}
+		slashed[slashing.ProposerIndex] = true
+		res.ProposerSlashings = append(res.ProposerSlashings, slashing)
+	}
+	for _, slashing := range attesterSlashings {
+		if uint64(len(res.AttesterSlashings)) == params.BeaconConfig().MaxAttesterSlashings {
+			break
+		}
+		var newlySlashed []uint64
+		for _, idx1 := range slashing.GetSlashableAttestation_1().GetValidatorIndices() {
+			for _, idx2 := range slashing.GetSlashableAttestation_2().GetValidatorIndices() {
+				if idx1 == idx2 && !slashed[idx1] {
+					newlySlashed = append(newlySlashed, idx1)
+				}
+			}
+		}
+		if len(newlySlashed) == 0 {
+			continue
+		}
+		if err := blocks.VerifyAttesterSlashing(beaconState, slashing); err != nil {
+			log.Debugf("Not including attester slashing: %v", err)
+			continue
+		}
+		for _, idx := range newlySlashed {
+			slashed[idx] = true
+		}
+		res.AttesterSlashings = append(res.AttesterSlashings, slashing)
+	}
+	exiting := make(map[uint64]bool)
+	for _, exit := range exits {
+		if uint64(len(res.VoluntaryExits)) == params.BeaconConfig().MaxVoluntaryExits {
+			break
+		}
+		if slashed[exit.ValidatorIndex] || exiting[exit.ValidatorIndex] {
+			continue
+		}
+		if err := blocks.VerifyExit(beaconState, exit); err != nil {
+			log.Debugf("Not including exit of validator %d: %v", exit.ValidatorIndex, err)
+			continue
+		}
+		exiting[exit.ValidatorIndex] = true
+		res.VoluntaryExits = append(res.VoluntaryExits, exit)
+	}
+	return res, nil
+}
+
+// ProduceBlock builds an unsigned block for the requested slot on top of the current head, carrying
+// the given randao reveal. The parent root, eth1 data vote, deposits and their proofs, attestations,
+// slashings, exits and the resulting state root are all computed from the same head state, so the
+// block is consistent even if the head moves while it is being built. The caller only has to sign it.
+func (ps *ProposerServer) ProduceBlock(ctx context.Context, req *pb.ProduceBlockRequest) (*pbp2p.BeaconBlock, error) {
+	head, err := ps.beaconDB.ChainHead()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve chain head: %v", err)
+	}
+	parentRoot, err := hashutil.HashBeaconBlock(head)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash chain head: %v", err)
+	}
+	beaconState, err := ps.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve beacon state: %v", err)
+	}
+	// The head block and state are read separately, make sure the state read
+	// belongs to the head block the new block builds on.
+	currentHead, err := ps.beaconDB.ChainHead()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve chain head: %v", err)
+	}
+	currentRoot, err := hashutil.HashBeaconBlock(currentHead)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash chain head: %v", err)
+	}
+	if currentRoot != parentRoot {
+		return nil, errors.New("chain head changed while producing block")
+	}
+	if req.Slot <= beaconState.Slot {
+		return nil, fmt.Errorf(
+			"cannot produce a block for slot %d, head state is already at slot %d",
+			req.Slot-params.BeaconConfig().GenesisSlot,
+			beaconState.Slot-params.BeaconConfig().GenesisSlot,
+		)
+	}
+
+	// Process any skipped slots up to the one preceding the block.
+	for beaconState.Slot < req.Slot-1 {
+		beaconState, err = state.ExecuteStateTransition(
+			ctx, beaconState, nil /* block */, parentRoot, state.DefaultConfig(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("could not execute head transition: %v", err)
+		}
+	}
+	// Block contents are checked against the state at the block's slot, before
+	// the block itself gets processed.
+	slotState := state.ProcessSlot(ctx, proto.Clone(beaconState).(*pbp2p.BeaconState), parentRoot)
+
+	eth1Data, err := eth1DataVote(ctx, ps.beaconDB, ps.powChainService, slotState)
+	if err != nil {
+		return nil, fmt.Errorf("could not determine eth1 data vote: %v", err)
+	}
+	deposits, err := pendingDeposits(ctx, ps.beaconDB, ps.powChainService, slotState)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve pending deposits: %v", err)
+	}
+	atts, err := ps.attestationsForInclusion(slotState, req.Slot, true /* readyForInclusion */)
+	if err != nil {
+		return nil, err
+	}
+	ops, err := ps.blockOperations(slotState)
+	if err != nil {
+		return nil, err
+	}
+
+	block := &pbp2p.BeaconBlock{
+		Slot:             req.Slot,
+		ParentRootHash32: parentRoot[:],
+		RandaoReveal:     req.RandaoReveal,
+		Eth1Data:         eth1Data,
+		Body: &pbp2p.BeaconBlockBody{
+			Attestations:      atts,
+			ProposerSlashings: ops.ProposerSlashings,
+			AttesterSlashings: ops.AttesterSlashings,
+			Deposits:          deposits,
+			VoluntaryExits:    ops.VoluntaryExits,
+		},
+	}
+	if !featureconfig.FeatureConfig().EnableComputeStateRoot {
+		log.Debug("Compute state root disabled, producing block with no-op state root")
+		block.StateRootHash32 = []byte("no-op")
+		return block, nil
+	}
+	block.StateRootHash32, err = computeStateRoot(ctx, beaconState, block)
+	if err != nil {
+		return nil, fmt.Errorf("could not compute state root: %v", err)
+	}
+	log.WithFields(logrus.Fields{
+		"slot":            req.Slot - params.BeaconConfig().GenesisSlot,
+		"numAttestations": len(atts),
+		"numDeposits":     len(deposits),
+	}).Debug("Produced block")
+	return block, nil
 }
 
 // ComputeStateRoot computes the state root after a block has been processed through a state transition and
@@ -162,7 +438,20 @@ func (ps *ProposerServer) ComputeStateRoot(ctx context.Context, req *pbp2p.Beaco
 	if err != nil {
 		return nil, fmt.Errorf("could not get beacon state: %v", err)
 	}
+	stateRoot, err := computeStateRoot(ctx, beaconState, req)
+	if err != nil {
+		return nil, err
+	}
+	log.WithField("beaconStateHash", fmt.Sprintf("%#x", stateRoot)).Debugf("Computed state hash")
+	return &pb.StateRootResponse{
+		StateRoot: stateRoot,
+	}, nil
+}
 
+// computeStateRoot returns the root of the state resulting from processing the block, along
+// with any slots skipped before it, on top of the given state.
+func computeStateRoot(ctx context.Context, beaconState *pbp2p.BeaconState, req *pbp2p.BeaconBlock) ([]byte, error) {
+	var err error
 	parentHash := bytesutil.ToBytes32(req.ParentRootHash32)
 	// Check for skipped slots.
 	for beaconState.Slot < req.Slot-1 {
@@ -192,8 +481,5 @@ func (ps *ProposerServer) ComputeStateRoot(ctx context.Context, req *pbp2p.Beaco
 	if err != nil {
 		return nil, fmt.Errorf("could not tree hash beacon state: %v", err)
 	}
-	log.WithField("beaconStateHash", fmt.Sprintf("%#x", beaconStateHash)).Debugf("Computed state hash")
-	return &pb.StateRootResponse{
-		StateRoot: beaconStateHash[:],
-	}, nil
+	return beaconStateHash[:], nil
 }
@@ -1,18 +1,26 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
+	"math/big"
+	"reflect"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
@@ -146,9 +154,12 @@ func TestPendingAttestations_FiltersWithinInclusionDelay(t *testing.T) {
 	proposerServer := &ProposerServer{
 		operationService: &mockOperationService{
 			pendingAttestations: []*pbp2p.Attestation{
-				{Data: &pbp2p.AttestationData{
-					Slot: beaconState.Slot - params.BeaconConfig().MinAttestationInclusionDelay,
-				}},
+				{
+					Data: &pbp2p.AttestationData{
+						Slot: beaconState.Slot - params.BeaconConfig().MinAttestationInclusionDelay,
+					},
+					AggregationBitfield: []byte{128},
+				},
 			},
 		},
 		chainService: &mockChainService{},
@@ -197,17 +208,17 @@ func TestPendingAttestations_FiltersExpiredAttestations(t *testing.T) {
 	opService := &mockOperationService{
 		pendingAttestations: []*pbp2p.Attestation{
 			// Expired attestations
-			{Data: &pbp2p.AttestationData{Slot: 0, JustifiedEpoch: expectedEpoch}},
-			{Data: &pbp2p.AttestationData{Slot: currentSlot - 10000, JustifiedEpoch: expectedEpoch}},
-			{Data: &pbp2p.AttestationData{Slot: currentSlot - 5000, JustifiedEpoch: expectedEpoch}},
-			{Data: &pbp2p.AttestationData{Slot: currentSlot - 100, JustifiedEpoch: expectedEpoch}},
-			{Data: &pbp2p.AttestationData{Slot: currentSlot - params.BeaconConfig().SlotsPerEpoch, JustifiedEpoch: expectedEpoch}},
+			{Data: &pbp2p.AttestationData{Slot: 0, JustifiedEpoch: expectedEpoch}, AggregationBitfield: []byte{128}},
+			{Data: &pbp2p.AttestationData{Slot: currentSlot - 10000, JustifiedEpoch: expectedEpoch}, AggregationBitfield: []byte{128}},
+			{Data: &pbp2p.AttestationData{Slot: currentSlot - 5000, JustifiedEpoch: expectedEpoch}, AggregationBitfield: []byte{128}},
+			{Data: &pbp2p.AttestationData{Slot: currentSlot - 100, JustifiedEpoch: expectedEpoch}, AggregationBitfield: []byte{128}},
+			{Data: &pbp2p.AttestationData{Slot: currentSlot - params.BeaconConfig().SlotsPerEpoch, JustifiedEpoch: expectedEpoch}, AggregationBitfield: []byte{128}},
 			// Non-expired attestation with incorrect justified epoch
-			{Data: &pbp2p.AttestationData{Slot: currentSlot - 5, JustifiedEpoch: expectedEpoch - 1}},
+			{Data: &pbp2p.AttestationData{Slot: currentSlot - 5, JustifiedEpoch: expectedEpoch - 1}, AggregationBitfield: []byte{128}},
 			// Non-expired attestations with correct justified epoch
-			{Data: &pbp2p.AttestationData{Slot: currentSlot - 5, JustifiedEpoch: expectedEpoch}},
-			{Data: &pbp2p.AttestationData{Slot: currentSlot - 2, JustifiedEpoch: expectedEpoch}},
-			{Data: &pbp2p.AttestationData{Slot: currentSlot, JustifiedEpoch: expectedEpoch}},
+			{Data: &pbp2p.AttestationData{Slot: currentSlot - 5, JustifiedEpoch: expectedEpoch}, AggregationBitfield: []byte{128}},
+			{Data: &pbp2p.AttestationData{Slot: currentSlot - 2, JustifiedEpoch: expectedEpoch}, AggregationBitfield: []byte{128}},
+			{Data: &pbp2p.AttestationData{Slot: currentSlot, JustifiedEpoch: expectedEpoch}, AggregationBitfield: []byte{128}},
 		},
 	}
 	expectedNumberOfAttestations := 3
@@ -309,13 +320,13 @@ func TestPendingAttestations_FiltersCanonicalAttestations(t *testing.T) {
 	opService := &mockOperationService{
 		pendingAttestations: []*pbp2p.Attestation{
 			// Canonical attestations
-			{Data: &pbp2p.AttestationData{Slot: currentSlot - 5, JustifiedEpoch: expectedEpoch, BeaconBlockRootHash32: []byte{'A'}}},
-			{Data: &pbp2p.AttestationData{Slot: currentSlot - 2, JustifiedEpoch: expectedEpoch, BeaconBlockRootHash32: []byte{'B'}}},
+			{Data: &pbp2p.AttestationData{Slot: currentSlot - 5, JustifiedEpoch: expectedEpoch, BeaconBlockRootHash32: []byte{'A'}}, AggregationBitfield: []byte{128}},
+			{Data: &pbp2p.AttestationData{Slot: currentSlot - 2, JustifiedEpoch: expectedEpoch, BeaconBlockRootHash32: []byte{'B'}}, AggregationBitfield: []byte{128}},
 			// Non canonical attestations
-			{Data: &pbp2p.AttestationData{Slot: currentSlot, JustifiedEpoch: expectedEpoch, BeaconBlockRootHash32: []byte{'C'}}},
-			{Data: &pbp2p.AttestationData{Slot: currentSlot, JustifiedEpoch: expectedEpoch, BeaconBlockRootHash32: []byte{'D'}}},
+			{Data: &pbp2p.AttestationData{Slot: currentSlot, JustifiedEpoch: expectedEpoch, BeaconBlockRootHash32: []byte{'C'}}, AggregationBitfield: []byte{128}},
+			{Data: &pbp2p.AttestationData{Slot: currentSlot, JustifiedEpoch: expectedEpoch, BeaconBlockRootHash32: []byte{'D'}}, AggregationBitfield: []byte{128}},
 			// Canonical attestation
-			{Data: &pbp2p.AttestationData{Slot: currentSlot, JustifiedEpoch: expectedEpoch, BeaconBlockRootHash32: []byte{'E'}}},
+			{Data: &pbp2p.AttestationData{Slot: currentSlot, JustifiedEpoch: expectedEpoch, BeaconBlockRootHash32: []byte{'E'}}, AggregationBitfield: []byte{128}},
 		},
 	}
 	expectedNumberOfAttestations := 3
@@ -365,3 +376,282 @@ func TestPendingAttestations_FiltersCanonicalAttestations(t *testing.T) {
 		)
 	}
 }
+
+func signProposalData(
+	t *testing.T, beaconState *pbp2p.BeaconState, priv *bls.SecretKey, data *pbp2p.ProposalSignedData,
+) []byte {
+	root, err := hashutil.HashProto(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := forkutil.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(data.Slot), params.BeaconConfig().DomainProposal)
+	return priv.Sign(root[:], domain).Marshal()
+}
+
+// signSlashableAttestation signs the attestation data with custody bit 0 for every
+// private key, as required in phase 0.
+func signSlashableAttestation(
+	t *testing.T, beaconState *pbp2p.BeaconState, privKeys []*bls.SecretKey, data *pbp2p.AttestationData,
+) []byte {
+	var sigs []*bls.Signature
+	for _, priv := range privKeys {
+		root, err := hashutil.HashAttestationDataAndCustodyBit(data, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		domain := forkutil.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(data.Slot), params.BeaconConfig().DomainAttestation)
+		sigs = append(sigs, priv.Sign(root[:], domain))
+	}
+	return bls.AggregateSignatures(sigs).Marshal()
+}
+
+func TestPendingBlockOperations_FiltersInvalidOperations(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	beaconState, privKeys := setupExitState(t, db, 8)
+
+	proposalData := &pbp2p.ProposalSignedData{
+		Slot:            params.BeaconConfig().GenesisSlot,
+		Shard:           params.BeaconConfig().BeaconChainShardNumber,
+		BlockRootHash32: []byte{'A'},
+	}
+	otherProposalData := proto.Clone(proposalData).(*pbp2p.ProposalSignedData)
+	otherProposalData.BlockRootHash32 = []byte{'C'}
+	proposerSlashing := &pbp2p.ProposerSlashing{
+		ProposerIndex:       5,
+		ProposalData_1:      proposalData,
+		ProposalSignature_1: signProposalData(t, beaconState, privKeys[5], proposalData),
+		ProposalData_2:      otherProposalData,
+		ProposalSignature_2: signProposalData(t, beaconState, privKeys[5], otherProposalData),
+	}
+	// The proposer is already slashed by the first slashing.
+	laterProposalData := &pbp2p.ProposalSignedData{
+		Slot:            params.BeaconConfig().GenesisSlot + 1,
+		Shard:           params.BeaconConfig().BeaconChainShardNumber,
+		BlockRootHash32: []byte{'B'},
+	}
+	otherLaterProposalData := proto.Clone(laterProposalData).(*pbp2p.ProposalSignedData)
+	otherLaterProposalData.BlockRootHash32 = []byte{'D'}
+	repeatedProposerSlashing := &pbp2p.ProposerSlashing{
+		ProposerIndex:       5,
+		ProposalData_1:      laterProposalData,
+		ProposalSignature_1: signProposalData(t, beaconState, privKeys[5], laterProposalData),
+		ProposalData_2:      otherLaterProposalData,
+		ProposalSignature_2: signProposalData(t, beaconState, privKeys[5], otherLaterProposalData),
+	}
+
+	data1 := &pbp2p.AttestationData{
+		Slot:           params.BeaconConfig().GenesisSlot,
+		JustifiedEpoch: params.BeaconConfig().GenesisEpoch,
+		Shard:          1,
+	}
+	data2 := &pbp2p.AttestationData{
+		Slot:           params.BeaconConfig().GenesisSlot,
+		JustifiedEpoch: params.BeaconConfig().GenesisEpoch,
+		Shard:          2,
+	}
+	attesterSlashing := &pbp2p.AttesterSlashing{
+		SlashableAttestation_1: &pbp2p.SlashableAttestation{
+			Data:               data1,
+			ValidatorIndices:   []uint64{2, 3},
+			CustodyBitfield:    []byte{0x00},
+			AggregateSignature: signSlashableAttestation(t, beaconState, privKeys[2:4], data1),
+		},
+		SlashableAttestation_2: &pbp2p.SlashableAttestation{
+			Data:               data2,
+			ValidatorIndices:   []uint64{2, 3},
+			CustodyBitfield:    []byte{0x00},
+			AggregateSignature: signSlashableAttestation(t, beaconState, privKeys[2:4], data2),
+		},
+	}
+	badAttesterSlashing := proto.Clone(attesterSlashing).(*pbp2p.AttesterSlashing)
+	badAttesterSlashing.SlashableAttestation_2.AggregateSignature = attesterSlashing.SlashableAttestation_1.AggregateSignature
+
+	valid := &pbp2p.VoluntaryExit{Epoch: params.BeaconConfig().GenesisEpoch, ValidatorIndex: 1}
+	signExit(t, beaconState, privKeys[1], valid)
+	// A second exit for the same validator would make the block invalid.
+	duplicate := &pbp2p.VoluntaryExit{Epoch: params.BeaconConfig().GenesisEpoch, ValidatorIndex: 1}
+	signExit(t, beaconState, privKeys[1], duplicate)
+	badSignature := &pbp2p.VoluntaryExit{Epoch: params.BeaconConfig().GenesisEpoch, ValidatorIndex: 4}
+	signExit(t, beaconState, privKeys[6], badSignature)
+	futureEpoch := &pbp2p.VoluntaryExit{Epoch: params.BeaconConfig().GenesisEpoch + 1, ValidatorIndex: 6}
+	signExit(t, beaconState, privKeys[6], futureEpoch)
+	// Slashed validators are exited by their slashing.
+	slashedProposer := &pbp2p.VoluntaryExit{Epoch: params.BeaconConfig().GenesisEpoch, ValidatorIndex: 5}
+	signExit(t, beaconState, privKeys[5], slashedProposer)
+	slashedAttester := &pbp2p.VoluntaryExit{Epoch: params.BeaconConfig().GenesisEpoch, ValidatorIndex: 3}
+	signExit(t, beaconState, privKeys[3], slashedAttester)
+
+	proposerServer := &ProposerServer{
+		beaconDB: db,
+		operationService: &mockOperationService{
+			pendingProposerSlashings: []*pbp2p.ProposerSlashing{proposerSlashing, repeatedProposerSlashing},
+			pendingAttesterSlashings: []*pbp2p.AttesterSlashing{badAttesterSlashing, attesterSlashing},
+			pendingExits: []*pbp2p.VoluntaryExit{
+				valid, duplicate, badSignature, futureEpoch, slashedProposer, slashedAttester,
+			},
+		},
+	}
+	res, err := proposerServer.PendingBlockOperations(context.Background(), &pb.PendingBlockOperationsRequest{
+		Slot: beaconState.Slot + 1,
+	})
+	if err != nil {
+		t.Fatalf("Could not get pending block operations: %v", err)
+	}
+	if len(res.ProposerSlashings) != 1 || res.ProposerSlashings[0] != proposerSlashing {
+		t.Errorf("Expected only the first proposer slashing, received %v", res.ProposerSlashings)
+	}
+	if len(res.AttesterSlashings) != 1 || res.AttesterSlashings[0] != attesterSlashing {
+		t.Errorf("Expected only the valid attester slashing, received %v", res.AttesterSlashings)
+	}
+	if len(res.VoluntaryExits) != 1 || res.VoluntaryExits[0] != valid {
+		t.Errorf("Expected only the valid exit, received %v", res.VoluntaryExits)
+	}
+}
+
+func TestPendingBlockOperations_CapsExits(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	validators := int(params.BeaconConfig().MaxVoluntaryExits) + 1
+	beaconState, privKeys := setupExitState(t, db, validators)
+
+	exits := make([]*pbp2p.VoluntaryExit, validators)
+	for i := range exits {
+		exits[i] = &pbp2p.VoluntaryExit{Epoch: params.BeaconConfig().GenesisEpoch, ValidatorIndex: uint64(i)}
+		signExit(t, beaconState, privKeys[i], exits[i])
+	}
+	proposerServer := &ProposerServer{
+		beaconDB:         db,
+		operationService: &mockOperationService{pendingExits: exits},
+	}
+	res, err := proposerServer.PendingBlockOperations(context.Background(), &pb.PendingBlockOperationsRequest{})
+	if err != nil {
+		t.Fatalf("Could not get pending block operations: %v", err)
+	}
+	if uint64(len(res.VoluntaryExits)) != params.BeaconConfig().MaxVoluntaryExits {
+		t.Errorf("Expected %d exits, received %d", params.BeaconConfig().MaxVoluntaryExits, len(res.VoluntaryExits))
+	}
+}
+
+func TestProduceBlock_OK(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+	// Every slot needs a non-empty committee to select the block proposer.
+	beaconState, privKeys := setupExitState(t, db, int(params.BeaconConfig().SlotsPerEpoch))
+	head, err := db.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	beaconState.LatestEth1Data = &pbp2p.Eth1Data{BlockHash32: []byte("eth1-genesis")}
+	vote := &pbp2p.Eth1Data{
+		BlockHash32:       []byte("eth1-block"),
+		DepositRootHash32: []byte("deposit-root"),
+	}
+	beaconState.Eth1DataVotes = []*pbp2p.Eth1DataVote{{Eth1Data: vote, VoteCount: 1}}
+	if err := db.UpdateChainHead(ctx, head, beaconState); err != nil {
+		t.Fatal(err)
+	}
+
+	exit := &pbp2p.VoluntaryExit{Epoch: params.BeaconConfig().GenesisEpoch, ValidatorIndex: 3}
+	signExit(t, beaconState, privKeys[3], exit)
+
+	followDistance := params.BeaconConfig().Eth1FollowDistance
+	proposerServer := &ProposerServer{
+		beaconDB:     db,
+		chainService: &mockChainService{},
+		powChainService: &mockPOWChainService{
+			latestBlockNumber: big.NewInt(int64(followDistance) + 2),
+			hashesByHeight: map[int][]byte{
+				1: []byte("eth1-genesis"),
+				2: []byte("eth1-block"),
+			},
+		},
+		operationService: &mockOperationService{
+			pendingExits: []*pbp2p.VoluntaryExit{exit},
+		},
+	}
+	headRoot, err := hashutil.HashBeaconBlock(head)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := &pb.ProduceBlockRequest{
+		Slot:         beaconState.Slot + 2,
+		RandaoReveal: []byte("randao"),
+	}
+	block, err := proposerServer.ProduceBlock(ctx, req)
+	if err != nil {
+		t.Fatalf("Could not produce block: %v", err)
+	}
+	if block.Slot != req.Slot {
+		t.Errorf("Expected block slot %d, received %d", req.Slot, block.Slot)
+	}
+	if !bytes.Equal(block.ParentRootHash32, headRoot[:]) {
+		t.Errorf("Expected parent root %#x, received %#x", headRoot, block.ParentRootHash32)
+	}
+	if !bytes.Equal(block.RandaoReveal, req.RandaoReveal) {
+		t.Errorf("Expected randao reveal %#x, received %#x", req.RandaoReveal, block.RandaoReveal)
+	}
+	if !proto.Equal(block.Eth1Data, vote) {
+		t.Errorf("Expected eth1 data vote %v, received %v", vote, block.Eth1Data)
+	}
+	if len(block.Body.VoluntaryExits) != 1 || !proto.Equal(block.Body.VoluntaryExits[0], exit) {
+		t.Errorf("Expected pending exit to be included, received %v", block.Body.VoluntaryExits)
+	}
+	if len(block.StateRootHash32) != 32 {
+		t.Errorf("Expected a computed state root, received %#x", block.StateRootHash32)
+	}
+}
+
+func TestProduceBlock_RejectsPastSlot(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	beaconState, _ := setupExitState(t, db, 8)
+
+	proposerServer := &ProposerServer{beaconDB: db}
+	want := "head state is already at slot"
+	if _, err := proposerServer.ProduceBlock(context.Background(), &pb.ProduceBlockRequest{
+		Slot: beaconState.Slot,
+	}); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error containing %q, received %v", want, err)
+	}
+}
+
+func TestPackAttestations_PrefersMostNewParticipants(t *testing.T) {
+	dataA := &pbp2p.AttestationData{Slot: params.BeaconConfig().GenesisSlot + 1, Shard: 1}
+	dataB := &pbp2p.AttestationData{Slot: params.BeaconConfig().GenesisSlot + 1, Shard: 2}
+	beaconState := &pbp2p.BeaconState{
+		LatestAttestations: []*pbp2p.PendingAttestation{
+			{Data: dataA, AggregationBitfield: []byte{128}},
+		},
+	}
+	atts := []*pbp2p.Attestation{
+		{Data: dataA, AggregationBitfield: []byte{192}}, // 1 new participant.
+		{Data: dataB, AggregationBitfield: []byte{128}}, // 1 new participant.
+		{Data: dataA, AggregationBitfield: []byte{56}},  // 3 new participants.
+		{Data: dataA, AggregationBitfield: []byte{48}},  // Covered by the previous one, so left out.
+	}
+
+	packed, err := packAttestations(beaconState, atts)
+	if err != nil {
+		t.Fatalf("Could not pack attestations: %v", err)
+	}
+	want := []*pbp2p.Attestation{atts[2], atts[0], atts[1]}
+	if !reflect.DeepEqual(packed, want) {
+		t.Errorf("Wanted packed attestations %v, received %v", want, packed)
+	}
+
+	prevConfig := params.BeaconConfig()
+	cfg := *prevConfig
+	cfg.MaxAttestations = 2
+	params.OverrideBeaconConfig(&cfg)
+	defer params.OverrideBeaconConfig(prevConfig)
+	packed, err = packAttestations(beaconState, atts)
+	if err != nil {
+		t.Fatalf("Could not pack attestations: %v", err)
+	}
+	if !reflect.DeepEqual(packed, want[:2]) {
+		t.Errorf("Wanted packed attestations %v, received %v", want[:2], packed)
+	}
+}
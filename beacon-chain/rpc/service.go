@@ -41,9 +41,17 @@ type chainService interface {
 type operationService interface {
 	PendingAttestations() ([]*pbp2p.Attestation, error)
 	HandleAttestations(context.Context, proto.Message) error
+	HandleValidatorExits(context.Context, proto.Message) error
+	PendingExits() ([]*pbp2p.VoluntaryExit, error)
+	PendingProposerSlashings() ([]*pbp2p.ProposerSlashing, error)
+	PendingAttesterSlashings() ([]*pbp2p.AttesterSlashing, error)
 	IncomingAttFeed() *event.Feed
 }
 
+type syncService interface {
+	Status() error
+}
+
 type powChainService interface {
 	HasChainStartLogOccurred() (bool, uint64, error)
 	ChainStartFeed() *event.Feed
@@ -59,10 +67,11 @@ type powChainService interface {
 type Service struct {
 	ctx                 context.Context
 	cancel              context.CancelFunc
-	beaconDB            *db.BeaconDB
+	beaconDB            db.Database
 	chainService        chainService
 	powChainService     powChainService
 	operationService    operationService
+	syncService         syncService
 	port                string
 	listener            net.Listener
 	withCert            string
@@ -78,10 +87,11 @@ type Config struct {
 	Port             string
 	CertFlag         string
 	KeyFlag          string
-	BeaconDB         *db.BeaconDB
+	BeaconDB         db.Database
 	ChainService     chainService
 	POWChainService  powChainService
 	OperationService operationService
+	SyncService      syncService
 }
 
 // NewRPCService creates a new instance of a struct implementing the BeaconServiceServer
@@ -95,6 +105,7 @@ func NewRPCService(ctx context.Context, cfg *Config) *Service {
 		chainService:        cfg.ChainService,
 		powChainService:     cfg.POWChainService,
 		operationService:    cfg.OperationService,
+		syncService:         cfg.SyncService,
 		port:                cfg.Port,
 		withCert:            cfg.CertFlag,
 		withKey:             cfg.KeyFlag,
@@ -144,6 +155,7 @@ func (s *Service) Start() {
 		powChainService:     s.powChainService,
 		chainService:        s.chainService,
 		operationService:    s.operationService,
+		syncService:         s.syncService,
 		incomingAttestation: s.incomingAttestation,
 		canonicalStateChan:  s.canonicalStateChan,
 		chainStartChan:      make(chan time.Time, 1),
@@ -163,6 +175,7 @@ func (s *Service) Start() {
 		ctx:                s.ctx,
 		beaconDB:           s.beaconDB,
 		chainService:       s.chainService,
+		operationService:   s.operationService,
 		canonicalStateChan: s.canonicalStateChan,
 	}
 	pb.RegisterBeaconServiceServer(s.grpcServer, beaconServer)
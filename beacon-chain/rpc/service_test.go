@@ -32,7 +32,11 @@ func (t *TestLogger) Errorf(format string, args ...interface{}) {
 }
 
 type mockOperationService struct {
-	pendingAttestations []*pb.Attestation
+	pendingAttestations      []*pb.Attestation
+	pendingExits             []*pb.VoluntaryExit
+	handledExits             []*pb.VoluntaryExit
+	pendingProposerSlashings []*pb.ProposerSlashing
+	pendingAttesterSlashings []*pb.AttesterSlashing
 }
 
 func (ms *mockOperationService) IncomingAttFeed() *event.Feed {
@@ -43,10 +47,35 @@ func (ms *mockOperationService) IncomingExitFeed() *event.Feed {
 	return new(event.Feed)
 }
 
+func (ms *mockOperationService) IncomingProposerSlashingFeed() *event.Feed {
+	return new(event.Feed)
+}
+
+func (ms *mockOperationService) IncomingAttesterSlashingFeed() *event.Feed {
+	return new(event.Feed)
+}
+
 func (ms *mockOperationService) HandleAttestations(_ context.Context, _ proto.Message) error {
 	return nil
 }
 
+func (ms *mockOperationService) HandleValidatorExits(_ context.Context, message proto.Message) error {
+	ms.handledExits = append(ms.handledExits, message.(*pb.VoluntaryExit))
+	return nil
+}
+
+func (ms *mockOperationService) PendingExits() ([]*pb.VoluntaryExit, error) {
+	return ms.pendingExits, nil
+}
+
+func (ms *mockOperationService) PendingProposerSlashings() ([]*pb.ProposerSlashing, error) {
+	return ms.pendingProposerSlashings, nil
+}
+
+func (ms *mockOperationService) PendingAttesterSlashings() ([]*pb.AttesterSlashing, error) {
+	return ms.pendingAttesterSlashings, nil
+}
+
 func (ms *mockOperationService) PendingAttestations() ([]*pb.Attestation, error) {
 	if ms.pendingAttestations != nil {
 		return ms.pendingAttestations, nil
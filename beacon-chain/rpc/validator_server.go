@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
@@ -21,8 +22,9 @@ import (
 // and more.
 type ValidatorServer struct {
 	ctx                context.Context
-	beaconDB           *db.BeaconDB
+	beaconDB           db.Database
 	chainService       chainService
+	operationService   operationService
 	canonicalStateChan chan *pbp2p.BeaconState
 }
 
@@ -107,6 +109,101 @@ func (vs *ValidatorServer) ValidatorPerformance(
 	}, nil
 }
 
+// ValidatorAttestations returns the attestations made by any of the given validators for a slot
+// at or after the requested one. Both the attestations included in the canonical chain and the
+// ones still waiting in the operations pool are considered. Validator clients use this to detect
+// another instance signing with the same keys before they start performing their duties.
+func (vs *ValidatorServer) ValidatorAttestations(
+	ctx context.Context, req *pb.ValidatorAttestationsRequest,
+) (*pb.ValidatorAttestationsResponse, error) {
+	beaconState, err := vs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve beacon state: %v", err)
+	}
+	requested := make(map[string]bool, len(req.PublicKeys))
+	for _, pubKey := range req.PublicKeys {
+		requested[string(pubKey)] = true
+	}
+	pubKeys := make(map[uint64][]byte)
+	for i, validator := range beaconState.ValidatorRegistry {
+		if requested[string(validator.Pubkey)] {
+			pubKeys[uint64(i)] = validator.Pubkey
+		}
+	}
+	resp := &pb.ValidatorAttestationsResponse{}
+	if len(pubKeys) == 0 {
+		return resp, nil
+	}
+
+	pooled, err := vs.beaconDB.Attestations()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve pending attestations: %v", err)
+	}
+	attestations := make([]*pbp2p.PendingAttestation, 0, len(beaconState.LatestAttestations)+len(pooled))
+	attestations = append(attestations, beaconState.LatestAttestations...)
+	for _, att := range pooled {
+		attestations = append(attestations, &pbp2p.PendingAttestation{
+			Data:                att.Data,
+			AggregationBitfield: att.AggregationBitfield,
+		})
+	}
+
+	// The same attestation may be both included in a block and still in the pool.
+	seen := make(map[[32]byte]map[uint64]bool)
+	for _, att := range attestations {
+		if att.Data.Slot < req.SinceSlot {
+			continue
+		}
+		participants, err := helpers.AttestationParticipants(beaconState, att.Data, att.AggregationBitfield)
+		if err != nil {
+			log.Debugf("Could not get participants of attestation at slot %d: %v",
+				att.Data.Slot-params.BeaconConfig().GenesisSlot, err)
+			continue
+		}
+		root, err := hashutil.HashProto(att.Data)
+		if err != nil {
+			return nil, fmt.Errorf("could not hash attestation data: %v", err)
+		}
+		for _, index := range participants {
+			pubKey, ok := pubKeys[index]
+			if !ok || seen[root][index] {
+				continue
+			}
+			if seen[root] == nil {
+				seen[root] = make(map[uint64]bool)
+			}
+			seen[root][index] = true
+			resp.Attestations = append(resp.Attestations, &pb.ValidatorAttestationsResponse_ValidatorAttestation{
+				PublicKey: pubKey,
+				Data:      att.Data,
+			})
+		}
+	}
+	return resp, nil
+}
+
+// ProposeExit is called by a validator wishing to voluntarily exit the validator set. The exit is
+// verified against the current head state before it is added to the operations pool, from which
+// it is broadcast to peers and later included in a block.
+func (vs *ValidatorServer) ProposeExit(ctx context.Context, exit *pbp2p.VoluntaryExit) (*pb.ProposeExitResponse, error) {
+	beaconState, err := vs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve beacon state: %v", err)
+	}
+	if err := blocks.VerifyExit(beaconState, exit); err != nil {
+		return nil, fmt.Errorf("invalid exit: %v", err)
+	}
+	h, err := hashutil.HashProto(exit)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash exit: %v", err)
+	}
+	if err := vs.operationService.HandleValidatorExits(ctx, exit); err != nil {
+		return nil, fmt.Errorf("could not save exit: %v", err)
+	}
+	log.WithField("validatorIndex", exit.ValidatorIndex).Info("Exit request received via RPC")
+	return &pb.ProposeExitResponse{ExitHash: h[:]}, nil
+}
+
 // CommitteeAssignment returns the committee assignment response from a given validator public key.
 // The committee assignment response contains the following fields for the current and previous epoch:
 //	1.) The list of validators in the committee.
@@ -1,7 +1,9 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
 	"encoding/binary"
 	"fmt"
 	"strconv"
@@ -14,9 +16,14 @@ import (
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bitutil"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
@@ -589,3 +596,172 @@ func TestWaitForActivation_ValidatorOriginallyExists(t *testing.T) {
 		t.Fatalf("Could not setup wait for activation stream: %v", err)
 	}
 }
+
+func TestValidatorAttestations_OK(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	genesis := b.NewGenesisBlock([]byte{})
+	if err := db.SaveBlock(genesis); err != nil {
+		t.Fatalf("Could not save genesis block: %v", err)
+	}
+	beaconState, err := genesisState(params.BeaconConfig().DepositsForChainStart)
+	if err != nil {
+		t.Fatalf("Could not setup genesis state: %v", err)
+	}
+	slot := params.BeaconConfig().GenesisSlot + 1
+	committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, slot, false /* registryChange */)
+	if err != nil {
+		t.Fatalf("Could not get crosslink committees: %v", err)
+	}
+	committee := committees[0].Committee
+	pubKey := beaconState.ValidatorRegistry[committee[0]].Pubkey
+	bitfield := bitutil.SetBitfield(0, (len(committee)+7)/8)
+
+	recent := &pbp2p.AttestationData{Slot: slot, Shard: committees[0].Shard}
+	other := &pbp2p.AttestationData{Slot: slot, Shard: committees[0].Shard, JustifiedEpoch: 1}
+	beaconState.LatestAttestations = []*pbp2p.PendingAttestation{
+		{Data: recent, AggregationBitfield: bitfield},
+	}
+	if err := db.UpdateChainHead(ctx, genesis, beaconState); err != nil {
+		t.Fatalf("Could not save genesis state: %v", err)
+	}
+	// The included attestation is also still in the pool, and must only be reported once.
+	if err := db.SaveAttestation(ctx, &pbp2p.Attestation{Data: recent, AggregationBitfield: bitfield}); err != nil {
+		t.Fatalf("Could not save attestation: %v", err)
+	}
+	if err := db.SaveAttestation(ctx, &pbp2p.Attestation{Data: other, AggregationBitfield: bitfield}); err != nil {
+		t.Fatalf("Could not save attestation: %v", err)
+	}
+
+	vs := &ValidatorServer{
+		beaconDB: db,
+	}
+	req := &pb.ValidatorAttestationsRequest{
+		PublicKeys: [][]byte{pubKey},
+		SinceSlot:  slot,
+	}
+	res, err := vs.ValidatorAttestations(ctx, req)
+	if err != nil {
+		t.Fatalf("Could not get validator attestations: %v", err)
+	}
+	if len(res.Attestations) != 2 {
+		t.Fatalf("Expected 2 attestations, received %d", len(res.Attestations))
+	}
+	for _, att := range res.Attestations {
+		if !bytes.Equal(att.PublicKey, pubKey) {
+			t.Errorf("Expected public key %#x, received %#x", pubKey, att.PublicKey)
+		}
+	}
+
+	// Attestations made before the requested slot are not reported.
+	req.SinceSlot = slot + 1
+	res, err = vs.ValidatorAttestations(ctx, req)
+	if err != nil {
+		t.Fatalf("Could not get validator attestations: %v", err)
+	}
+	if len(res.Attestations) != 0 {
+		t.Errorf("Expected no attestations, received %d", len(res.Attestations))
+	}
+}
+
+// setupExitState saves a genesis state as the chain head whose validators hold
+// the returned keys, so the exits they sign can be verified.
+func setupExitState(t *testing.T, beaconDB *db.BeaconDB, validators int) (*pbp2p.BeaconState, []*bls.SecretKey) {
+	privKeys := make([]*bls.SecretKey, validators)
+	deposits := make([]*pbp2p.Deposit, validators)
+	for i := 0; i < len(deposits); i++ {
+		priv, err := bls.RandKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		depositData, err := helpers.EncodeDepositData(
+			&pbp2p.DepositInput{Pubkey: priv.PublicKey().Marshal()},
+			params.BeaconConfig().MaxDepositAmount,
+			time.Now().Unix(),
+		)
+		if err != nil {
+			t.Fatalf("Could not encode deposit input: %v", err)
+		}
+		deposits[i] = &pbp2p.Deposit{DepositData: depositData}
+		privKeys[i] = priv
+	}
+	beaconState, err := state.GenesisBeaconState(deposits, 0, nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate genesis state: %v", err)
+	}
+	genesis := b.NewGenesisBlock([]byte{})
+	if err := beaconDB.SaveBlock(genesis); err != nil {
+		t.Fatalf("Could not save genesis block: %v", err)
+	}
+	if err := beaconDB.UpdateChainHead(context.Background(), genesis, beaconState); err != nil {
+		t.Fatalf("Could not save genesis state: %v", err)
+	}
+	return beaconState, privKeys
+}
+
+func signExit(t *testing.T, beaconState *pbp2p.BeaconState, priv *bls.SecretKey, exit *pbp2p.VoluntaryExit) {
+	exitMessage, err := hashutil.HashVoluntaryExit(exit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := forkutil.DomainVersion(beaconState.Fork, exit.Epoch, params.BeaconConfig().DomainExit)
+	exit.Signature = priv.Sign(exitMessage[:], domain).Marshal()
+}
+
+func TestProposeExit_OK(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	beaconState, privKeys := setupExitState(t, db, 8)
+
+	opService := &mockOperationService{}
+	vs := &ValidatorServer{
+		beaconDB:         db,
+		operationService: opService,
+	}
+	exit := &pbp2p.VoluntaryExit{
+		Epoch:          params.BeaconConfig().GenesisEpoch,
+		ValidatorIndex: 2,
+	}
+	signExit(t, beaconState, privKeys[2], exit)
+
+	res, err := vs.ProposeExit(context.Background(), exit)
+	if err != nil {
+		t.Fatalf("Could not propose exit: %v", err)
+	}
+	h, err := hashutil.HashProto(exit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(res.ExitHash, h[:]) {
+		t.Errorf("Expected exit hash %#x, received %#x", h, res.ExitHash)
+	}
+	if len(opService.handledExits) != 1 || opService.handledExits[0] != exit {
+		t.Error("Expected exit to be handed to the operations service")
+	}
+}
+
+func TestProposeExit_InvalidSignature(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	beaconState, privKeys := setupExitState(t, db, 8)
+
+	opService := &mockOperationService{}
+	vs := &ValidatorServer{
+		beaconDB:         db,
+		operationService: opService,
+	}
+	exit := &pbp2p.VoluntaryExit{
+		Epoch:          params.BeaconConfig().GenesisEpoch,
+		ValidatorIndex: 2,
+	}
+	signExit(t, beaconState, privKeys[3], exit)
+
+	if _, err := vs.ProposeExit(context.Background(), exit); err == nil || !strings.Contains(err.Error(), "invalid exit") {
+		t.Errorf("Expected invalid exit error, received %v", err)
+	}
+	if len(opService.handledExits) != 0 {
+		t.Error("Expected invalid exit not to reach the operations service")
+	}
+}
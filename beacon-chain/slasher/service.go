@@ -0,0 +1,304 @@
+// Package slasher defines a service which watches the attestations and blocks gossiped on the
+// network, and turns double votes, surround votes and double proposals into slashings.
+package slasher
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+var log = logrus.WithField("prefix", "slasher")
+
+// DefaultHistoryEpochs is the number of epochs of votes and proposals kept by the slasher.
+// Surround votes spanning more epochs than that are not detected.
+const DefaultHistoryEpochs = 4096
+
+type operationService interface {
+	HandleProposerSlashing(ctx context.Context, message proto.Message) error
+	HandleAttesterSlashing(ctx context.Context, message proto.Message) error
+}
+
+// Service represents a service that indexes the attestations and blocks received from
+// peers and reports the slashable offences it finds to the operations pool.
+type Service struct {
+	ctx             context.Context
+	cancel          context.CancelFunc
+	beaconDB        db.Database
+	p2p             p2p.Subscriber
+	opsService      operationService
+	historyEpochs   uint64
+	attestationBuf  chan p2p.Message
+	blockBuf        chan p2p.Message
+	lastPrunedEpoch uint64
+}
+
+// Config options for the service.
+type Config struct {
+	BeaconDB         db.Database
+	P2P              p2p.Subscriber
+	OperationService operationService
+	HistoryEpochs    uint64
+}
+
+// NewSlasherService instantiates a new service instance that will
+// be registered into a running beacon node.
+func NewSlasherService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	historyEpochs := cfg.HistoryEpochs
+	if historyEpochs == 0 {
+		historyEpochs = DefaultHistoryEpochs
+	}
+	return &Service{
+		ctx:            ctx,
+		cancel:         cancel,
+		beaconDB:       cfg.BeaconDB,
+		p2p:            cfg.P2P,
+		opsService:     cfg.OperationService,
+		historyEpochs:  historyEpochs,
+		attestationBuf: make(chan p2p.Message, params.BeaconConfig().DefaultBufferSize),
+		blockBuf:       make(chan p2p.Message, params.BeaconConfig().DefaultBufferSize),
+	}
+}
+
+// Start the slasher service's main event loop.
+func (s *Service) Start() {
+	log.Info("Starting service")
+	go s.run()
+}
+
+// Stop the slasher service's main event loop and associated goroutines.
+func (s *Service) Stop() error {
+	defer s.cancel()
+	log.Info("Stopping service")
+	return nil
+}
+
+// Status always returns nil.
+func (s *Service) Status() error {
+	return nil
+}
+
+func (s *Service) run() {
+	attestationSub := s.p2p.Subscribe(&pb.AttestationResponse{}, s.attestationBuf)
+	defer attestationSub.Unsubscribe()
+	blockSub := s.p2p.Subscribe(&pb.BeaconBlockResponse{}, s.blockBuf)
+	defer blockSub.Unsubscribe()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			log.Debug("Slasher context closed, exiting goroutine")
+			return
+		case msg := <-s.attestationBuf:
+			resp := msg.Data.(*pb.AttestationResponse)
+			if err := s.CheckAttestation(msg.Ctx, resp.Attestation); err != nil {
+				log.Errorf("Could not check attestation for slashable votes: %v", err)
+			}
+		case msg := <-s.blockBuf:
+			resp := msg.Data.(*pb.BeaconBlockResponse)
+			if err := s.CheckBlock(msg.Ctx, resp.Block); err != nil {
+				log.Errorf("Could not check block for slashable proposals: %v", err)
+			}
+		}
+	}
+}
+
+// CheckBlock records the proposal of a block, reporting a proposer slashing if the proposer
+// already proposed a different block at the same slot. The attestations included in the
+// block are checked as well, and the slasher history is pruned once per epoch. Blocks
+// whose proposer signature does not verify against the head state are rejected before
+// anything is recorded, so a forged proposal can neither hide nor fake an offence.
+func (s *Service) CheckBlock(ctx context.Context, block *pb.BeaconBlock) error {
+	ctx, span := trace.StartSpan(ctx, "slasher.CheckBlock")
+	defer span.End()
+
+	headState, err := s.beaconDB.HeadState(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	proposerIndex, err := helpers.BeaconProposerIndex(headState, block.Slot)
+	if err != nil {
+		return fmt.Errorf("could not get proposer index at slot %d: %v", block.Slot, err)
+	}
+	if err := blocks.VerifyProposalSignature(headState, proposerIndex, block); err != nil {
+		return fmt.Errorf("could not verify proposer signature: %v", err)
+	}
+	root, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return fmt.Errorf("could not hash block: %v", err)
+	}
+
+	record, err := s.beaconDB.ProposalRecord(block.Slot, proposerIndex)
+	if err != nil {
+		return fmt.Errorf("could not retrieve proposal record: %v", err)
+	}
+	if record == nil {
+		if err := s.beaconDB.SaveProposalRecord(ctx, block.Slot, proposerIndex, &db.ProposalRecord{
+			BlockRoot: root,
+			Signature: block.Signature,
+		}); err != nil {
+			return fmt.Errorf("could not save proposal record: %v", err)
+		}
+	} else if record.BlockRoot != root {
+		slashing := &pb.ProposerSlashing{
+			ProposerIndex: proposerIndex,
+			ProposalData_1: &pb.ProposalSignedData{
+				Slot:            block.Slot,
+				Shard:           params.BeaconConfig().BeaconChainShardNumber,
+				BlockRootHash32: record.BlockRoot[:],
+			},
+			ProposalSignature_1: record.Signature,
+			ProposalData_2: &pb.ProposalSignedData{
+				Slot:            block.Slot,
+				Shard:           params.BeaconConfig().BeaconChainShardNumber,
+				BlockRootHash32: root[:],
+			},
+			ProposalSignature_2: block.Signature,
+		}
+		log.WithFields(logrus.Fields{
+			"proposerIndex": proposerIndex,
+			"slot":          block.Slot - params.BeaconConfig().GenesisSlot,
+		}).Warn("Detected double proposal")
+		if err := s.opsService.HandleProposerSlashing(ctx, slashing); err != nil {
+			return fmt.Errorf("could not submit proposer slashing: %v", err)
+		}
+	}
+
+	for _, att := range block.GetBody().GetAttestations() {
+		if err := s.checkAttestation(ctx, headState, att); err != nil {
+			return err
+		}
+	}
+	return s.pruneHistory(helpers.SlotToEpoch(block.Slot))
+}
+
+// CheckAttestation records the votes of the attestation participants, reporting an attester
+// slashing for every recorded attestation the new one double votes or surround votes with.
+// Attestations whose aggregate signature does not verify against the head state are
+// rejected before any vote is recorded.
+func (s *Service) CheckAttestation(ctx context.Context, att *pb.Attestation) error {
+	ctx, span := trace.StartSpan(ctx, "slasher.CheckAttestation")
+	defer span.End()
+
+	headState, err := s.beaconDB.HeadState(ctx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	return s.checkAttestation(ctx, headState, att)
+}
+
+func (s *Service) checkAttestation(ctx context.Context, headState *pb.BeaconState, att *pb.Attestation) error {
+	if err := blocks.VerifyAttestationSignature(headState, att); err != nil {
+		return fmt.Errorf("could not verify attestation signature: %v", err)
+	}
+	participants, err := helpers.AttestationParticipants(headState, att.Data, att.AggregationBitfield)
+	if err != nil {
+		return fmt.Errorf("could not get attestation participants: %v", err)
+	}
+	sort.Slice(participants, func(i, j int) bool {
+		return participants[i] < participants[j]
+	})
+	slashable := &pb.SlashableAttestation{
+		ValidatorIndices:   participants,
+		CustodyBitfield:    make([]byte, (len(participants)+7)/8),
+		Data:               att.Data,
+		AggregateSignature: att.AggregateSignature,
+	}
+	dataHash, err := hashutil.HashProto(att.Data)
+	if err != nil {
+		return fmt.Errorf("could not hash attestation data: %v", err)
+	}
+	attHash, err := hashutil.HashProto(slashable)
+	if err != nil {
+		return fmt.Errorf("could not hash attestation: %v", err)
+	}
+	sourceEpoch := att.Data.JustifiedEpoch
+	targetEpoch := helpers.SlotToEpoch(att.Data.Slot)
+
+	// Slashings are keyed by the conflicting attestation so that a single slashing is built
+	// for all the participants the two attestations have in common.
+	conflicts := make(map[[32]byte]*pb.AttesterSlashing)
+	var order [][32]byte
+	for _, index := range participants {
+		votes, err := s.beaconDB.ValidatorVotes(index)
+		if err != nil {
+			return fmt.Errorf("could not retrieve votes of validator %d: %v", index, err)
+		}
+		for _, vote := range votes {
+			if _, ok := conflicts[vote.AttestationHash]; ok {
+				continue
+			}
+			doubleVote := vote.TargetEpoch == targetEpoch && vote.DataHash != dataHash
+			surrounded := vote.SourceEpoch < sourceEpoch && targetEpoch < vote.TargetEpoch
+			surrounding := sourceEpoch < vote.SourceEpoch && vote.TargetEpoch < targetEpoch
+			if !doubleVote && !surrounded && !surrounding {
+				continue
+			}
+			prior, err := s.beaconDB.SlashableAttestation(vote.TargetEpoch, vote.AttestationHash)
+			if err != nil {
+				return fmt.Errorf("could not retrieve attestation %#x: %v", vote.AttestationHash, err)
+			}
+			if prior == nil {
+				continue
+			}
+			slashing := &pb.AttesterSlashing{
+				SlashableAttestation_1: prior,
+				SlashableAttestation_2: slashable,
+			}
+			if surrounding {
+				slashing.SlashableAttestation_1, slashing.SlashableAttestation_2 = slashable, prior
+			}
+			log.WithFields(logrus.Fields{
+				"validatorIndex": index,
+				"doubleVote":     doubleVote,
+				"sourceEpoch":    sourceEpoch - params.BeaconConfig().GenesisEpoch,
+				"targetEpoch":    targetEpoch - params.BeaconConfig().GenesisEpoch,
+			}).Warn("Detected slashable attestation")
+			conflicts[vote.AttestationHash] = slashing
+			order = append(order, vote.AttestationHash)
+		}
+		if err := s.beaconDB.SaveValidatorVote(ctx, index, &db.ValidatorVote{
+			SourceEpoch:     sourceEpoch,
+			TargetEpoch:     targetEpoch,
+			DataHash:        dataHash,
+			AttestationHash: attHash,
+		}); err != nil {
+			return fmt.Errorf("could not save vote of validator %d: %v", index, err)
+		}
+	}
+	if err := s.beaconDB.SaveSlashableAttestation(ctx, targetEpoch, slashable); err != nil {
+		return fmt.Errorf("could not save attestation: %v", err)
+	}
+
+	for _, h := range order {
+		if err := s.opsService.HandleAttesterSlashing(ctx, conflicts[h]); err != nil {
+			return fmt.Errorf("could not submit attester slashing: %v", err)
+		}
+	}
+	return nil
+}
+
+// pruneHistory deletes the votes and proposals older than the history the slasher keeps,
+// at most once per epoch.
+func (s *Service) pruneHistory(currentEpoch uint64) error {
+	if currentEpoch <= s.lastPrunedEpoch || currentEpoch < params.BeaconConfig().GenesisEpoch+s.historyEpochs {
+		return nil
+	}
+	if err := s.beaconDB.PruneSlasherHistory(currentEpoch - s.historyEpochs); err != nil {
+		return fmt.Errorf("could not prune slasher history: %v", err)
+	}
+	s.lastPrunedEpoch = currentEpoch
+	return nil
+}
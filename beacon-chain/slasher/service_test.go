@@ -0,0 +1,386 @@
+package slasher
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/sirupsen/logrus"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func init() {
+	logrus.SetLevel(logrus.DebugLevel)
+	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{})
+}
+
+type mockOperationService struct {
+	proposerSlashings []*pb.ProposerSlashing
+	attesterSlashings []*pb.AttesterSlashing
+}
+
+func (ms *mockOperationService) HandleProposerSlashing(_ context.Context, message proto.Message) error {
+	ms.proposerSlashings = append(ms.proposerSlashings, message.(*pb.ProposerSlashing))
+	return nil
+}
+
+func (ms *mockOperationService) HandleAttesterSlashing(_ context.Context, message proto.Message) error {
+	ms.attesterSlashings = append(ms.attesterSlashings, message.(*pb.AttesterSlashing))
+	return nil
+}
+
+func setupService(t *testing.T, beaconDB db.Database) (*Service, *mockOperationService, *pb.BeaconState, []*bls.SecretKey) {
+	deposits := make([]*pb.Deposit, params.BeaconConfig().SlotsPerEpoch)
+	privKeys := make([]*bls.SecretKey, len(deposits))
+	for i := 0; i < len(deposits); i++ {
+		priv, err := bls.RandKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		depositData, err := helpers.EncodeDepositData(
+			&pb.DepositInput{Pubkey: priv.PublicKey().Marshal()},
+			params.BeaconConfig().MaxDepositAmount,
+			0,
+		)
+		if err != nil {
+			t.Fatalf("Could not encode deposit input: %v", err)
+		}
+		deposits[i] = &pb.Deposit{DepositData: depositData}
+		privKeys[i] = priv
+	}
+	beaconState, err := state.GenesisBeaconState(deposits, 0, nil)
+	if err != nil {
+		t.Fatalf("Could not instantiate genesis state: %v", err)
+	}
+	genesis := b.NewGenesisBlock([]byte{})
+	if err := beaconDB.SaveBlock(genesis); err != nil {
+		t.Fatalf("Could not save genesis block: %v", err)
+	}
+	if err := beaconDB.UpdateChainHead(context.Background(), genesis, beaconState); err != nil {
+		t.Fatalf("Could not save genesis state: %v", err)
+	}
+	ops := &mockOperationService{}
+	s := NewSlasherService(context.Background(), &Config{
+		BeaconDB:         beaconDB,
+		OperationService: ops,
+	})
+	return s, ops, beaconState, privKeys
+}
+
+// signBlock signs the block with the key of the given validator.
+func signBlock(t *testing.T, beaconState *pb.BeaconState, priv *bls.SecretKey, block *pb.BeaconBlock) *pb.BeaconBlock {
+	proposalRoot, err := hashutil.HashProposal(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := forkutil.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(block.Slot), params.BeaconConfig().DomainProposal)
+	block.Signature = priv.Sign(proposalRoot[:], domain).Marshal()
+	return block
+}
+
+// proposedBlock returns a block at the slot signed by its proposer.
+func proposedBlock(t *testing.T, beaconState *pb.BeaconState, privKeys []*bls.SecretKey, slot uint64, randao byte) *pb.BeaconBlock {
+	proposerIndex, err := helpers.BeaconProposerIndex(beaconState, slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signBlock(t, beaconState, privKeys[proposerIndex], &pb.BeaconBlock{Slot: slot, RandaoReveal: []byte{randao}})
+}
+
+// attestation returns an attestation from the first member of the committee at the slot,
+// signed with the key of that member.
+func attestation(t *testing.T, beaconState *pb.BeaconState, privKeys []*bls.SecretKey, slot uint64, sourceEpoch uint64, root byte) *pb.Attestation {
+	committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, slot, false)
+	if err != nil {
+		t.Fatalf("Could not get committees at slot %d: %v", slot, err)
+	}
+	bitfield := make([]byte, (len(committees[0].Committee)+7)/8)
+	bitfield[0] = 0x80
+	att := &pb.Attestation{
+		Data: &pb.AttestationData{
+			Slot:                  slot,
+			Shard:                 committees[0].Shard,
+			JustifiedEpoch:        sourceEpoch,
+			BeaconBlockRootHash32: []byte{root},
+		},
+		AggregationBitfield: bitfield,
+		CustodyBitfield:     make([]byte, len(bitfield)),
+	}
+	msg, err := hashutil.HashAttestationDataAndCustodyBit(att.Data, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := forkutil.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(slot), params.BeaconConfig().DomainAttestation)
+	att.AggregateSignature = privKeys[committees[0].Committee[0]].Sign(msg[:], domain).Marshal()
+	return att
+}
+
+// committeeSlot returns the slot of the epoch starting at startSlot where the validator is the
+// first member of the first committee.
+func committeeSlot(t *testing.T, beaconState *pb.BeaconState, startSlot uint64, validatorIndex uint64) uint64 {
+	for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+		committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, slot, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if committees[0].Committee[0] == validatorIndex {
+			return slot
+		}
+	}
+	t.Fatalf("Validator %d is not the first member of a committee", validatorIndex)
+	return 0
+}
+
+// includeInBlock processes a block with the given body on top of the state, at the next
+// slot, verifying every signature as the state transition of the chain service does.
+func includeInBlock(t *testing.T, beaconState *pb.BeaconState, privKeys []*bls.SecretKey, body *pb.BeaconBlockBody) *pb.BeaconState {
+	ctx := context.Background()
+	beaconState = state.ProcessSlot(ctx, proto.Clone(beaconState).(*pb.BeaconState), [32]byte{})
+	proposerIndex, err := helpers.BeaconProposerIndex(beaconState, beaconState.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	epoch := helpers.CurrentEpoch(beaconState)
+	buf := make([]byte, 32)
+	binary.LittleEndian.PutUint64(buf, epoch)
+	domain := forkutil.DomainVersion(beaconState.Fork, epoch, params.BeaconConfig().DomainRandao)
+	block := signBlock(t, beaconState, privKeys[proposerIndex], &pb.BeaconBlock{
+		Slot:         beaconState.Slot,
+		RandaoReveal: privKeys[proposerIndex].Sign(buf, domain).Marshal(),
+		Eth1Data:     &pb.Eth1Data{},
+		Body:         body,
+	})
+	beaconState, err = state.ProcessBlock(ctx, beaconState, block, &state.TransitionConfig{VerifySignatures: true})
+	if err != nil {
+		t.Fatalf("Could not process block including the slashing: %v", err)
+	}
+	return beaconState
+}
+
+// assertSlashed checks that the validators are slashed in the state.
+func assertSlashed(t *testing.T, beaconState *pb.BeaconState, indices ...uint64) {
+	for _, index := range indices {
+		if beaconState.ValidatorRegistry[index].SlashedEpoch == params.BeaconConfig().FarFutureEpoch {
+			t.Errorf("Expected validator %d to be slashed", index)
+		}
+	}
+}
+
+func mustHashBlock(t *testing.T, block *pb.BeaconBlock) []byte {
+	root, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return root[:]
+}
+
+func TestCheckBlock_DoubleProposal(t *testing.T) {
+	hook := logTest.NewGlobal()
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	s, ops, beaconState, privKeys := setupService(t, beaconDB)
+
+	slot := params.BeaconConfig().GenesisSlot + 1
+	block1 := proposedBlock(t, beaconState, privKeys, slot, 'A')
+	block2 := proposedBlock(t, beaconState, privKeys, slot, 'B')
+	for _, block := range []*pb.BeaconBlock{block1, block1, block2} {
+		if err := s.CheckBlock(context.Background(), block); err != nil {
+			t.Fatalf("Could not check block: %v", err)
+		}
+	}
+
+	if len(ops.proposerSlashings) != 1 {
+		t.Fatalf("Expected 1 proposer slashing, received %d", len(ops.proposerSlashings))
+	}
+	slashing := ops.proposerSlashings[0]
+	if !proto.Equal(slashing.ProposalData_1, &pb.ProposalSignedData{
+		Slot:            slot,
+		Shard:           params.BeaconConfig().BeaconChainShardNumber,
+		BlockRootHash32: mustHashBlock(t, block1),
+	}) {
+		t.Errorf("Unexpected first proposal %v", slashing.ProposalData_1)
+	}
+	if !bytes.Equal(slashing.ProposalSignature_1, block1.Signature) || !bytes.Equal(slashing.ProposalSignature_2, block2.Signature) {
+		t.Errorf("Unexpected proposal signatures %#x, %#x", slashing.ProposalSignature_1, slashing.ProposalSignature_2)
+	}
+	testutil.AssertLogsContain(t, hook, "Detected double proposal")
+
+	newState := includeInBlock(t, beaconState, privKeys, &pb.BeaconBlockBody{
+		ProposerSlashings: ops.proposerSlashings,
+	})
+	assertSlashed(t, newState, slashing.ProposerIndex)
+}
+
+func TestCheckBlock_ForgedProposal(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	s, ops, beaconState, privKeys := setupService(t, beaconDB)
+
+	slot := params.BeaconConfig().GenesisSlot + 1
+	proposerIndex, err := helpers.BeaconProposerIndex(beaconState, slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	forgerIndex := (proposerIndex + 1) % uint64(len(privKeys))
+	forged := signBlock(t, beaconState, privKeys[forgerIndex], &pb.BeaconBlock{Slot: slot, RandaoReveal: []byte{'F'}})
+	if err := s.CheckBlock(context.Background(), forged); err == nil {
+		t.Fatal("Expected a block with a forged signature to be rejected")
+	}
+
+	// The forged proposal must not be recorded in place of the real ones.
+	block1 := proposedBlock(t, beaconState, privKeys, slot, 'A')
+	block2 := proposedBlock(t, beaconState, privKeys, slot, 'B')
+	for _, block := range []*pb.BeaconBlock{block1, block2} {
+		if err := s.CheckBlock(context.Background(), block); err != nil {
+			t.Fatalf("Could not check block: %v", err)
+		}
+	}
+	if len(ops.proposerSlashings) != 1 {
+		t.Fatalf("Expected 1 proposer slashing, received %d", len(ops.proposerSlashings))
+	}
+	if !bytes.Equal(ops.proposerSlashings[0].ProposalData_1.BlockRootHash32, mustHashBlock(t, block1)) {
+		t.Errorf("Expected the first real proposal in the slashing, received %v", ops.proposerSlashings[0])
+	}
+}
+
+func TestCheckAttestation_DoubleVote(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	s, ops, beaconState, privKeys := setupService(t, beaconDB)
+
+	slot := params.BeaconConfig().GenesisSlot + 1
+	att1 := attestation(t, beaconState, privKeys, slot, params.BeaconConfig().GenesisEpoch, 'A')
+	att2 := attestation(t, beaconState, privKeys, slot, params.BeaconConfig().GenesisEpoch, 'B')
+	for _, att := range []*pb.Attestation{att1, att1, att2} {
+		if err := s.CheckAttestation(context.Background(), att); err != nil {
+			t.Fatalf("Could not check attestation: %v", err)
+		}
+	}
+
+	if len(ops.attesterSlashings) != 1 {
+		t.Fatalf("Expected 1 attester slashing, received %d", len(ops.attesterSlashings))
+	}
+	slashing := ops.attesterSlashings[0]
+	if !proto.Equal(slashing.SlashableAttestation_1.Data, att1.Data) ||
+		!proto.Equal(slashing.SlashableAttestation_2.Data, att2.Data) {
+		t.Errorf("Unexpected slashable attestations %v", slashing)
+	}
+	if len(slashing.SlashableAttestation_1.ValidatorIndices) != 1 {
+		t.Errorf("Expected a single participant, received %v", slashing.SlashableAttestation_1.ValidatorIndices)
+	}
+
+	newState := includeInBlock(t, beaconState, privKeys, &pb.BeaconBlockBody{
+		AttesterSlashings: ops.attesterSlashings,
+	})
+	assertSlashed(t, newState, slashing.SlashableAttestation_1.ValidatorIndices...)
+}
+
+func TestCheckAttestation_ForgedVote(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	s, ops, beaconState, privKeys := setupService(t, beaconDB)
+
+	slot := params.BeaconConfig().GenesisSlot + 1
+	forged := attestation(t, beaconState, privKeys, slot, params.BeaconConfig().GenesisEpoch, 'F')
+	forged.AggregateSignature = attestation(t, beaconState, privKeys, slot+1, params.BeaconConfig().GenesisEpoch, 'F').AggregateSignature
+	if err := s.CheckAttestation(context.Background(), forged); err == nil {
+		t.Fatal("Expected an attestation with a forged signature to be rejected")
+	}
+	if len(ops.attesterSlashings) != 0 {
+		t.Fatalf("Expected no attester slashing, received %v", ops.attesterSlashings)
+	}
+
+	// The forged vote must not hide the double vote of the real attestations.
+	att1 := attestation(t, beaconState, privKeys, slot, params.BeaconConfig().GenesisEpoch, 'A')
+	att2 := attestation(t, beaconState, privKeys, slot, params.BeaconConfig().GenesisEpoch, 'B')
+	for _, att := range []*pb.Attestation{att1, att2} {
+		if err := s.CheckAttestation(context.Background(), att); err != nil {
+			t.Fatalf("Could not check attestation: %v", err)
+		}
+	}
+	if len(ops.attesterSlashings) != 1 {
+		t.Fatalf("Expected 1 attester slashing, received %d", len(ops.attesterSlashings))
+	}
+	if !proto.Equal(ops.attesterSlashings[0].SlashableAttestation_1.Data, att1.Data) {
+		t.Errorf("Expected the first real attestation in the slashing, received %v", ops.attesterSlashings[0])
+	}
+}
+
+func TestCheckAttestation_SurroundVote(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	s, ops, beaconState, privKeys := setupService(t, beaconDB)
+	genesisEpoch := params.BeaconConfig().GenesisEpoch
+	genesisSlot := params.BeaconConfig().GenesisSlot
+
+	// Find where the first committee member of the genesis slot attests in the next epoch.
+	committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, genesisSlot, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nextSlot := committeeSlot(t, beaconState, genesisSlot+params.BeaconConfig().SlotsPerEpoch, committees[0].Committee[0])
+
+	surrounded := attestation(t, beaconState, privKeys, genesisSlot, genesisEpoch-1, 'A')
+	surrounding := attestation(t, beaconState, privKeys, nextSlot, genesisEpoch-2, 'B')
+	for _, att := range []*pb.Attestation{surrounded, surrounding} {
+		if err := s.CheckAttestation(context.Background(), att); err != nil {
+			t.Fatalf("Could not check attestation: %v", err)
+		}
+	}
+
+	if len(ops.attesterSlashings) != 1 {
+		t.Fatalf("Expected 1 attester slashing, received %d", len(ops.attesterSlashings))
+	}
+	slashing := ops.attesterSlashings[0]
+	if !proto.Equal(slashing.SlashableAttestation_1.Data, surrounding.Data) ||
+		!proto.Equal(slashing.SlashableAttestation_2.Data, surrounded.Data) {
+		t.Errorf("Expected the surrounding attestation first, received %v", slashing)
+	}
+
+	newState := includeInBlock(t, beaconState, privKeys, &pb.BeaconBlockBody{
+		AttesterSlashings: ops.attesterSlashings,
+	})
+	assertSlashed(t, newState, slashing.SlashableAttestation_1.ValidatorIndices...)
+}
+
+func TestPruneHistory_OncePerEpoch(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	s := NewSlasherService(context.Background(), &Config{BeaconDB: beaconDB, HistoryEpochs: 2})
+	genesisEpoch := params.BeaconConfig().GenesisEpoch
+
+	vote := &db.ValidatorVote{TargetEpoch: genesisEpoch}
+	if err := beaconDB.SaveValidatorVote(context.Background(), 1, vote); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.pruneHistory(genesisEpoch + 1); err != nil {
+		t.Fatal(err)
+	}
+	if votes, err := beaconDB.ValidatorVotes(1); err != nil || len(votes) != 1 {
+		t.Fatalf("Expected vote to be kept, received %v, %v", votes, err)
+	}
+	if err := s.pruneHistory(genesisEpoch + 3); err != nil {
+		t.Fatal(err)
+	}
+	if votes, err := beaconDB.ValidatorVotes(1); err != nil || len(votes) != 0 {
+		t.Fatalf("Expected vote to be pruned, received %v, %v", votes, err)
+	}
+	if s.lastPrunedEpoch != genesisEpoch+3 {
+		t.Errorf("Expected last pruned epoch %d, received %d", genesisEpoch+3, s.lastPrunedEpoch)
+	}
+}
@@ -40,7 +40,7 @@ type Config struct {
 	BlockAnnounceBufferSize int
 	BatchedBlockBufferSize  int
 	StateBufferSize         int
-	BeaconDB                *db.BeaconDB
+	BeaconDB                db.Database
 	P2P                     p2pAPI
 	SyncService             syncService
 	ChainService            chainService
@@ -91,7 +91,7 @@ type InitialSync struct {
 	p2p                 p2pAPI
 	syncService         syncService
 	chainService        chainService
-	db                  *db.BeaconDB
+	db                  db.Database
 	powchain            powChainService
 	blockAnnounceBuf    chan p2p.Message
 	batchedBlockBuf     chan p2p.Message
@@ -57,7 +57,10 @@ func (s *InitialSync) processState(msg p2p.Message) {
 		return
 	}
 
-	s.db.PrunePendingDeposits(ctx, finalizedState.DepositIndex)
+	if err := s.db.PrunePendingDeposits(ctx, finalizedState.DepositIndex); err != nil {
+		log.Errorf("Could not prune pending deposits: %v", err)
+		return
+	}
 
 	if err := s.db.UpdateChainHead(ctx, finalizedState.LatestBlock, finalizedState); err != nil {
 		log.Errorf("Could not update chain head: %v", err)
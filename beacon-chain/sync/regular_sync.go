@@ -69,7 +69,7 @@ type RegularSync struct {
 	chainService                 chainService
 	attsService                  attsService
 	operationsService            operations.OperationFeeds
-	db                           *db.BeaconDB
+	db                           db.Database
 	blockAnnouncementFeed        *event.Feed
 	announceBlockBuf             chan p2p.Message
 	blockBuf                     chan p2p.Message
@@ -82,6 +82,8 @@ type RegularSync struct {
 	attestationReqByHashBuf      chan p2p.Message
 	announceAttestationBuf       chan p2p.Message
 	exitBuf                      chan p2p.Message
+	proposerSlashingBuf          chan p2p.Message
+	attesterSlashingBuf          chan p2p.Message
 	canonicalBuf                 chan *pb.BeaconBlockAnnounce
 	highestObservedSlot          uint64
 	blocksAwaitingProcessing     map[[32]byte]p2p.Message
@@ -103,12 +105,13 @@ type RegularSyncConfig struct {
 	AttestationReqHashBufSize   int
 	AttestationsAnnounceBufSize int
 	ExitBufferSize              int
+	SlashingBufferSize          int
 	ChainHeadReqBufferSize      int
 	CanonicalBufferSize         int
 	ChainService                chainService
 	OperationService            operations.OperationFeeds
 	AttsService                 attsService
-	BeaconDB                    *db.BeaconDB
+	BeaconDB                    db.Database
 	P2P                         p2pAPI
 }
 
@@ -126,6 +129,7 @@ func DefaultRegularSyncConfig() *RegularSyncConfig {
 		AttestationReqHashBufSize:   params.BeaconConfig().DefaultBufferSize,
 		AttestationsAnnounceBufSize: params.BeaconConfig().DefaultBufferSize,
 		ExitBufferSize:              params.BeaconConfig().DefaultBufferSize,
+		SlashingBufferSize:          params.BeaconConfig().DefaultBufferSize,
 		CanonicalBufferSize:         params.BeaconConfig().DefaultBufferSize,
 	}
 }
@@ -152,6 +156,8 @@ func NewRegularSyncService(ctx context.Context, cfg *RegularSyncConfig) *Regular
 		attestationReqByHashBuf:  make(chan p2p.Message, cfg.AttestationReqHashBufSize),
 		announceAttestationBuf:   make(chan p2p.Message, cfg.AttestationsAnnounceBufSize),
 		exitBuf:                  make(chan p2p.Message, cfg.ExitBufferSize),
+		proposerSlashingBuf:      make(chan p2p.Message, cfg.SlashingBufferSize),
+		attesterSlashingBuf:      make(chan p2p.Message, cfg.SlashingBufferSize),
 		chainHeadReqBuf:          make(chan p2p.Message, cfg.ChainHeadReqBufferSize),
 		canonicalBuf:             make(chan *pb.BeaconBlockAnnounce, cfg.CanonicalBufferSize),
 		blocksAwaitingProcessing: make(map[[32]byte]p2p.Message),
@@ -194,6 +200,8 @@ func (rs *RegularSync) run() {
 	attestationReqSub := rs.p2p.Subscribe(&pb.AttestationRequest{}, rs.attestationReqByHashBuf)
 	announceAttestationSub := rs.p2p.Subscribe(&pb.AttestationAnnounce{}, rs.announceAttestationBuf)
 	exitSub := rs.p2p.Subscribe(&pb.VoluntaryExit{}, rs.exitBuf)
+	proposerSlashingSub := rs.p2p.Subscribe(&pb.ProposerSlashing{}, rs.proposerSlashingBuf)
+	attesterSlashingSub := rs.p2p.Subscribe(&pb.AttesterSlashing{}, rs.attesterSlashingBuf)
 	chainHeadReqSub := rs.p2p.Subscribe(&pb.ChainHeadRequest{}, rs.chainHeadReqBuf)
 	canonicalBlockSub := rs.chainService.CanonicalBlockFeed().Subscribe(rs.canonicalBuf)
 
@@ -208,6 +216,8 @@ func (rs *RegularSync) run() {
 	defer attestationReqSub.Unsubscribe()
 	defer announceAttestationSub.Unsubscribe()
 	defer exitSub.Unsubscribe()
+	defer proposerSlashingSub.Unsubscribe()
+	defer attesterSlashingSub.Unsubscribe()
 	defer canonicalBlockSub.Unsubscribe()
 
 	for {
@@ -225,6 +235,10 @@ func (rs *RegularSync) run() {
 			go safelyHandleMessage(rs.handleAttestationAnnouncement, msg)
 		case msg := <-rs.exitBuf:
 			go safelyHandleMessage(rs.receiveExitRequest, msg)
+		case msg := <-rs.proposerSlashingBuf:
+			go safelyHandleMessage(rs.receiveProposerSlashing, msg)
+		case msg := <-rs.attesterSlashingBuf:
+			go safelyHandleMessage(rs.receiveAttesterSlashing, msg)
 		case msg := <-rs.blockBuf:
 			go safelyHandleMessage(rs.receiveBlock, msg)
 		case msg := <-rs.blockRequestBySlot:
@@ -492,6 +506,58 @@ func (rs *RegularSync) receiveExitRequest(msg p2p.Message) error {
 	return nil
 }
 
+// receiveProposerSlashing accepts a broadcasted proposer slashing from the p2p layer,
+// discards it if we already have it, and forwards it to the operations service.
+func (rs *RegularSync) receiveProposerSlashing(msg p2p.Message) error {
+	_, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.receiveProposerSlashing")
+	defer span.End()
+	recProposerSlashing.Inc()
+	slashing := msg.Data.(*pb.ProposerSlashing)
+	h, err := hashutil.HashProto(slashing)
+	if err != nil {
+		log.Errorf("Could not hash incoming proposer slashing: %v", err)
+		return err
+	}
+
+	hasSlashing := rs.db.HasProposerSlashing(h)
+	span.AddAttributes(trace.BoolAttribute("hasSlashing", hasSlashing))
+	if hasSlashing {
+		log.Debugf("Received, skipping proposer slashing #%x", h)
+		return nil
+	}
+	log.WithField("slashingHash", fmt.Sprintf("%#x", h)).
+		Debug("Forwarding proposer slashing to subscribed services")
+	rs.operationsService.IncomingProposerSlashingFeed().Send(slashing)
+	sentProposerSlashing.Inc()
+	return nil
+}
+
+// receiveAttesterSlashing accepts a broadcasted attester slashing from the p2p layer,
+// discards it if we already have it, and forwards it to the operations service.
+func (rs *RegularSync) receiveAttesterSlashing(msg p2p.Message) error {
+	_, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.receiveAttesterSlashing")
+	defer span.End()
+	recAttesterSlashing.Inc()
+	slashing := msg.Data.(*pb.AttesterSlashing)
+	h, err := hashutil.HashProto(slashing)
+	if err != nil {
+		log.Errorf("Could not hash incoming attester slashing: %v", err)
+		return err
+	}
+
+	hasSlashing := rs.db.HasAttesterSlashing(h)
+	span.AddAttributes(trace.BoolAttribute("hasSlashing", hasSlashing))
+	if hasSlashing {
+		log.Debugf("Received, skipping attester slashing #%x", h)
+		return nil
+	}
+	log.WithField("slashingHash", fmt.Sprintf("%#x", h)).
+		Debug("Forwarding attester slashing to subscribed services")
+	rs.operationsService.IncomingAttesterSlashingFeed().Send(slashing)
+	sentAttesterSlashing.Inc()
+	return nil
+}
+
 func (rs *RegularSync) handleBlockRequestByHash(msg p2p.Message) error {
 	ctx, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.handleBlockRequestByHash")
 	defer span.End()
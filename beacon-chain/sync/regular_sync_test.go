@@ -48,7 +48,7 @@ type mockChainService struct {
 	bFeed           *event.Feed
 	sFeed           *event.Feed
 	cFeed           *event.Feed
-	db              *db.BeaconDB
+	db              db.Database
 	canonicalBlocks map[uint64][]byte
 }
 
@@ -103,6 +103,10 @@ func (ms *mockOperationService) IncomingProcessedBlockFeed() *event.Feed {
 	return nil
 }
 
+func (ms *mockOperationService) IncomingOrphanedBlockFeed() *event.Feed {
+	return nil
+}
+
 func (ms *mockOperationService) IncomingAttFeed() *event.Feed {
 	return new(event.Feed)
 }
@@ -111,6 +115,14 @@ func (ms *mockOperationService) IncomingExitFeed() *event.Feed {
 	return new(event.Feed)
 }
 
+func (ms *mockOperationService) IncomingProposerSlashingFeed() *event.Feed {
+	return new(event.Feed)
+}
+
+func (ms *mockOperationService) IncomingAttesterSlashingFeed() *event.Feed {
+	return new(event.Feed)
+}
+
 type mockAttestationService struct{}
 
 func (ma *mockAttestationService) IncomingAttestationFeed() *event.Feed {
@@ -378,7 +390,11 @@ func TestBlockRequest_OK(t *testing.T) {
 		Data: request1,
 		Peer: "",
 	}
-	if err := db.SaveBlock(&pb.BeaconBlock{Slot: 20}); err != nil {
+	block := &pb.BeaconBlock{Slot: 20}
+	if err := db.SaveBlock(block); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(context.Background(), block, &pb.BeaconState{Slot: 20}); err != nil {
 		t.Fatal(err)
 	}
 
@@ -503,6 +519,70 @@ func TestReceiveExitReq_OK(t *testing.T) {
 	testutil.AssertLogsContain(t, hook, "Forwarding validator exit request to subscribed services")
 }
 
+func TestReceiveSlashings_OK(t *testing.T) {
+	hook := logTest.NewGlobal()
+	os := &mockOperationService{}
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	cfg := &RegularSyncConfig{
+		OperationService: os,
+		P2P:              &mockP2P{},
+		BeaconDB:         db,
+		ChainService:     &mockChainService{},
+	}
+	ss := NewRegularSyncService(context.Background(), cfg)
+
+	proposerSlashing := p2p.Message{
+		Ctx:  context.Background(),
+		Data: &pb.ProposerSlashing{ProposerIndex: 1},
+	}
+	if err := ss.receiveProposerSlashing(proposerSlashing); err != nil {
+		t.Error(err)
+	}
+	testutil.AssertLogsContain(t, hook, "Forwarding proposer slashing to subscribed services")
+
+	attesterSlashing := p2p.Message{
+		Ctx: context.Background(),
+		Data: &pb.AttesterSlashing{
+			SlashableAttestation_1: &pb.SlashableAttestation{ValidatorIndices: []uint64{1}},
+		},
+	}
+	if err := ss.receiveAttesterSlashing(attesterSlashing); err != nil {
+		t.Error(err)
+	}
+	testutil.AssertLogsContain(t, hook, "Forwarding attester slashing to subscribed services")
+}
+
+func TestReceiveSlashings_SkipsKnown(t *testing.T) {
+	hook := logTest.NewGlobal()
+	os := &mockOperationService{}
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	cfg := &RegularSyncConfig{
+		OperationService: os,
+		P2P:              &mockP2P{},
+		BeaconDB:         db,
+		ChainService:     &mockChainService{},
+	}
+	ss := NewRegularSyncService(context.Background(), cfg)
+
+	slashing := &pb.ProposerSlashing{ProposerIndex: 1}
+	if err := db.SaveProposerSlashing(context.Background(), slashing); err != nil {
+		t.Fatal(err)
+	}
+	msg := p2p.Message{
+		Ctx:  context.Background(),
+		Data: slashing,
+	}
+	if err := ss.receiveProposerSlashing(msg); err != nil {
+		t.Error(err)
+	}
+	testutil.AssertLogsContain(t, hook, "Received, skipping proposer slashing")
+	testutil.AssertLogsDoNotContain(t, hook, "Forwarding proposer slashing to subscribed services")
+}
+
 func TestHandleAttReq_HashNotFound(t *testing.T) {
 	hook := logTest.NewGlobal()
 	os := &mockOperationService{}
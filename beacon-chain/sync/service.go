@@ -24,7 +24,7 @@ type Service struct {
 // Config defines the configured services required for sync to work.
 type Config struct {
 	ChainService     chainService
-	BeaconDB         *db.BeaconDB
+	BeaconDB         db.Database
 	P2P              p2pAPI
 	AttsService      attsService
 	OperationService operations.OperationFeeds
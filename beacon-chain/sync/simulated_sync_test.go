@@ -124,7 +124,6 @@ func setupSimBackendAndDB(t *testing.T) (*backend.SimulatedBackend, *db.BeaconDB
 func setUpSyncedService(numOfBlocks int, simP2P *simulatedP2P, t *testing.T) (*Service, *db.BeaconDB, [32]byte) {
 	bd, beacondb, _ := setupSimBackendAndDB(t)
 	defer bd.Shutdown()
-	defer db.TeardownDB(bd.DB())
 	ctx := context.Background()
 
 	mockPow := &genesisPowChain{
@@ -199,7 +198,6 @@ func setUpSyncedService(numOfBlocks int, simP2P *simulatedP2P, t *testing.T) (*S
 func setUpUnSyncedService(simP2P *simulatedP2P, stateRoot [32]byte, t *testing.T) (*Service, *db.BeaconDB) {
 	bd, beacondb, _ := setupSimBackendAndDB(t)
 	defer bd.Shutdown()
-	defer db.TeardownDB(bd.DB())
 
 	mockPow := &afterGenesisPowChain{
 		feed: new(event.Feed),
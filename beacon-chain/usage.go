@@ -77,6 +77,10 @@ var appHelpFlagGroups = []flagGroup{
 			utils.CertFlag,
 			utils.KeyFlag,
 			utils.EnableDBCleanup,
+			utils.EnableSlasherFlag,
+			utils.DBMigrateDryRunFlag,
+			utils.ArchiveFlag,
+			utils.HistoricalStateIntervalFlag,
 		},
 	},
 	{
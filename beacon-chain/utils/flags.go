@@ -42,4 +42,44 @@ var (
 		Name:  "enable-db-cleanup",
 		Usage: "Enable automatic DB cleanup routine",
 	}
+	// EnableSlasherFlag tells the beacon node to watch the network for slashable offences.
+	EnableSlasherFlag = cli.BoolFlag{
+		Name:  "enable-slasher",
+		Usage: "Detect double votes, surround votes and double proposals, and submit slashings for them",
+	}
+	// DBMigrateDryRunFlag reports the pending database migrations instead of starting the node.
+	DBMigrateDryRunFlag = cli.BoolFlag{
+		Name:  "db-migrate-dry-run",
+		Usage: "Report which database migrations would be applied to the data directory, without applying them, and exit",
+	}
+	// ArchiveFlag tells the beacon node to keep every historical state.
+	ArchiveFlag = cli.BoolFlag{
+		Name:  "archive",
+		Usage: "Keep every historical state in the database instead of pruning the ones older than the finalized state",
+	}
+	// HistoricalStateIntervalFlag defines how many epochs apart the historical states kept by the beacon node are.
+	HistoricalStateIntervalFlag = cli.Uint64Flag{
+		Name:  "historical-state-interval",
+		Usage: "Keep one historical state every this many epochs once finalized, and regenerate the states in between by replaying blocks. 0 keeps the pruning behaviour of --enable-historical-state-pruning",
+	}
+	// RootFlag defines the hex encoded root of the block or state a db command looks up.
+	RootFlag = cli.StringFlag{
+		Name:  "root",
+		Usage: "Hex encoded root of the block or state to look up",
+	}
+	// SlotFlag defines the slot of the block or state a db command looks up.
+	SlotFlag = cli.Uint64Flag{
+		Name:  "slot",
+		Usage: "Slot since genesis of the block or state in the main chain to look up",
+	}
+	// EndSlotFlag defines the last slot of the blocks exported from the database.
+	EndSlotFlag = cli.Uint64Flag{
+		Name:  "end-slot",
+		Usage: "Slot since genesis of the last block to export. 0 exports the blocks up to the chain head",
+	}
+	// ChainFileFlag defines the location of the file used to export or import a chain.
+	ChainFileFlag = cli.StringFlag{
+		Name:  "chain-file",
+		Usage: "Path to the JSON file holding the finalized state and the blocks of an exported chain",
+	}
 )
@@ -40,6 +40,9 @@ const (
 	Topic_ATTESTATION_ANNOUNCE                Topic = 12
 	Topic_ATTESTATION_REQUEST                 Topic = 13
 	Topic_ATTESTATION_RESPONSE                Topic = 14
+	Topic_VOLUNTARY_EXIT                      Topic = 15
+	Topic_PROPOSER_SLASHING                   Topic = 16
+	Topic_ATTESTER_SLASHING                   Topic = 17
 )
 
 var Topic_name = map[int32]string{
@@ -58,6 +61,9 @@ var Topic_name = map[int32]string{
 	12: "ATTESTATION_ANNOUNCE",
 	13: "ATTESTATION_REQUEST",
 	14: "ATTESTATION_RESPONSE",
+	15: "VOLUNTARY_EXIT",
+	16: "PROPOSER_SLASHING",
+	17: "ATTESTER_SLASHING",
 }
 
 var Topic_value = map[string]int32{
@@ -76,6 +82,9 @@ var Topic_value = map[string]int32{
 	"ATTESTATION_ANNOUNCE":                12,
 	"ATTESTATION_REQUEST":                 13,
 	"ATTESTATION_RESPONSE":                14,
+	"VOLUNTARY_EXIT":                      15,
+	"PROPOSER_SLASHING":                   16,
+	"ATTESTER_SLASHING":                   17,
 }
 
 func (x Topic) String() string {
@@ -1469,63 +1478,65 @@ func init() {
 func init() { proto.RegisterFile("proto/beacon/p2p/v1/messages.proto", fileDescriptor_a1d590cda035b632) }
 
 var fileDescriptor_a1d590cda035b632 = []byte{
-	// 884 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xd1, 0x72, 0xda, 0x46,
-	0x14, 0x86, 0xab, 0xd8, 0x0e, 0xce, 0x01, 0x13, 0xb2, 0x6e, 0x63, 0xec, 0x26, 0xd8, 0x56, 0xea,
-	0xa9, 0xdb, 0x99, 0xe0, 0x89, 0x73, 0x95, 0x8b, 0x4e, 0x47, 0xc2, 0x9a, 0x92, 0xc4, 0x15, 0xa9,
-	0x80, 0x76, 0x7a, 0xb5, 0x5d, 0xc4, 0xd6, 0x30, 0xc5, 0xbb, 0x5b, 0x76, 0x61, 0xec, 0xde, 0xf7,
-	0x19, 0xfa, 0x04, 0x7d, 0x89, 0x3e, 0x41, 0x2f, 0xfb, 0x08, 0x1d, 0x3f, 0x49, 0x47, 0xab, 0x15,
-	0x16, 0x20, 0xcb, 0x5c, 0xf4, 0x0e, 0x9d, 0xf3, 0xff, 0xff, 0x9e, 0x6f, 0x39, 0x62, 0x00, 0x5b,
-	0x8c, 0xb9, 0xe2, 0x27, 0x3d, 0x4a, 0x42, 0xce, 0x4e, 0xc4, 0xa9, 0x38, 0x99, 0xbe, 0x3a, 0xb9,
-	0xa4, 0x52, 0x92, 0x0b, 0x2a, 0xeb, 0xba, 0x89, 0x9e, 0x52, 0x35, 0xa0, 0x63, 0x3a, 0xb9, 0xac,
-	0xc7, 0xb2, 0xba, 0x38, 0x15, 0xf5, 0xe9, 0xab, 0xbd, 0xfd, 0x2c, 0xaf, 0xba, 0x16, 0x89, 0xd1,
-	0xfe, 0x06, 0x36, 0x3d, 0x36, 0xa5, 0x23, 0x2e, 0x28, 0x3a, 0x84, 0x92, 0x14, 0x84, 0xe1, 0x90,
-	0x33, 0x45, 0xaf, 0x54, 0xd5, 0x3a, 0xb0, 0x8e, 0x4b, 0x41, 0x31, 0xaa, 0x35, 0xe2, 0x12, 0xaa,
-	0x42, 0x41, 0x90, 0xeb, 0x11, 0x27, 0xfd, 0xea, 0x03, 0xdd, 0x4d, 0x1e, 0xed, 0x77, 0xb0, 0xed,
-	0xea, 0x53, 0xdc, 0x11, 0x0f, 0x7f, 0x71, 0x18, 0xe3, 0x13, 0x16, 0x52, 0x84, 0x60, 0x7d, 0x40,
-	0xe4, 0xc0, 0x64, 0xe9, 0xcf, 0x68, 0x1f, 0x8a, 0x72, 0xc4, 0x15, 0x66, 0x93, 0xcb, 0x1e, 0x1d,
-	0xeb, 0xa0, 0xf5, 0x00, 0xa2, 0x92, 0xaf, 0x2b, 0xf6, 0x31, 0xa0, 0x54, 0x56, 0x40, 0x7f, 0x9d,
-	0x50, 0xa9, 0xb2, 0xa2, 0x6c, 0x07, 0x6a, 0xcb, 0x4a, 0xf7, 0xba, 0x3d, 0xcb, 0x5a, 0x3c, 0xcc,
-	0x5a, 0x3a, 0xec, 0x0f, 0x6b, 0x6e, 0xf2, 0x80, 0x4a, 0xc1, 0x99, 0xa4, 0xe8, 0x0d, 0x6c, 0xf4,
-	0xa2, 0x82, 0xb6, 0x14, 0x4f, 0x5f, 0xd4, 0xb3, 0xaf, 0xb8, 0x9e, 0xf6, 0xc6, 0x0e, 0xe4, 0x41,
-	0x91, 0x28, 0x45, 0xa5, 0x22, 0x6a, 0xc8, 0x99, 0x06, 0xcc, 0x09, 0x70, 0x6e, 0xa5, 0x41, 0xda,
-	0x67, 0x77, 0x61, 0xd7, 0x25, 0x2a, 0x1c, 0xd0, 0x7e, 0xc6, 0x6d, 0x3c, 0x07, 0x90, 0x8a, 0x8c,
-	0x15, 0x8e, 0x50, 0x0c, 0xd6, 0x23, 0x5d, 0x89, 0xe0, 0xd1, 0x2e, 0x6c, 0x52, 0xd6, 0x8f, 0x9b,
-	0xf1, 0x05, 0x17, 0x28, 0xeb, 0x47, 0x2d, 0x7b, 0x00, 0x7b, 0x59, 0xb1, 0x06, 0xfb, 0x1d, 0x94,
-	0x7b, 0x71, 0x17, 0x6b, 0x18, 0x59, 0xb5, 0x0e, 0xd6, 0x56, 0xe5, 0xdf, 0x32, 0x56, 0xfd, 0x24,
-	0x6d, 0x04, 0x95, 0xc6, 0x80, 0x0c, 0x59, 0x93, 0x92, 0xbe, 0x99, 0xdb, 0xfe, 0xcb, 0x82, 0x27,
-	0xa9, 0xa2, 0x39, 0xf5, 0x08, 0xca, 0x21, 0x61, 0x9c, 0x0d, 0x43, 0x32, 0x4a, 0x13, 0x6d, 0xcd,
-	0xaa, 0x9a, 0xea, 0x2b, 0xf8, 0x34, 0x25, 0x53, 0x44, 0x51, 0x3c, 0xe6, 0x5c, 0xe1, 0x68, 0x17,
-	0x5e, 0x9f, 0x9a, 0x95, 0xac, 0xde, 0x7a, 0x22, 0x45, 0xc0, 0xb9, 0x6a, 0xea, 0x3e, 0xfa, 0x1a,
-	0x9e, 0xfd, 0x3c, 0x64, 0x64, 0x34, 0xfc, 0x8d, 0xf6, 0x97, 0xed, 0xb2, 0xba, 0xa6, 0xfd, 0xbb,
-	0x33, 0xcd, 0x82, 0x5f, 0xda, 0x2f, 0x61, 0x27, 0xc6, 0xd5, 0x9d, 0xa8, 0x9a, 0xb7, 0xe8, 0x76,
-	0x37, 0xd9, 0xe3, 0x38, 0xc8, 0x7c, 0x73, 0xf7, 0x4d, 0x61, 0xdd, 0x37, 0x45, 0x98, 0x2c, 0xac,
-	0x89, 0x35, 0x77, 0x78, 0x0e, 0x8f, 0x17, 0x72, 0x57, 0x5b, 0xdd, 0x38, 0xa5, 0x3c, 0x7f, 0x9e,
-	0xfd, 0x05, 0x6c, 0xa7, 0x16, 0x33, 0x17, 0xf3, 0x18, 0x50, 0x7a, 0x87, 0x73, 0x5e, 0x57, 0x31,
-	0x17, 0x3a, 0x9b, 0x3c, 0xeb, 0x47, 0xe2, 0x7f, 0x7a, 0x87, 0xea, 0x50, 0xfd, 0x30, 0xe6, 0x82,
-	0x4b, 0x3a, 0x6e, 0x8f, 0x88, 0x1c, 0x0c, 0xd9, 0x45, 0x2e, 0xcb, 0x4b, 0xd8, 0x59, 0xd4, 0xe7,
-	0x01, 0xfd, 0x6e, 0x2d, 0xe7, 0xe7, 0x62, 0x75, 0xe1, 0x89, 0x30, 0x7a, 0x2c, 0x8d, 0xc1, 0xc0,
-	0x1d, 0xdf, 0x05, 0xb7, 0x74, 0x40, 0x45, 0x2c, 0x54, 0x22, 0xcc, 0xf8, 0x0a, 0x56, 0xc7, 0x5c,
-	0xd4, 0xdf, 0x87, 0xb9, 0xac, 0xcf, 0xc7, 0x4c, 0xf4, 0x2b, 0x63, 0x2e, 0x1d, 0x50, 0x59, 0xac,
-	0xd8, 0x47, 0xf0, 0xf8, 0x8c, 0x0a, 0x2e, 0x87, 0x2a, 0x97, 0xee, 0x33, 0x28, 0x1b, 0x59, 0x1e,
-	0xd4, 0x4f, 0xb3, 0xb0, 0x5c, 0x94, 0x37, 0x50, 0xe8, 0xc7, 0x32, 0x03, 0xb0, 0x7f, 0x17, 0x40,
-	0x92, 0x96, 0xe8, 0x6d, 0x1b, 0x4a, 0xde, 0xd5, 0x3d, 0xb3, 0x1e, 0x42, 0x31, 0xd2, 0xe4, 0xbf,
-	0x35, 0xa5, 0x58, 0x92, 0x33, 0xe5, 0x39, 0x94, 0xa7, 0x7c, 0x34, 0x61, 0x8a, 0x8c, 0xaf, 0x31,
-	0xbd, 0x9a, 0x0d, 0x7b, 0x74, 0xd7, 0xb0, 0xdf, 0x27, 0x6a, 0x1d, 0xbd, 0x35, 0x4d, 0x3f, 0x7e,
-	0xf9, 0xe7, 0x1a, 0x6c, 0x74, 0xb8, 0x18, 0x86, 0xa8, 0x08, 0x85, 0xae, 0xff, 0xde, 0x6f, 0xfd,
-	0xe0, 0x57, 0x3e, 0x42, 0xbb, 0xf0, 0x89, 0xeb, 0x39, 0x8d, 0x96, 0x8f, 0xdd, 0xf3, 0x56, 0xe3,
-	0x3d, 0x76, 0x7c, 0xbf, 0xd5, 0xf5, 0x1b, 0x5e, 0xc5, 0x42, 0x55, 0xf8, 0x78, 0xae, 0x15, 0x78,
-	0xdf, 0x75, 0xbd, 0x76, 0xa7, 0xf2, 0x00, 0x7d, 0x0e, 0x2f, 0xb2, 0x3a, 0xd8, 0xfd, 0x11, 0xb7,
-	0xcf, 0x5b, 0x1d, 0xec, 0x77, 0xbf, 0x75, 0xbd, 0xa0, 0xb2, 0xb6, 0x94, 0x1e, 0x78, 0xed, 0x0f,
-	0x2d, 0xbf, 0xed, 0x55, 0xd6, 0xd1, 0x01, 0x3c, 0x73, 0x9d, 0x4e, 0xa3, 0xe9, 0x9d, 0xe1, 0xcc,
-	0x53, 0x36, 0xd0, 0x21, 0x3c, 0xbf, 0x43, 0x61, 0x42, 0x1e, 0xa2, 0xa7, 0x80, 0x1a, 0x4d, 0xe7,
-	0xad, 0x8f, 0x9b, 0x9e, 0x73, 0x36, 0xb3, 0x16, 0xd0, 0x0e, 0x6c, 0xcf, 0xd5, 0x8d, 0x61, 0x13,
-	0xd5, 0x60, 0xcf, 0x64, 0xb5, 0x3b, 0x4e, 0xc7, 0xc3, 0x4d, 0xa7, 0xdd, 0xbc, 0x65, 0x7e, 0x94,
-	0x62, 0x8e, 0xfb, 0x49, 0x24, 0xa4, 0x50, 0x92, 0x8e, 0x09, 0x2d, 0x46, 0x26, 0xa7, 0xd3, 0xf1,
-	0xa2, 0xfa, 0xdb, 0x96, 0x7f, 0x1b, 0x57, 0x8a, 0xe6, 0x48, 0x77, 0x92, 0xb4, 0xad, 0x45, 0xcb,
-	0x2c, 0xac, 0xec, 0x96, 0xfe, 0xbe, 0xa9, 0x59, 0xff, 0xdc, 0xd4, 0xac, 0x7f, 0x6f, 0x6a, 0x56,
-	0xef, 0xa1, 0xfe, 0x4b, 0xf7, 0xfa, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb1, 0xd9, 0xa8, 0x18,
-	0x31, 0x0a, 0x00, 0x00,
+	// 919 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xdf, 0x52, 0xdb, 0x46,
+	0x14, 0xc6, 0x2b, 0xfe, 0xc4, 0xe4, 0xd8, 0x18, 0xb1, 0x34, 0xc1, 0xd0, 0xc4, 0x80, 0x52, 0xa6,
+	0xb4, 0x33, 0x31, 0x13, 0x72, 0x95, 0x8b, 0x4e, 0x47, 0x32, 0x9a, 0x98, 0xc4, 0x95, 0xa8, 0x24,
+	0xa7, 0xcd, 0xd5, 0x76, 0x6d, 0x6f, 0xb1, 0xa7, 0x66, 0x57, 0xf5, 0xae, 0x3d, 0xd0, 0xfb, 0x3e,
+	0x43, 0xdf, 0xa5, 0x4f, 0xd0, 0xcb, 0x3e, 0x42, 0x87, 0x9b, 0xbe, 0x46, 0x47, 0xd2, 0xca, 0xc8,
+	0x7f, 0x10, 0x5c, 0xe4, 0x8e, 0x3d, 0xe7, 0xfb, 0xbe, 0x73, 0x7e, 0xcb, 0x6a, 0xc6, 0x60, 0x84,
+	0x43, 0x2e, 0xf9, 0x71, 0x9b, 0x92, 0x0e, 0x67, 0xc7, 0xe1, 0x49, 0x78, 0x3c, 0x7e, 0x75, 0x7c,
+	0x49, 0x85, 0x20, 0x17, 0x54, 0xd4, 0xe2, 0x26, 0x7a, 0x4a, 0x65, 0x8f, 0x0e, 0xe9, 0xe8, 0xb2,
+	0x96, 0xc8, 0x6a, 0xe1, 0x49, 0x58, 0x1b, 0xbf, 0xda, 0xdd, 0x5b, 0xe4, 0x95, 0xd7, 0x61, 0x6a,
+	0x34, 0xde, 0xc2, 0x9a, 0xcd, 0xc6, 0x74, 0xc0, 0x43, 0x8a, 0x0e, 0xa0, 0x24, 0x42, 0xc2, 0x70,
+	0x87, 0x33, 0x49, 0xaf, 0x64, 0x45, 0xdb, 0xd7, 0x8e, 0x4a, 0x5e, 0x31, 0xaa, 0xd5, 0x93, 0x12,
+	0xaa, 0x40, 0x21, 0x24, 0xd7, 0x03, 0x4e, 0xba, 0x95, 0xa5, 0xb8, 0x9b, 0x1e, 0x8d, 0x77, 0xb0,
+	0x65, 0xc5, 0x53, 0xac, 0x01, 0xef, 0xfc, 0x6a, 0x32, 0xc6, 0x47, 0xac, 0x43, 0x11, 0x82, 0x95,
+	0x1e, 0x11, 0x3d, 0x95, 0x15, 0xff, 0x8d, 0xf6, 0xa0, 0x28, 0x06, 0x5c, 0x62, 0x36, 0xba, 0x6c,
+	0xd3, 0x61, 0x1c, 0xb4, 0xe2, 0x41, 0x54, 0x72, 0xe2, 0x8a, 0x71, 0x04, 0x28, 0x93, 0xe5, 0xd1,
+	0xdf, 0x46, 0x54, 0xc8, 0x45, 0x51, 0x86, 0x09, 0xd5, 0x79, 0xa5, 0x75, 0xed, 0x4f, 0xb2, 0x66,
+	0x87, 0x69, 0x73, 0xc3, 0xfe, 0xd4, 0xa6, 0x36, 0xf7, 0xa8, 0x08, 0x39, 0x13, 0x14, 0xbd, 0x81,
+	0xd5, 0x76, 0x54, 0x88, 0x2d, 0xc5, 0x93, 0x17, 0xb5, 0xc5, 0x57, 0x5c, 0xcb, 0x7a, 0x13, 0x07,
+	0xb2, 0xa1, 0x48, 0xa4, 0xa4, 0x42, 0x12, 0xd9, 0xe7, 0xac, 0xb2, 0x94, 0x1f, 0x60, 0xde, 0x4a,
+	0xbd, 0xac, 0xcf, 0x68, 0xc1, 0x8e, 0x45, 0x64, 0xa7, 0x47, 0xbb, 0x0b, 0x6e, 0xe3, 0x39, 0x80,
+	0x90, 0x64, 0x28, 0x71, 0x84, 0xa2, 0xb0, 0x1e, 0xc7, 0x95, 0x08, 0x1e, 0xed, 0xc0, 0x1a, 0x65,
+	0xdd, 0xa4, 0x99, 0x5c, 0x70, 0x81, 0xb2, 0x6e, 0xd4, 0x32, 0x7a, 0xb0, 0xbb, 0x28, 0x56, 0x61,
+	0xbf, 0x83, 0x72, 0x3b, 0xe9, 0xe2, 0x18, 0x46, 0x54, 0xb4, 0xfd, 0xe5, 0x87, 0xf2, 0xaf, 0x2b,
+	0x6b, 0x7c, 0x12, 0x06, 0x02, 0xbd, 0xde, 0x23, 0x7d, 0xd6, 0xa0, 0xa4, 0xab, 0xf6, 0x36, 0xfe,
+	0xd2, 0x60, 0x33, 0x53, 0x54, 0x53, 0x0f, 0xa1, 0xdc, 0x21, 0x8c, 0xb3, 0x7e, 0x87, 0x0c, 0xb2,
+	0x44, 0xeb, 0x93, 0x6a, 0x4c, 0xf5, 0x2d, 0x7c, 0x91, 0x91, 0x49, 0x22, 0x29, 0x1e, 0x72, 0x2e,
+	0x71, 0xf4, 0x16, 0x5e, 0x9f, 0xa8, 0x27, 0x59, 0xb9, 0xf5, 0x44, 0x0a, 0x8f, 0x73, 0xd9, 0x88,
+	0xfb, 0xe8, 0x3b, 0x78, 0xf6, 0x4b, 0x9f, 0x91, 0x41, 0xff, 0x77, 0xda, 0x9d, 0xb7, 0x8b, 0xca,
+	0x72, 0xec, 0xdf, 0x99, 0x68, 0x66, 0xfc, 0xc2, 0x78, 0x09, 0xdb, 0x09, 0x6e, 0xdc, 0x89, 0xaa,
+	0x79, 0x0f, 0xdd, 0x68, 0x01, 0xca, 0xc8, 0xd3, 0xff, 0xdc, 0x7d, 0x5b, 0x68, 0xf7, 0x6d, 0xd1,
+	0x49, 0x1f, 0xac, 0x8a, 0x55, 0x77, 0xd8, 0x84, 0x8d, 0x99, 0xdc, 0x87, 0x3d, 0xdd, 0x24, 0xa5,
+	0x3c, 0x3d, 0xcf, 0xf8, 0x1a, 0xb6, 0x32, 0x0f, 0x33, 0x17, 0xf3, 0x08, 0x50, 0xf6, 0x0d, 0xe7,
+	0x7c, 0xae, 0xe1, 0x54, 0xe8, 0x64, 0xf3, 0x05, 0xd2, 0x4f, 0xf5, 0x0d, 0xd5, 0xa0, 0x72, 0x3e,
+	0xe4, 0x21, 0x17, 0x74, 0xe8, 0x0f, 0x88, 0xe8, 0xf5, 0xd9, 0x45, 0x2e, 0xcb, 0x4b, 0xd8, 0x9e,
+	0xd5, 0xe7, 0x01, 0xfd, 0xa1, 0xcd, 0xe7, 0xe7, 0x62, 0xb5, 0x60, 0x33, 0x54, 0x7a, 0x2c, 0x94,
+	0x41, 0xc1, 0x1d, 0xdd, 0x05, 0x37, 0x37, 0x40, 0x0f, 0x67, 0x2a, 0x11, 0x66, 0x72, 0x05, 0x0f,
+	0xc7, 0x9c, 0xd5, 0xdf, 0x87, 0x39, 0xaf, 0xcf, 0xc7, 0x4c, 0xf5, 0x0f, 0xc6, 0x9c, 0x1b, 0xa0,
+	0xcf, 0x56, 0x8c, 0x43, 0xd8, 0x38, 0xa5, 0x21, 0x17, 0x7d, 0x99, 0x4b, 0xf7, 0x25, 0x94, 0x95,
+	0x2c, 0x0f, 0xea, 0xe7, 0x49, 0x58, 0x2e, 0xca, 0x1b, 0x28, 0x74, 0x13, 0x99, 0x02, 0xd8, 0xbb,
+	0x0b, 0x20, 0x4d, 0x4b, 0xf5, 0x86, 0x01, 0x25, 0xfb, 0xea, 0x9e, 0x5d, 0x0f, 0xa0, 0x68, 0x5f,
+	0xe5, 0x2f, 0x1a, 0x26, 0x31, 0xb9, 0x5b, 0x36, 0xa1, 0x3c, 0xe6, 0x83, 0x11, 0x93, 0x64, 0x78,
+	0x8d, 0xe9, 0xd5, 0x64, 0xd9, 0xc3, 0xbb, 0x96, 0xfd, 0x90, 0xaa, 0xe3, 0xe8, 0xf5, 0x71, 0xf6,
+	0xf8, 0xcd, 0x7f, 0xcb, 0xb0, 0x1a, 0xf0, 0xb0, 0xdf, 0x41, 0x45, 0x28, 0xb4, 0x9c, 0xf7, 0x8e,
+	0xfb, 0xa3, 0xa3, 0x7f, 0x86, 0x76, 0xe0, 0x89, 0x65, 0x9b, 0x75, 0xd7, 0xc1, 0x56, 0xd3, 0xad,
+	0xbf, 0xc7, 0xa6, 0xe3, 0xb8, 0x2d, 0xa7, 0x6e, 0xeb, 0x1a, 0xaa, 0xc0, 0xe7, 0x53, 0x2d, 0xcf,
+	0xfe, 0xa1, 0x65, 0xfb, 0x81, 0xbe, 0x84, 0xbe, 0x82, 0x17, 0x8b, 0x3a, 0xd8, 0xfa, 0x88, 0xfd,
+	0xa6, 0x1b, 0x60, 0xa7, 0xf5, 0xbd, 0x65, 0x7b, 0xfa, 0xf2, 0x5c, 0xba, 0x67, 0xfb, 0xe7, 0xae,
+	0xe3, 0xdb, 0xfa, 0x0a, 0xda, 0x87, 0x67, 0x96, 0x19, 0xd4, 0x1b, 0xf6, 0x29, 0x5e, 0x38, 0x65,
+	0x15, 0x1d, 0xc0, 0xf3, 0x3b, 0x14, 0x2a, 0xe4, 0x11, 0x7a, 0x0a, 0xa8, 0xde, 0x30, 0xcf, 0x1c,
+	0xdc, 0xb0, 0xcd, 0xd3, 0x89, 0xb5, 0x80, 0xb6, 0x61, 0x6b, 0xaa, 0xae, 0x0c, 0x6b, 0xa8, 0x0a,
+	0xbb, 0x2a, 0xcb, 0x0f, 0xcc, 0xc0, 0xc6, 0x0d, 0xd3, 0x6f, 0xdc, 0x32, 0x3f, 0xce, 0x30, 0x27,
+	0xfd, 0x34, 0x12, 0x32, 0x28, 0x69, 0x47, 0x85, 0x16, 0x23, 0x93, 0x19, 0x04, 0x76, 0x54, 0x3f,
+	0x73, 0x9d, 0xdb, 0xb8, 0x52, 0xb4, 0x47, 0xb6, 0x93, 0xa6, 0xad, 0xcf, 0x5a, 0x26, 0x61, 0x65,
+	0x84, 0xa0, 0xfc, 0xc1, 0x6d, 0xb6, 0x9c, 0xc0, 0xf4, 0x3e, 0x62, 0xfb, 0xa7, 0xb3, 0x40, 0xdf,
+	0x40, 0x4f, 0x60, 0xf3, 0xdc, 0x73, 0xcf, 0x5d, 0xdf, 0xf6, 0xb0, 0xdf, 0x34, 0xfd, 0xc6, 0x99,
+	0xf3, 0x56, 0xd7, 0xa3, 0x72, 0x12, 0x92, 0x2d, 0x6f, 0x5a, 0xa5, 0xbf, 0x6f, 0xaa, 0xda, 0x3f,
+	0x37, 0x55, 0xed, 0xdf, 0x9b, 0xaa, 0xd6, 0x7e, 0x14, 0xff, 0x28, 0x7c, 0xfd, 0xff, 0x00, 0xdf,
+	0xad, 0x68, 0x4d, 0x73, 0x0a, 0x00, 0x00,
 }
 
 func (m *Envelope) Marshal() (dAtA []byte, err error) {
@@ -221,6 +221,167 @@ func (m *ValidatorPerformanceResponse) GetAverageValidatorBalance() float32 {
 	return 0
 }
 
+type ValidatorAttestationsRequest struct {
+	PublicKeys           [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	SinceSlot            uint64   `protobuf:"varint,2,opt,name=since_slot,json=sinceSlot,proto3" json:"since_slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorAttestationsRequest) Reset()         { *m = ValidatorAttestationsRequest{} }
+func (m *ValidatorAttestationsRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorAttestationsRequest) ProtoMessage()    {}
+func (*ValidatorAttestationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{2}
+}
+func (m *ValidatorAttestationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorAttestationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorAttestationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorAttestationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorAttestationsRequest.Merge(m, src)
+}
+func (m *ValidatorAttestationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorAttestationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorAttestationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorAttestationsRequest proto.InternalMessageInfo
+
+func (m *ValidatorAttestationsRequest) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+func (m *ValidatorAttestationsRequest) GetSinceSlot() uint64 {
+	if m != nil {
+		return m.SinceSlot
+	}
+	return 0
+}
+
+type ValidatorAttestationsResponse struct {
+	Attestations         []*ValidatorAttestationsResponse_ValidatorAttestation `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                              `json:"-"`
+	XXX_unrecognized     []byte                                                `json:"-"`
+	XXX_sizecache        int32                                                 `json:"-"`
+}
+
+func (m *ValidatorAttestationsResponse) Reset()         { *m = ValidatorAttestationsResponse{} }
+func (m *ValidatorAttestationsResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorAttestationsResponse) ProtoMessage()    {}
+func (*ValidatorAttestationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{3}
+}
+func (m *ValidatorAttestationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorAttestationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorAttestationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorAttestationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorAttestationsResponse.Merge(m, src)
+}
+func (m *ValidatorAttestationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorAttestationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorAttestationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorAttestationsResponse proto.InternalMessageInfo
+
+func (m *ValidatorAttestationsResponse) GetAttestations() []*ValidatorAttestationsResponse_ValidatorAttestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+type ValidatorAttestationsResponse_ValidatorAttestation struct {
+	PublicKey            []byte              `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Data                 *v1.AttestationData `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ValidatorAttestationsResponse_ValidatorAttestation) Reset() {
+	*m = ValidatorAttestationsResponse_ValidatorAttestation{}
+}
+func (m *ValidatorAttestationsResponse_ValidatorAttestation) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ValidatorAttestationsResponse_ValidatorAttestation) ProtoMessage() {}
+func (*ValidatorAttestationsResponse_ValidatorAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{3, 0}
+}
+func (m *ValidatorAttestationsResponse_ValidatorAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorAttestationsResponse_ValidatorAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorAttestationsResponse_ValidatorAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorAttestationsResponse_ValidatorAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorAttestationsResponse_ValidatorAttestation.Merge(m, src)
+}
+func (m *ValidatorAttestationsResponse_ValidatorAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorAttestationsResponse_ValidatorAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorAttestationsResponse_ValidatorAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorAttestationsResponse_ValidatorAttestation proto.InternalMessageInfo
+
+func (m *ValidatorAttestationsResponse_ValidatorAttestation) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *ValidatorAttestationsResponse_ValidatorAttestation) GetData() *v1.AttestationData {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 type ValidatorActivationRequest struct {
 	Pubkey               []byte   `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -232,7 +393,7 @@ func (m *ValidatorActivationRequest) Reset()         { *m = ValidatorActivationR
 func (m *ValidatorActivationRequest) String() string { return proto.CompactTextString(m) }
 func (*ValidatorActivationRequest) ProtoMessage()    {}
 func (*ValidatorActivationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{2}
+	return fileDescriptor_9eb4e94b85965285, []int{4}
 }
 func (m *ValidatorActivationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -279,7 +440,7 @@ func (m *ValidatorActivationResponse) Reset()         { *m = ValidatorActivation
 func (m *ValidatorActivationResponse) String() string { return proto.CompactTextString(m) }
 func (*ValidatorActivationResponse) ProtoMessage()    {}
 func (*ValidatorActivationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{3}
+	return fileDescriptor_9eb4e94b85965285, []int{5}
 }
 func (m *ValidatorActivationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -327,7 +488,7 @@ func (m *AttestationDataRequest) Reset()         { *m = AttestationDataRequest{}
 func (m *AttestationDataRequest) String() string { return proto.CompactTextString(m) }
 func (*AttestationDataRequest) ProtoMessage()    {}
 func (*AttestationDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{4}
+	return fileDescriptor_9eb4e94b85965285, []int{6}
 }
 func (m *AttestationDataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,7 +547,7 @@ func (m *AttestationDataResponse) Reset()         { *m = AttestationDataResponse
 func (m *AttestationDataResponse) String() string { return proto.CompactTextString(m) }
 func (*AttestationDataResponse) ProtoMessage()    {}
 func (*AttestationDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{5}
+	return fileDescriptor_9eb4e94b85965285, []int{7}
 }
 func (m *AttestationDataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -469,7 +630,7 @@ func (m *PendingAttestationsRequest) Reset()         { *m = PendingAttestationsR
 func (m *PendingAttestationsRequest) String() string { return proto.CompactTextString(m) }
 func (*PendingAttestationsRequest) ProtoMessage()    {}
 func (*PendingAttestationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{6}
+	return fileDescriptor_9eb4e94b85965285, []int{8}
 }
 func (m *PendingAttestationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -523,7 +684,7 @@ func (m *PendingAttestationsResponse) Reset()         { *m = PendingAttestations
 func (m *PendingAttestationsResponse) String() string { return proto.CompactTextString(m) }
 func (*PendingAttestationsResponse) ProtoMessage()    {}
 func (*PendingAttestationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{7}
+	return fileDescriptor_9eb4e94b85965285, []int{9}
 }
 func (m *PendingAttestationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,7 +732,7 @@ func (m *ChainStartResponse) Reset()         { *m = ChainStartResponse{} }
 func (m *ChainStartResponse) String() string { return proto.CompactTextString(m) }
 func (*ChainStartResponse) ProtoMessage()    {}
 func (*ChainStartResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{8}
+	return fileDescriptor_9eb4e94b85965285, []int{10}
 }
 func (m *ChainStartResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -630,7 +791,7 @@ func (m *ProposeRequest) Reset()         { *m = ProposeRequest{} }
 func (m *ProposeRequest) String() string { return proto.CompactTextString(m) }
 func (*ProposeRequest) ProtoMessage()    {}
 func (*ProposeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{9}
+	return fileDescriptor_9eb4e94b85965285, []int{11}
 }
 func (m *ProposeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -712,7 +873,7 @@ func (m *ProposeResponse) Reset()         { *m = ProposeResponse{} }
 func (m *ProposeResponse) String() string { return proto.CompactTextString(m) }
 func (*ProposeResponse) ProtoMessage()    {}
 func (*ProposeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{10}
+	return fileDescriptor_9eb4e94b85965285, []int{12}
 }
 func (m *ProposeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -759,7 +920,7 @@ func (m *ProposerIndexRequest) Reset()         { *m = ProposerIndexRequest{} }
 func (m *ProposerIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*ProposerIndexRequest) ProtoMessage()    {}
 func (*ProposerIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{11}
+	return fileDescriptor_9eb4e94b85965285, []int{13}
 }
 func (m *ProposerIndexRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -806,7 +967,7 @@ func (m *ProposerIndexResponse) Reset()         { *m = ProposerIndexResponse{} }
 func (m *ProposerIndexResponse) String() string { return proto.CompactTextString(m) }
 func (*ProposerIndexResponse) ProtoMessage()    {}
 func (*ProposerIndexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{12}
+	return fileDescriptor_9eb4e94b85965285, []int{14}
 }
 func (m *ProposerIndexResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -853,7 +1014,7 @@ func (m *StateRootResponse) Reset()         { *m = StateRootResponse{} }
 func (m *StateRootResponse) String() string { return proto.CompactTextString(m) }
 func (*StateRootResponse) ProtoMessage()    {}
 func (*StateRootResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{13}
+	return fileDescriptor_9eb4e94b85965285, []int{15}
 }
 func (m *StateRootResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -900,7 +1061,7 @@ func (m *AttestResponse) Reset()         { *m = AttestResponse{} }
 func (m *AttestResponse) String() string { return proto.CompactTextString(m) }
 func (*AttestResponse) ProtoMessage()    {}
 func (*AttestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{14}
+	return fileDescriptor_9eb4e94b85965285, []int{16}
 }
 func (m *AttestResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -947,7 +1108,7 @@ func (m *ValidatorIndexRequest) Reset()         { *m = ValidatorIndexRequest{} }
 func (m *ValidatorIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*ValidatorIndexRequest) ProtoMessage()    {}
 func (*ValidatorIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{15}
+	return fileDescriptor_9eb4e94b85965285, []int{17}
 }
 func (m *ValidatorIndexRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -994,7 +1155,7 @@ func (m *ValidatorIndexResponse) Reset()         { *m = ValidatorIndexResponse{}
 func (m *ValidatorIndexResponse) String() string { return proto.CompactTextString(m) }
 func (*ValidatorIndexResponse) ProtoMessage()    {}
 func (*ValidatorIndexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{16}
+	return fileDescriptor_9eb4e94b85965285, []int{18}
 }
 func (m *ValidatorIndexResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1042,7 +1203,7 @@ func (m *CommitteeAssignmentsRequest) Reset()         { *m = CommitteeAssignment
 func (m *CommitteeAssignmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*CommitteeAssignmentsRequest) ProtoMessage()    {}
 func (*CommitteeAssignmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{17}
+	return fileDescriptor_9eb4e94b85965285, []int{19}
 }
 func (m *CommitteeAssignmentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1096,7 +1257,7 @@ func (m *PendingDepositsResponse) Reset()         { *m = PendingDepositsResponse
 func (m *PendingDepositsResponse) String() string { return proto.CompactTextString(m) }
 func (*PendingDepositsResponse) ProtoMessage()    {}
 func (*PendingDepositsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{18}
+	return fileDescriptor_9eb4e94b85965285, []int{20}
 }
 func (m *PendingDepositsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1132,25 +1293,26 @@ func (m *PendingDepositsResponse) GetPendingDeposits() []*v1.Deposit {
 	return nil
 }
 
-type CommitteeAssignmentResponse struct {
-	Assignment           []*CommitteeAssignmentResponse_CommitteeAssignment `protobuf:"bytes,1,rep,name=assignment,proto3" json:"assignment,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
-	XXX_unrecognized     []byte                                             `json:"-"`
-	XXX_sizecache        int32                                              `json:"-"`
+type ProduceBlockRequest struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	RandaoReveal         []byte   `protobuf:"bytes,2,opt,name=randao_reveal,json=randaoReveal,proto3" json:"randao_reveal,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CommitteeAssignmentResponse) Reset()         { *m = CommitteeAssignmentResponse{} }
-func (m *CommitteeAssignmentResponse) String() string { return proto.CompactTextString(m) }
-func (*CommitteeAssignmentResponse) ProtoMessage()    {}
-func (*CommitteeAssignmentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{19}
+func (m *ProduceBlockRequest) Reset()         { *m = ProduceBlockRequest{} }
+func (m *ProduceBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*ProduceBlockRequest) ProtoMessage()    {}
+func (*ProduceBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{21}
 }
-func (m *CommitteeAssignmentResponse) XXX_Unmarshal(b []byte) error {
+func (m *ProduceBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CommitteeAssignmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ProduceBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CommitteeAssignmentResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ProduceBlockRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1160,53 +1322,51 @@ func (m *CommitteeAssignmentResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *CommitteeAssignmentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CommitteeAssignmentResponse.Merge(m, src)
+func (m *ProduceBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProduceBlockRequest.Merge(m, src)
 }
-func (m *CommitteeAssignmentResponse) XXX_Size() int {
+func (m *ProduceBlockRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CommitteeAssignmentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CommitteeAssignmentResponse.DiscardUnknown(m)
+func (m *ProduceBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProduceBlockRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CommitteeAssignmentResponse proto.InternalMessageInfo
+var xxx_messageInfo_ProduceBlockRequest proto.InternalMessageInfo
 
-func (m *CommitteeAssignmentResponse) GetAssignment() []*CommitteeAssignmentResponse_CommitteeAssignment {
+func (m *ProduceBlockRequest) GetSlot() uint64 {
 	if m != nil {
-		return m.Assignment
+		return m.Slot
 	}
-	return nil
+	return 0
 }
 
-type CommitteeAssignmentResponse_CommitteeAssignment struct {
-	Committee            []uint64        `protobuf:"varint,1,rep,packed,name=committee,proto3" json:"committee,omitempty"`
-	Shard                uint64          `protobuf:"varint,2,opt,name=shard,proto3" json:"shard,omitempty"`
-	Slot                 uint64          `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
-	IsProposer           bool            `protobuf:"varint,4,opt,name=is_proposer,json=isProposer,proto3" json:"is_proposer,omitempty"`
-	PublicKey            []byte          `protobuf:"bytes,5,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	Status               ValidatorStatus `protobuf:"varint,6,opt,name=status,proto3,enum=ethereum.beacon.rpc.v1.ValidatorStatus" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+func (m *ProduceBlockRequest) GetRandaoReveal() []byte {
+	if m != nil {
+		return m.RandaoReveal
+	}
+	return nil
 }
 
-func (m *CommitteeAssignmentResponse_CommitteeAssignment) Reset() {
-	*m = CommitteeAssignmentResponse_CommitteeAssignment{}
-}
-func (m *CommitteeAssignmentResponse_CommitteeAssignment) String() string {
-	return proto.CompactTextString(m)
+type PendingBlockOperationsRequest struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*CommitteeAssignmentResponse_CommitteeAssignment) ProtoMessage() {}
-func (*CommitteeAssignmentResponse_CommitteeAssignment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{19, 0}
+
+func (m *PendingBlockOperationsRequest) Reset()         { *m = PendingBlockOperationsRequest{} }
+func (m *PendingBlockOperationsRequest) String() string { return proto.CompactTextString(m) }
+func (*PendingBlockOperationsRequest) ProtoMessage()    {}
+func (*PendingBlockOperationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{22}
 }
-func (m *CommitteeAssignmentResponse_CommitteeAssignment) XXX_Unmarshal(b []byte) error {
+func (m *PendingBlockOperationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CommitteeAssignmentResponse_CommitteeAssignment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PendingBlockOperationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CommitteeAssignmentResponse_CommitteeAssignment.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PendingBlockOperationsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1216,79 +1376,107 @@ func (m *CommitteeAssignmentResponse_CommitteeAssignment) XXX_Marshal(b []byte,
 		return b[:n], nil
 	}
 }
-func (m *CommitteeAssignmentResponse_CommitteeAssignment) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CommitteeAssignmentResponse_CommitteeAssignment.Merge(m, src)
+func (m *PendingBlockOperationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingBlockOperationsRequest.Merge(m, src)
 }
-func (m *CommitteeAssignmentResponse_CommitteeAssignment) XXX_Size() int {
+func (m *PendingBlockOperationsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CommitteeAssignmentResponse_CommitteeAssignment) XXX_DiscardUnknown() {
-	xxx_messageInfo_CommitteeAssignmentResponse_CommitteeAssignment.DiscardUnknown(m)
+func (m *PendingBlockOperationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingBlockOperationsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CommitteeAssignmentResponse_CommitteeAssignment proto.InternalMessageInfo
+var xxx_messageInfo_PendingBlockOperationsRequest proto.InternalMessageInfo
 
-func (m *CommitteeAssignmentResponse_CommitteeAssignment) GetCommittee() []uint64 {
+func (m *PendingBlockOperationsRequest) GetSlot() uint64 {
 	if m != nil {
-		return m.Committee
+		return m.Slot
 	}
-	return nil
+	return 0
 }
 
-func (m *CommitteeAssignmentResponse_CommitteeAssignment) GetShard() uint64 {
-	if m != nil {
-		return m.Shard
-	}
-	return 0
+type PendingBlockOperationsResponse struct {
+	ProposerSlashings    []*v1.ProposerSlashing `protobuf:"bytes,1,rep,name=proposer_slashings,json=proposerSlashings,proto3" json:"proposer_slashings,omitempty"`
+	AttesterSlashings    []*v1.AttesterSlashing `protobuf:"bytes,2,rep,name=attester_slashings,json=attesterSlashings,proto3" json:"attester_slashings,omitempty"`
+	VoluntaryExits       []*v1.VoluntaryExit    `protobuf:"bytes,3,rep,name=voluntary_exits,json=voluntaryExits,proto3" json:"voluntary_exits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
-func (m *CommitteeAssignmentResponse_CommitteeAssignment) GetSlot() uint64 {
-	if m != nil {
-		return m.Slot
+func (m *PendingBlockOperationsResponse) Reset()         { *m = PendingBlockOperationsResponse{} }
+func (m *PendingBlockOperationsResponse) String() string { return proto.CompactTextString(m) }
+func (*PendingBlockOperationsResponse) ProtoMessage()    {}
+func (*PendingBlockOperationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{23}
+}
+func (m *PendingBlockOperationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingBlockOperationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingBlockOperationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *PendingBlockOperationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingBlockOperationsResponse.Merge(m, src)
+}
+func (m *PendingBlockOperationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingBlockOperationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingBlockOperationsResponse.DiscardUnknown(m)
 }
 
-func (m *CommitteeAssignmentResponse_CommitteeAssignment) GetIsProposer() bool {
+var xxx_messageInfo_PendingBlockOperationsResponse proto.InternalMessageInfo
+
+func (m *PendingBlockOperationsResponse) GetProposerSlashings() []*v1.ProposerSlashing {
 	if m != nil {
-		return m.IsProposer
+		return m.ProposerSlashings
 	}
-	return false
+	return nil
 }
 
-func (m *CommitteeAssignmentResponse_CommitteeAssignment) GetPublicKey() []byte {
+func (m *PendingBlockOperationsResponse) GetAttesterSlashings() []*v1.AttesterSlashing {
 	if m != nil {
-		return m.PublicKey
+		return m.AttesterSlashings
 	}
 	return nil
 }
 
-func (m *CommitteeAssignmentResponse_CommitteeAssignment) GetStatus() ValidatorStatus {
+func (m *PendingBlockOperationsResponse) GetVoluntaryExits() []*v1.VoluntaryExit {
 	if m != nil {
-		return m.Status
+		return m.VoluntaryExits
 	}
-	return ValidatorStatus_UNKNOWN_STATUS
+	return nil
 }
 
-type ValidatorStatusResponse struct {
-	Status               ValidatorStatus `protobuf:"varint,1,opt,name=status,proto3,enum=ethereum.beacon.rpc.v1.ValidatorStatus" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type ProposeExitResponse struct {
+	ExitHash             []byte   `protobuf:"bytes,1,opt,name=exit_hash,json=exitHash,proto3" json:"exit_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ValidatorStatusResponse) Reset()         { *m = ValidatorStatusResponse{} }
-func (m *ValidatorStatusResponse) String() string { return proto.CompactTextString(m) }
-func (*ValidatorStatusResponse) ProtoMessage()    {}
-func (*ValidatorStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{20}
+func (m *ProposeExitResponse) Reset()         { *m = ProposeExitResponse{} }
+func (m *ProposeExitResponse) String() string { return proto.CompactTextString(m) }
+func (*ProposeExitResponse) ProtoMessage()    {}
+func (*ProposeExitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{24}
 }
-func (m *ValidatorStatusResponse) XXX_Unmarshal(b []byte) error {
+func (m *ProposeExitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValidatorStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ProposeExitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValidatorStatusResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ProposeExitResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1298,13 +1486,198 @@ func (m *ValidatorStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *ValidatorStatusResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorStatusResponse.Merge(m, src)
+func (m *ProposeExitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposeExitResponse.Merge(m, src)
 }
-func (m *ValidatorStatusResponse) XXX_Size() int {
+func (m *ProposeExitResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValidatorStatusResponse) XXX_DiscardUnknown() {
+func (m *ProposeExitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposeExitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposeExitResponse proto.InternalMessageInfo
+
+func (m *ProposeExitResponse) GetExitHash() []byte {
+	if m != nil {
+		return m.ExitHash
+	}
+	return nil
+}
+
+type CommitteeAssignmentResponse struct {
+	Assignment           []*CommitteeAssignmentResponse_CommitteeAssignment `protobuf:"bytes,1,rep,name=assignment,proto3" json:"assignment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_unrecognized     []byte                                             `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *CommitteeAssignmentResponse) Reset()         { *m = CommitteeAssignmentResponse{} }
+func (m *CommitteeAssignmentResponse) String() string { return proto.CompactTextString(m) }
+func (*CommitteeAssignmentResponse) ProtoMessage()    {}
+func (*CommitteeAssignmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{25}
+}
+func (m *CommitteeAssignmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitteeAssignmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitteeAssignmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitteeAssignmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitteeAssignmentResponse.Merge(m, src)
+}
+func (m *CommitteeAssignmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitteeAssignmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitteeAssignmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitteeAssignmentResponse proto.InternalMessageInfo
+
+func (m *CommitteeAssignmentResponse) GetAssignment() []*CommitteeAssignmentResponse_CommitteeAssignment {
+	if m != nil {
+		return m.Assignment
+	}
+	return nil
+}
+
+type CommitteeAssignmentResponse_CommitteeAssignment struct {
+	Committee            []uint64        `protobuf:"varint,1,rep,packed,name=committee,proto3" json:"committee,omitempty"`
+	Shard                uint64          `protobuf:"varint,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	Slot                 uint64          `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	IsProposer           bool            `protobuf:"varint,4,opt,name=is_proposer,json=isProposer,proto3" json:"is_proposer,omitempty"`
+	PublicKey            []byte          `protobuf:"bytes,5,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Status               ValidatorStatus `protobuf:"varint,6,opt,name=status,proto3,enum=ethereum.beacon.rpc.v1.ValidatorStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CommitteeAssignmentResponse_CommitteeAssignment) Reset() {
+	*m = CommitteeAssignmentResponse_CommitteeAssignment{}
+}
+func (m *CommitteeAssignmentResponse_CommitteeAssignment) String() string {
+	return proto.CompactTextString(m)
+}
+func (*CommitteeAssignmentResponse_CommitteeAssignment) ProtoMessage() {}
+func (*CommitteeAssignmentResponse_CommitteeAssignment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{25, 0}
+}
+func (m *CommitteeAssignmentResponse_CommitteeAssignment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitteeAssignmentResponse_CommitteeAssignment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitteeAssignmentResponse_CommitteeAssignment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitteeAssignmentResponse_CommitteeAssignment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitteeAssignmentResponse_CommitteeAssignment.Merge(m, src)
+}
+func (m *CommitteeAssignmentResponse_CommitteeAssignment) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitteeAssignmentResponse_CommitteeAssignment) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitteeAssignmentResponse_CommitteeAssignment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitteeAssignmentResponse_CommitteeAssignment proto.InternalMessageInfo
+
+func (m *CommitteeAssignmentResponse_CommitteeAssignment) GetCommittee() []uint64 {
+	if m != nil {
+		return m.Committee
+	}
+	return nil
+}
+
+func (m *CommitteeAssignmentResponse_CommitteeAssignment) GetShard() uint64 {
+	if m != nil {
+		return m.Shard
+	}
+	return 0
+}
+
+func (m *CommitteeAssignmentResponse_CommitteeAssignment) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *CommitteeAssignmentResponse_CommitteeAssignment) GetIsProposer() bool {
+	if m != nil {
+		return m.IsProposer
+	}
+	return false
+}
+
+func (m *CommitteeAssignmentResponse_CommitteeAssignment) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *CommitteeAssignmentResponse_CommitteeAssignment) GetStatus() ValidatorStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ValidatorStatus_UNKNOWN_STATUS
+}
+
+type ValidatorStatusResponse struct {
+	Status               ValidatorStatus `protobuf:"varint,1,opt,name=status,proto3,enum=ethereum.beacon.rpc.v1.ValidatorStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ValidatorStatusResponse) Reset()         { *m = ValidatorStatusResponse{} }
+func (m *ValidatorStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorStatusResponse) ProtoMessage()    {}
+func (*ValidatorStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{26}
+}
+func (m *ValidatorStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorStatusResponse.Merge(m, src)
+}
+func (m *ValidatorStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorStatusResponse) XXX_DiscardUnknown() {
 	xxx_messageInfo_ValidatorStatusResponse.DiscardUnknown(m)
 }
 
@@ -1328,7 +1701,7 @@ func (m *Eth1DataResponse) Reset()         { *m = Eth1DataResponse{} }
 func (m *Eth1DataResponse) String() string { return proto.CompactTextString(m) }
 func (*Eth1DataResponse) ProtoMessage()    {}
 func (*Eth1DataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{21}
+	return fileDescriptor_9eb4e94b85965285, []int{27}
 }
 func (m *Eth1DataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1364,11 +1737,69 @@ func (m *Eth1DataResponse) GetEth1Data() *v1.Eth1Data {
 	return nil
 }
 
+type SyncStatusResponse struct {
+	Syncing              bool     `protobuf:"varint,1,opt,name=syncing,proto3" json:"syncing,omitempty"`
+	HeadSlot             uint64   `protobuf:"varint,2,opt,name=head_slot,json=headSlot,proto3" json:"head_slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncStatusResponse) Reset()         { *m = SyncStatusResponse{} }
+func (m *SyncStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*SyncStatusResponse) ProtoMessage()    {}
+func (*SyncStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{28}
+}
+func (m *SyncStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncStatusResponse.Merge(m, src)
+}
+func (m *SyncStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncStatusResponse proto.InternalMessageInfo
+
+func (m *SyncStatusResponse) GetSyncing() bool {
+	if m != nil {
+		return m.Syncing
+	}
+	return false
+}
+
+func (m *SyncStatusResponse) GetHeadSlot() uint64 {
+	if m != nil {
+		return m.HeadSlot
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorRole", ValidatorRole_name, ValidatorRole_value)
 	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorStatus", ValidatorStatus_name, ValidatorStatus_value)
 	proto.RegisterType((*ValidatorPerformanceRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceRequest")
 	proto.RegisterType((*ValidatorPerformanceResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceResponse")
+	proto.RegisterType((*ValidatorAttestationsRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorAttestationsRequest")
+	proto.RegisterType((*ValidatorAttestationsResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorAttestationsResponse")
+	proto.RegisterType((*ValidatorAttestationsResponse_ValidatorAttestation)(nil), "ethereum.beacon.rpc.v1.ValidatorAttestationsResponse.ValidatorAttestation")
 	proto.RegisterType((*ValidatorActivationRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationRequest")
 	proto.RegisterType((*ValidatorActivationResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationResponse")
 	proto.RegisterType((*AttestationDataRequest)(nil), "ethereum.beacon.rpc.v1.AttestationDataRequest")
@@ -1386,119 +1817,144 @@ func init() {
 	proto.RegisterType((*ValidatorIndexResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexResponse")
 	proto.RegisterType((*CommitteeAssignmentsRequest)(nil), "ethereum.beacon.rpc.v1.CommitteeAssignmentsRequest")
 	proto.RegisterType((*PendingDepositsResponse)(nil), "ethereum.beacon.rpc.v1.PendingDepositsResponse")
+	proto.RegisterType((*ProduceBlockRequest)(nil), "ethereum.beacon.rpc.v1.ProduceBlockRequest")
+	proto.RegisterType((*PendingBlockOperationsRequest)(nil), "ethereum.beacon.rpc.v1.PendingBlockOperationsRequest")
+	proto.RegisterType((*PendingBlockOperationsResponse)(nil), "ethereum.beacon.rpc.v1.PendingBlockOperationsResponse")
+	proto.RegisterType((*ProposeExitResponse)(nil), "ethereum.beacon.rpc.v1.ProposeExitResponse")
 	proto.RegisterType((*CommitteeAssignmentResponse)(nil), "ethereum.beacon.rpc.v1.CommitteeAssignmentResponse")
 	proto.RegisterType((*CommitteeAssignmentResponse_CommitteeAssignment)(nil), "ethereum.beacon.rpc.v1.CommitteeAssignmentResponse.CommitteeAssignment")
 	proto.RegisterType((*ValidatorStatusResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorStatusResponse")
 	proto.RegisterType((*Eth1DataResponse)(nil), "ethereum.beacon.rpc.v1.Eth1DataResponse")
+	proto.RegisterType((*SyncStatusResponse)(nil), "ethereum.beacon.rpc.v1.SyncStatusResponse")
 }
 
 func init() { proto.RegisterFile("proto/beacon/rpc/v1/services.proto", fileDescriptor_9eb4e94b85965285) }
 
 var fileDescriptor_9eb4e94b85965285 = []byte{
-	// 1646 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4f, 0x6f, 0xdb, 0xca,
-	0x11, 0x7f, 0x94, 0x64, 0x3f, 0x7b, 0x2c, 0x5b, 0xf4, 0xfa, 0x8f, 0x54, 0x39, 0x2f, 0xf6, 0xe3,
-	0x03, 0x1a, 0xc7, 0x68, 0xa8, 0x58, 0x7e, 0x78, 0x2f, 0x68, 0x10, 0xa4, 0x92, 0xad, 0xc4, 0x6a,
-	0x0c, 0xc7, 0xa1, 0x94, 0xb8, 0x0d, 0x8a, 0x12, 0x2b, 0x69, 0x2d, 0xb1, 0xa6, 0xb8, 0x0c, 0xb9,
-	0x12, 0xe2, 0x4b, 0x8a, 0x1e, 0x8b, 0x7e, 0x87, 0x7e, 0x97, 0xde, 0x7a, 0xec, 0xad, 0xb7, 0xa2,
-	0xf0, 0xa1, 0xfd, 0x0a, 0x05, 0x7a, 0x29, 0x76, 0xb9, 0xa4, 0xa8, 0x3f, 0xf4, 0x9f, 0xde, 0xb8,
-	0x33, 0xf3, 0x9b, 0x9d, 0xf9, 0xed, 0xec, 0xec, 0x48, 0xa0, 0xb9, 0x1e, 0x65, 0xb4, 0xd4, 0x22,
-	0xb8, 0x4d, 0x9d, 0x92, 0xe7, 0xb6, 0x4b, 0xc3, 0xfd, 0x92, 0x4f, 0xbc, 0xa1, 0xd5, 0x26, 0xbe,
-	0x2e, 0x94, 0x68, 0x93, 0xb0, 0x1e, 0xf1, 0xc8, 0xa0, 0xaf, 0x07, 0x66, 0xba, 0xe7, 0xb6, 0xf5,
-	0xe1, 0x7e, 0x71, 0x7b, 0x0c, 0xeb, 0x96, 0x5d, 0x8e, 0x65, 0x57, 0x6e, 0x08, 0x2c, 0x6e, 0x75,
-	0x29, 0xed, 0xda, 0xa4, 0x24, 0x56, 0xad, 0xc1, 0x45, 0x89, 0xf4, 0x5d, 0x76, 0x25, 0x95, 0xdb,
-	0x93, 0x4a, 0x66, 0xf5, 0x89, 0xcf, 0x70, 0xdf, 0x0d, 0x0c, 0xb4, 0x33, 0xd8, 0xfa, 0x80, 0x6d,
-	0xab, 0x83, 0x19, 0xf5, 0xce, 0x88, 0x77, 0x41, 0xbd, 0x3e, 0x76, 0xda, 0xc4, 0x20, 0x9f, 0x06,
-	0xc4, 0x67, 0x08, 0x41, 0xc6, 0xb7, 0x29, 0x2b, 0x28, 0x3b, 0xca, 0x6e, 0xc6, 0x10, 0xdf, 0xe8,
-	0x1b, 0x00, 0x77, 0xd0, 0xb2, 0xad, 0xb6, 0x79, 0x49, 0xae, 0x0a, 0xa9, 0x1d, 0x65, 0x37, 0x6b,
-	0x2c, 0x06, 0x92, 0x37, 0xe4, 0x4a, 0xfb, 0xbb, 0x02, 0x0f, 0x66, 0xbb, 0xf4, 0x5d, 0xea, 0xf8,
-	0x04, 0x15, 0xe0, 0xeb, 0x16, 0xb6, 0xb9, 0x48, 0xba, 0x0d, 0x97, 0xe8, 0x31, 0xa8, 0x8c, 0x32,
-	0x6c, 0x9b, 0xc3, 0x10, 0xef, 0x0b, 0xff, 0x19, 0x23, 0x27, 0xe4, 0x91, 0x5b, 0x1f, 0xfd, 0x00,
-	0xf9, 0xc0, 0x14, 0xb7, 0x99, 0x35, 0x24, 0x71, 0x44, 0x5a, 0x20, 0x36, 0x84, 0xba, 0x22, 0xb4,
-	0x31, 0xdc, 0xcf, 0xe1, 0x27, 0x78, 0x48, 0x3c, 0xdc, 0x8d, 0x41, 0xcc, 0x30, 0x9c, 0xcc, 0x8e,
-	0xb2, 0x9b, 0x32, 0xf2, 0xd2, 0x20, 0x42, 0x55, 0x03, 0xb5, 0xf6, 0x3d, 0x14, 0x23, 0x99, 0x70,
-	0x8c, 0x99, 0x45, 0x9d, 0x90, 0xaa, 0x4d, 0x98, 0x77, 0x07, 0x2d, 0x4e, 0x89, 0x22, 0x28, 0x91,
-	0x2b, 0xed, 0xb7, 0x31, 0x86, 0xe3, 0x28, 0xc9, 0xc6, 0x4b, 0x58, 0x8c, 0x02, 0x11, 0xc8, 0xa5,
-	0xf2, 0xb7, 0xfa, 0x64, 0x2d, 0xb8, 0x65, 0x57, 0x1f, 0xee, 0xeb, 0x91, 0x1f, 0x63, 0x84, 0xd1,
-	0xaa, 0xb0, 0x59, 0x61, 0x8c, 0x1f, 0x2a, 0xf7, 0x7b, 0x84, 0x19, 0x0e, 0x23, 0x5a, 0x87, 0x39,
-	0xbf, 0x87, 0xbd, 0x8e, 0xa4, 0x39, 0x58, 0x44, 0x47, 0x9a, 0x1a, 0x1d, 0xa9, 0x76, 0x9d, 0x82,
-	0xfc, 0x94, 0x13, 0x19, 0xe0, 0x8f, 0x50, 0x08, 0xa2, 0x30, 0x5b, 0x36, 0x6d, 0x5f, 0x9a, 0x1e,
-	0xa5, 0xcc, 0xec, 0x61, 0xbf, 0x77, 0x50, 0x96, 0x99, 0x6e, 0x04, 0xfa, 0x2a, 0x57, 0x1b, 0x94,
-	0xb2, 0x63, 0xa1, 0x44, 0xcf, 0xa1, 0x48, 0x5c, 0xda, 0xee, 0x99, 0x2d, 0x3a, 0x70, 0x3a, 0xd8,
-	0xbb, 0x1a, 0x83, 0x06, 0x75, 0x93, 0x17, 0x16, 0x55, 0x69, 0x10, 0x03, 0x3f, 0x82, 0xdc, 0xef,
-	0x06, 0x3e, 0xb3, 0x2e, 0x2c, 0xd2, 0x31, 0x85, 0x91, 0x3c, 0xd7, 0x95, 0x48, 0x5c, 0xe3, 0x52,
-	0xf4, 0x02, 0xb6, 0x46, 0x86, 0xd3, 0x11, 0x66, 0xc4, 0x36, 0x85, 0xc8, 0x64, 0x32, 0xc8, 0x13,
-	0x50, 0x6d, 0xcc, 0x13, 0x37, 0xdb, 0x1e, 0xf5, 0x7d, 0xdb, 0x72, 0x2e, 0x0b, 0x73, 0x37, 0x9f,
-	0xc2, 0x61, 0x68, 0x68, 0xe4, 0x02, 0x68, 0x24, 0x40, 0x5b, 0xb0, 0xd8, 0x23, 0xb8, 0x63, 0x0a,
-	0x82, 0xe7, 0x45, 0xbc, 0x0b, 0x5c, 0xd0, 0xe0, 0x24, 0xff, 0x51, 0x81, 0xe2, 0x19, 0x71, 0x3a,
-	0x96, 0xd3, 0x8d, 0x71, 0xed, 0x87, 0xa7, 0xf5, 0x1c, 0x8a, 0x17, 0x96, 0xcd, 0x88, 0x67, 0x7a,
-	0x04, 0x77, 0xae, 0xcc, 0x0b, 0xea, 0x99, 0x96, 0xd3, 0xb6, 0x07, 0xbe, 0x45, 0x1d, 0xc1, 0xf4,
-	0x82, 0x91, 0x0f, 0x2c, 0x0c, 0x6e, 0xf0, 0x8a, 0x7a, 0xf5, 0x50, 0x8d, 0x74, 0x58, 0x73, 0x3d,
-	0xea, 0x52, 0x1f, 0xdb, 0x92, 0x84, 0xd8, 0x19, 0xaf, 0x86, 0x2a, 0x91, 0xbc, 0x88, 0x65, 0x00,
-	0x5b, 0x33, 0x43, 0x91, 0x67, 0xfe, 0x01, 0xd6, 0xdd, 0x40, 0x6d, 0xe2, 0x98, 0xbe, 0xa0, 0xec,
-	0xa4, 0x77, 0x97, 0xca, 0xdf, 0x25, 0x31, 0x13, 0xf3, 0x65, 0xac, 0xb9, 0xd3, 0xfe, 0xb5, 0x77,
-	0x80, 0x0e, 0x7b, 0xd8, 0x72, 0x1a, 0x0c, 0x7b, 0x2c, 0xde, 0x10, 0x7c, 0x2e, 0x20, 0x1d, 0x99,
-	0x66, 0xb8, 0x44, 0xdf, 0x42, 0xb6, 0x4b, 0x1c, 0xe2, 0x5b, 0xbe, 0xc9, 0x1b, 0x97, 0xcc, 0x67,
-	0x49, 0xca, 0x9a, 0x56, 0x9f, 0x68, 0x7f, 0x4e, 0xc1, 0xca, 0x99, 0xc8, 0x2f, 0x6a, 0x5a, 0xdb,
-	0xb0, 0xe4, 0x62, 0x8f, 0x38, 0x41, 0x11, 0xc8, 0x22, 0x85, 0x40, 0xc4, 0x8f, 0x9d, 0x1b, 0x70,
-	0x7a, 0x4c, 0x67, 0xd0, 0x6f, 0x11, 0x4f, 0x7a, 0x05, 0x2e, 0x3a, 0x15, 0x12, 0xf4, 0x1d, 0x2c,
-	0x7b, 0xd8, 0xe9, 0x60, 0x6a, 0x7a, 0x64, 0x48, 0xb0, 0x2d, 0x6a, 0x2f, 0x6b, 0x64, 0x03, 0xa1,
-	0x21, 0x64, 0xa8, 0x04, 0x6b, 0x31, 0x72, 0xcc, 0x96, 0xc5, 0xfa, 0xd8, 0xbf, 0x94, 0x15, 0x87,
-	0x62, 0xaa, 0x6a, 0xa0, 0x11, 0xbd, 0x27, 0x06, 0xc0, 0xdd, 0xae, 0x47, 0xba, 0x98, 0x11, 0xd3,
-	0xb7, 0xba, 0x85, 0xb9, 0x9d, 0xf4, 0x6e, 0xc6, 0xc8, 0xc7, 0x0c, 0x2a, 0xa1, 0xbe, 0x61, 0x75,
-	0xd1, 0x33, 0x58, 0x8c, 0x5a, 0xb7, 0xa8, 0xac, 0xa5, 0x72, 0x51, 0x0f, 0x9a, 0xbb, 0x1e, 0x36,
-	0x77, 0xbd, 0x19, 0x5a, 0x18, 0x23, 0x63, 0xed, 0x05, 0xe4, 0x22, 0x7e, 0x24, 0xe1, 0x7b, 0xb0,
-	0x9a, 0x74, 0x97, 0x73, 0xad, 0xf1, 0x0b, 0xa2, 0xfd, 0x08, 0xeb, 0x12, 0xee, 0xd5, 0x9d, 0x0e,
-	0xf9, 0x1c, 0x23, 0x39, 0xce, 0xa1, 0x32, 0xc9, 0xa1, 0xf6, 0x04, 0x36, 0x26, 0x80, 0x72, 0xf7,
-	0x75, 0x98, 0xb3, 0xb8, 0x20, 0x6c, 0x4b, 0x62, 0xa1, 0x95, 0x61, 0xb5, 0xc1, 0x30, 0x23, 0x7c,
-	0xeb, 0xc8, 0xf4, 0x1b, 0x00, 0x4e, 0x06, 0x11, 0x81, 0xca, 0x08, 0x17, 0xfd, 0xd0, 0x4c, 0x7b,
-	0x0e, 0x2b, 0x41, 0x79, 0x45, 0x80, 0xc7, 0xa0, 0xc6, 0x29, 0x8e, 0x9d, 0x7f, 0x2e, 0x26, 0xe7,
-	0xa9, 0x69, 0x3f, 0xc0, 0x46, 0xd4, 0x4f, 0xc7, 0x32, 0x1b, 0x7f, 0xdf, 0x94, 0xc9, 0xf7, 0x4d,
-	0x87, 0xcd, 0x49, 0xdc, 0x8d, 0x89, 0x99, 0xb0, 0x75, 0x48, 0xfb, 0x7d, 0x8b, 0x31, 0x42, 0x2a,
-	0xbe, 0x6f, 0x75, 0x9d, 0x3e, 0x71, 0x98, 0x1f, 0xe3, 0x31, 0xe8, 0x92, 0xa2, 0xe6, 0x43, 0x1e,
-	0x85, 0x48, 0xdc, 0x12, 0x51, 0xcd, 0x51, 0x38, 0xfc, 0x3d, 0x4c, 0x8b, 0x6a, 0x0e, 0xe3, 0xf1,
-	0x35, 0x02, 0x79, 0x79, 0x97, 0x8f, 0x88, 0x4b, 0x7d, 0x8b, 0x8d, 0xee, 0xf1, 0x2f, 0x41, 0x0d,
-	0xef, 0x71, 0x47, 0xea, 0xe4, 0x1d, 0xde, 0x4e, 0xba, 0xc3, 0xd2, 0x87, 0x91, 0x73, 0xc7, 0x7d,
-	0x6a, 0xff, 0x4e, 0xcd, 0x4c, 0x24, 0xda, 0xab, 0x0b, 0x80, 0x23, 0xa9, 0xdc, 0xe5, 0xb5, 0x3e,
-	0x7b, 0xaa, 0xd1, 0x6f, 0x70, 0x34, 0x53, 0x17, 0x73, 0x5d, 0xfc, 0x87, 0x02, 0x6b, 0x33, 0x6c,
-	0xd0, 0x03, 0x58, 0x6c, 0x87, 0x62, 0xb1, 0x7f, 0xc6, 0x18, 0x09, 0x46, 0x8f, 0x61, 0x6a, 0xd6,
-	0x63, 0x98, 0x8e, 0xcd, 0x37, 0xdb, 0xb0, 0x64, 0xf9, 0xa6, 0x2b, 0x6b, 0x57, 0xdc, 0xe7, 0x05,
-	0x03, 0x2c, 0x3f, 0xac, 0xe6, 0x89, 0x02, 0x99, 0x9b, 0x28, 0x10, 0xf4, 0x12, 0xe6, 0x79, 0x9d,
-	0x0d, 0x7c, 0x71, 0x4f, 0x57, 0xca, 0x8f, 0x92, 0x48, 0x88, 0xca, 0xa8, 0x21, 0xcc, 0x0d, 0x09,
-	0xd3, 0x3e, 0x42, 0x7e, 0x52, 0x35, 0x9a, 0x16, 0x42, 0xdf, 0xca, 0xff, 0xe7, 0xfb, 0x1d, 0xa8,
-	0x35, 0xd6, 0xdb, 0x1f, 0x7b, 0xe1, 0x5f, 0xc0, 0x22, 0x61, 0xbd, 0x7d, 0xb3, 0x83, 0x19, 0x96,
-	0x23, 0xc8, 0x4e, 0x52, 0x79, 0x44, 0xe0, 0x05, 0x22, 0xbf, 0xf6, 0x9e, 0xc1, 0xf2, 0x68, 0x30,
-	0xa1, 0x36, 0x41, 0x4b, 0xf0, 0xf5, 0xfb, 0xd3, 0x37, 0xa7, 0x6f, 0xcf, 0x4f, 0xd5, 0xaf, 0x50,
-	0x16, 0x16, 0x2a, 0xcd, 0x66, 0xad, 0xd1, 0xac, 0x19, 0xaa, 0xc2, 0x57, 0x67, 0xc6, 0xdb, 0xb3,
-	0xb7, 0x8d, 0x9a, 0xa1, 0xa6, 0xf6, 0xfe, 0xa4, 0x40, 0x6e, 0x22, 0x50, 0x84, 0x60, 0x45, 0x82,
-	0xcd, 0x46, 0xb3, 0xd2, 0x7c, 0xdf, 0x50, 0xbf, 0xe2, 0xb2, 0xb3, 0xda, 0xe9, 0x51, 0xfd, 0xf4,
-	0xb5, 0x59, 0x39, 0x6c, 0xd6, 0x3f, 0xd4, 0x54, 0x05, 0x01, 0xcc, 0xcb, 0xef, 0x14, 0xd7, 0xd7,
-	0x4f, 0xeb, 0xcd, 0x7a, 0xa5, 0x59, 0x3b, 0x32, 0x6b, 0xbf, 0xaa, 0x37, 0xd5, 0x34, 0x52, 0x21,
-	0x7b, 0x5e, 0x6f, 0x1e, 0x1f, 0x19, 0x95, 0xf3, 0x4a, 0xf5, 0xa4, 0xa6, 0x66, 0x38, 0x82, 0xeb,
-	0x6a, 0x47, 0xea, 0x1c, 0x47, 0x04, 0xdf, 0x66, 0xe3, 0xa4, 0xd2, 0x38, 0xae, 0x1d, 0xa9, 0xf3,
-	0xe5, 0xff, 0xa4, 0x61, 0xb9, 0x2a, 0x92, 0x6d, 0x04, 0xa3, 0x39, 0xfa, 0x35, 0xac, 0x9e, 0x63,
-	0x8b, 0xbd, 0xa2, 0xde, 0xe8, 0xd5, 0x42, 0x9b, 0x53, 0x6d, 0xb7, 0xc6, 0x07, 0xee, 0xe2, 0x5e,
-	0x62, 0xad, 0x4f, 0xbd, 0x78, 0x4f, 0x15, 0x74, 0x02, 0xcb, 0x87, 0xd8, 0xa1, 0x8e, 0xd5, 0xc6,
-	0xf6, 0x31, 0xc1, 0x9d, 0x44, 0xb7, 0x89, 0x8f, 0x6d, 0x75, 0x34, 0x74, 0x21, 0x03, 0x56, 0x4f,
-	0xc4, 0x28, 0x12, 0x7b, 0x6d, 0xef, 0xef, 0x31, 0x06, 0x7e, 0xaa, 0xa0, 0x8f, 0x90, 0x9b, 0x68,
-	0x2b, 0x89, 0x1e, 0x4b, 0x49, 0xa9, 0x27, 0xf5, 0xa5, 0x13, 0x58, 0x08, 0x0b, 0x29, 0xd1, 0xe9,
-	0x6e, 0x92, 0xd3, 0xa9, 0xfa, 0xfd, 0x05, 0x2c, 0xbc, 0xa2, 0xde, 0xe5, 0x8d, 0xde, 0x1e, 0x24,
-	0x25, 0xcd, 0x91, 0xe5, 0x7f, 0x29, 0x90, 0x0b, 0xb2, 0x27, 0xde, 0xe8, 0xf0, 0x21, 0x10, 0x89,
-	0xe3, 0xb9, 0x0b, 0x69, 0xc5, 0x9f, 0x26, 0x85, 0x3c, 0xf1, 0x4a, 0x7d, 0x86, 0x8d, 0x89, 0x69,
-	0xbb, 0xc2, 0xf8, 0x58, 0x86, 0xf4, 0x9b, 0x1d, 0x4c, 0x4e, 0xf8, 0xc9, 0xc4, 0x27, 0x0c, 0xf3,
-	0xe5, 0xbf, 0xa4, 0xa3, 0x69, 0x20, 0x4a, 0xd4, 0x86, 0xe5, 0xb1, 0x87, 0x1a, 0xfd, 0x2c, 0xf1,
-	0x38, 0x67, 0x0c, 0x02, 0xc5, 0x27, 0x77, 0xb4, 0x96, 0xb9, 0x7f, 0x81, 0xb5, 0x19, 0x93, 0x27,
-	0x2a, 0xdf, 0x52, 0x42, 0x33, 0x26, 0xe6, 0xe2, 0xc1, 0xbd, 0x30, 0x72, 0xff, 0xdf, 0x40, 0x56,
-	0x06, 0x16, 0x5c, 0x9d, 0xbb, 0xdc, 0xaf, 0xe2, 0xa3, 0x5b, 0x72, 0x8c, 0xbc, 0xb7, 0x40, 0x3d,
-	0xa4, 0x7d, 0x77, 0xc0, 0x48, 0x34, 0xcc, 0xdc, 0x6d, 0x87, 0xc7, 0x49, 0x3b, 0x4c, 0x0d, 0x45,
-	0xe5, 0xff, 0x66, 0x40, 0x1d, 0x75, 0x4d, 0x79, 0x88, 0x5f, 0xa2, 0x56, 0x35, 0xfa, 0x8d, 0x99,
-	0x4c, 0x6a, 0xf2, 0xcf, 0xd8, 0x64, 0x52, 0x6f, 0xf8, 0x11, 0xfb, 0x54, 0x41, 0x14, 0x56, 0xc6,
-	0xa7, 0x22, 0xf4, 0xe4, 0x56, 0x47, 0x63, 0x65, 0xa4, 0xdf, 0xd5, 0x5c, 0x32, 0xfd, 0xfb, 0xd9,
-	0x43, 0xc0, 0xc1, 0x3d, 0x26, 0x8e, 0xdb, 0x0b, 0xe9, 0xa6, 0x79, 0xe7, 0xd3, 0xf4, 0xdb, 0x75,
-	0xcf, 0x94, 0x4b, 0x77, 0x7d, 0xbc, 0xc3, 0x2d, 0xff, 0xa0, 0xc0, 0xfa, 0xac, 0xbf, 0x56, 0xd0,
-	0xed, 0x87, 0x36, 0xfd, 0xdf, 0x4e, 0xf1, 0xfb, 0xfb, 0x81, 0x82, 0x18, 0xaa, 0xd9, 0xbf, 0x5e,
-	0x3f, 0x54, 0xfe, 0x76, 0xfd, 0x50, 0xf9, 0xe7, 0xf5, 0x43, 0xa5, 0x35, 0x2f, 0xda, 0xec, 0xc1,
-	0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xef, 0x0b, 0x12, 0x9b, 0xe2, 0x12, 0x00, 0x00,
+	// 1959 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xdf, 0x6f, 0x1b, 0xc7,
+	0xf1, 0xcf, 0x51, 0x94, 0x22, 0x8d, 0x28, 0x91, 0x5a, 0xfd, 0xfc, 0x9e, 0x6c, 0x4b, 0xb9, 0xe0,
+	0x5b, 0xcb, 0x4e, 0x4d, 0xc6, 0x94, 0xe3, 0x04, 0x35, 0x8c, 0x94, 0x92, 0xe8, 0x58, 0xb1, 0x20,
+	0x2b, 0x47, 0xc6, 0x6a, 0x83, 0x22, 0xd7, 0x25, 0xb9, 0x22, 0xaf, 0x3a, 0xde, 0x5d, 0x6e, 0x97,
+	0x84, 0xf5, 0x92, 0xa2, 0x68, 0x81, 0xa2, 0xe8, 0xff, 0xd0, 0x87, 0xbe, 0xf7, 0xff, 0x28, 0xfa,
+	0xd4, 0xb7, 0xbe, 0x15, 0x85, 0x81, 0xb6, 0xff, 0x46, 0xb1, 0x7b, 0x7b, 0x3f, 0x78, 0xbc, 0xa3,
+	0xc8, 0xbc, 0xdd, 0xce, 0xcc, 0x67, 0x76, 0x66, 0x76, 0x66, 0x67, 0xf6, 0x40, 0x73, 0x3d, 0x87,
+	0x39, 0x95, 0x16, 0xc1, 0x6d, 0xc7, 0xae, 0x78, 0x6e, 0xbb, 0x32, 0x7c, 0x5c, 0xa1, 0xc4, 0x1b,
+	0x9a, 0x6d, 0x42, 0xcb, 0x82, 0x89, 0xb6, 0x08, 0xeb, 0x11, 0x8f, 0x0c, 0xfa, 0x65, 0x5f, 0xac,
+	0xec, 0xb9, 0xed, 0xf2, 0xf0, 0xb1, 0xba, 0x37, 0x82, 0x75, 0xab, 0x2e, 0xc7, 0xb2, 0x1b, 0x37,
+	0x00, 0xaa, 0xbb, 0x5d, 0xc7, 0xe9, 0x5a, 0xa4, 0x22, 0x56, 0xad, 0xc1, 0x55, 0x85, 0xf4, 0x5d,
+	0x76, 0x23, 0x99, 0x7b, 0x49, 0x26, 0x33, 0xfb, 0x84, 0x32, 0xdc, 0x77, 0x7d, 0x01, 0xed, 0x02,
+	0x76, 0xdf, 0x60, 0xcb, 0xec, 0x60, 0xe6, 0x78, 0x17, 0xc4, 0xbb, 0x72, 0xbc, 0x3e, 0xb6, 0xdb,
+	0x44, 0x27, 0xdf, 0x0d, 0x08, 0x65, 0x08, 0x41, 0x9e, 0x5a, 0x0e, 0xdb, 0x51, 0xf6, 0x95, 0x83,
+	0xbc, 0x2e, 0xbe, 0xd1, 0x5d, 0x00, 0x77, 0xd0, 0xb2, 0xcc, 0xb6, 0x71, 0x4d, 0x6e, 0x76, 0x72,
+	0xfb, 0xca, 0x41, 0x41, 0x5f, 0xf2, 0x29, 0xaf, 0xc8, 0x8d, 0xf6, 0x0f, 0x05, 0xee, 0xa4, 0xab,
+	0xa4, 0xae, 0x63, 0x53, 0x82, 0x76, 0xe0, 0xfd, 0x16, 0xb6, 0x38, 0x49, 0xaa, 0x0d, 0x96, 0xe8,
+	0x01, 0x94, 0x98, 0xc3, 0xb0, 0x65, 0x0c, 0x03, 0x3c, 0x15, 0xfa, 0xf3, 0x7a, 0x51, 0xd0, 0x43,
+	0xb5, 0x14, 0x3d, 0x85, 0x6d, 0x5f, 0x14, 0xb7, 0x99, 0x39, 0x24, 0x71, 0xc4, 0x9c, 0x40, 0x6c,
+	0x0a, 0x76, 0x4d, 0x70, 0x63, 0xb8, 0x9f, 0xc0, 0xff, 0xe1, 0x21, 0xf1, 0x70, 0x37, 0x06, 0x31,
+	0x02, 0x73, 0xf2, 0xfb, 0xca, 0x41, 0x4e, 0xdf, 0x96, 0x02, 0x21, 0xea, 0xc8, 0x67, 0x6b, 0xdf,
+	0xc6, 0x1c, 0xab, 0x31, 0xc6, 0xe3, 0xc8, 0x4c, 0xc7, 0xa6, 0x41, 0xb0, 0xf6, 0x60, 0x39, 0x0a,
+	0x0c, 0xdd, 0x51, 0xf6, 0xe7, 0x0e, 0x0a, 0x3a, 0x84, 0x91, 0xa1, 0x3c, 0x72, 0xd4, 0xb4, 0xdb,
+	0xc4, 0x10, 0x31, 0xf5, 0x3d, 0x5b, 0x12, 0x94, 0x86, 0xe5, 0x30, 0xed, 0xb7, 0x39, 0xb8, 0x9b,
+	0xb1, 0x81, 0x0c, 0x9d, 0x0d, 0x05, 0x1c, 0xa3, 0x8b, 0x2d, 0x96, 0xab, 0x5f, 0x96, 0xd3, 0x73,
+	0xa7, 0x3c, 0x51, 0x59, 0x2a, 0x57, 0x1f, 0xd1, 0xaf, 0x7a, 0xb0, 0x91, 0x26, 0x95, 0x48, 0x01,
+	0x25, 0x91, 0x02, 0xe8, 0x19, 0xe4, 0x3b, 0x98, 0x61, 0xe1, 0xe1, 0x72, 0xf5, 0xfe, 0x98, 0x79,
+	0x6e, 0xd5, 0xe5, 0xe6, 0xc5, 0x34, 0x9e, 0x60, 0x86, 0x75, 0x01, 0xd2, 0x9e, 0x80, 0x1a, 0xed,
+	0xc9, 0x8f, 0xcf, 0x37, 0x4c, 0xc6, 0x78, 0x0b, 0x16, 0xdc, 0x41, 0x2b, 0xda, 0x55, 0xae, 0xb4,
+	0x6f, 0x61, 0x37, 0x15, 0x25, 0x03, 0xf7, 0x39, 0x2c, 0x85, 0xc7, 0x2d, 0x90, 0xcb, 0xd5, 0x0f,
+	0xb2, 0xcc, 0x0a, 0xf5, 0xe8, 0x11, 0x46, 0x3b, 0x82, 0xad, 0xa4, 0xb9, 0xd2, 0xa2, 0x0d, 0x98,
+	0xa7, 0x3d, 0xec, 0x75, 0x64, 0x32, 0xfb, 0x8b, 0xb0, 0x70, 0x72, 0x51, 0xe1, 0x68, 0xef, 0x72,
+	0xb0, 0x3d, 0xa6, 0x44, 0x1a, 0xf8, 0x29, 0xec, 0xf8, 0x56, 0x18, 0x2d, 0xcb, 0x69, 0x5f, 0x1b,
+	0x9e, 0xe3, 0x30, 0xa3, 0x87, 0x69, 0xef, 0xb0, 0x2a, 0x3d, 0xdd, 0xf4, 0xf9, 0x47, 0x9c, 0xad,
+	0x3b, 0x0e, 0x7b, 0x29, 0x98, 0xe8, 0x19, 0xa8, 0xc4, 0x75, 0xda, 0x3d, 0xa3, 0xe5, 0x0c, 0xec,
+	0x0e, 0xf6, 0x6e, 0x46, 0xa0, 0x7e, 0x75, 0x6e, 0x0b, 0x89, 0x23, 0x29, 0x10, 0x03, 0xdf, 0x87,
+	0xe2, 0xaf, 0x06, 0x94, 0x99, 0x57, 0x26, 0xe9, 0x18, 0x42, 0x48, 0x56, 0xcf, 0x6a, 0x48, 0xae,
+	0x73, 0x2a, 0x7a, 0x0e, 0xbb, 0x91, 0xe0, 0xb8, 0x85, 0x79, 0xb1, 0xcd, 0x4e, 0x28, 0x92, 0x34,
+	0xf2, 0x0c, 0x4a, 0x16, 0xe6, 0x8e, 0x1b, 0x6d, 0xcf, 0xa1, 0xd4, 0x32, 0xed, 0xeb, 0x9d, 0xf9,
+	0xc9, 0xa7, 0x70, 0x1c, 0x08, 0xea, 0x45, 0x1f, 0x1a, 0x12, 0xd0, 0x2e, 0x2c, 0xf5, 0x08, 0xee,
+	0xf8, 0x55, 0xb4, 0x20, 0xec, 0x5d, 0xe4, 0x04, 0x51, 0x44, 0x7f, 0x50, 0x40, 0xbd, 0x20, 0x76,
+	0xc7, 0xb4, 0xbb, 0x69, 0x35, 0xfa, 0x0c, 0xd4, 0x2b, 0xd3, 0x62, 0xc4, 0x33, 0x3c, 0x82, 0x3b,
+	0x37, 0xc6, 0x95, 0xe3, 0x19, 0xa6, 0xdd, 0xb6, 0x06, 0xd4, 0x74, 0x6c, 0x11, 0xe9, 0x45, 0x7d,
+	0xdb, 0x97, 0xd0, 0xb9, 0xc0, 0x0b, 0xc7, 0x3b, 0x0d, 0xd8, 0xa8, 0x0c, 0xeb, 0xae, 0xe7, 0xb8,
+	0x0e, 0xc5, 0x96, 0x0c, 0x42, 0xec, 0x8c, 0xd7, 0x02, 0x96, 0x70, 0x5e, 0xd8, 0x32, 0x80, 0xdd,
+	0x54, 0x53, 0xe4, 0x99, 0xbf, 0x81, 0x0d, 0xd7, 0x67, 0x1b, 0x29, 0x55, 0xfd, 0xe1, 0x14, 0x65,
+	0xa3, 0xaf, 0xbb, 0xe3, 0xfa, 0xb5, 0xaf, 0x00, 0x1d, 0xf7, 0xb0, 0x69, 0x37, 0x18, 0xf6, 0x58,
+	0xfc, 0xda, 0xa5, 0x9c, 0x40, 0x3a, 0xd2, 0xcd, 0x60, 0x89, 0x3e, 0x80, 0x42, 0x97, 0xd8, 0x84,
+	0x9a, 0xd4, 0xe0, 0xed, 0x41, 0xfa, 0xb3, 0x2c, 0x69, 0x4d, 0xb3, 0x4f, 0xb4, 0x3f, 0xe5, 0x60,
+	0xf5, 0x42, 0xf8, 0x47, 0xe2, 0xb7, 0x1d, 0xf6, 0x88, 0xed, 0x27, 0x81, 0x4c, 0x52, 0xf0, 0x49,
+	0xfc, 0xd8, 0xb9, 0x00, 0x0f, 0x8f, 0x61, 0x0f, 0xfa, 0x2d, 0xe2, 0x49, 0xad, 0xc0, 0x49, 0xe7,
+	0x82, 0x82, 0x3e, 0x84, 0x15, 0x0f, 0xdb, 0x1d, 0xec, 0x18, 0x1e, 0x19, 0x12, 0x6c, 0x89, 0xdc,
+	0x2b, 0xe8, 0x05, 0x9f, 0xa8, 0x0b, 0x1a, 0xaa, 0xc0, 0x7a, 0x2c, 0x38, 0x46, 0xcb, 0x64, 0x7d,
+	0x4c, 0xaf, 0x65, 0xc6, 0xa1, 0x18, 0xeb, 0xc8, 0xe7, 0x88, 0x1b, 0x3e, 0x06, 0xc0, 0xdd, 0xae,
+	0x47, 0xba, 0x98, 0x11, 0x83, 0x9a, 0xdd, 0x9d, 0xf9, 0xfd, 0xb9, 0x83, 0xbc, 0xbe, 0x1d, 0x13,
+	0xa8, 0x05, 0xfc, 0x86, 0xd9, 0x45, 0x9f, 0xc1, 0x52, 0xd8, 0x20, 0x45, 0x66, 0x2d, 0x57, 0xd5,
+	0xb2, 0xdf, 0x42, 0xcb, 0x41, 0x0b, 0x2d, 0x37, 0x03, 0x09, 0x3d, 0x12, 0xd6, 0x9e, 0x43, 0x31,
+	0x8c, 0x8f, 0x0c, 0xf8, 0x43, 0x58, 0xcb, 0xaa, 0xe5, 0x62, 0x6b, 0xb4, 0x40, 0xb4, 0x4f, 0x61,
+	0x43, 0xc2, 0xbd, 0x53, 0xbb, 0x43, 0xde, 0xc6, 0x82, 0x1c, 0x8f, 0xa1, 0x92, 0x8c, 0xa1, 0xf6,
+	0x08, 0x36, 0x13, 0x40, 0xb9, 0xfb, 0x06, 0xcc, 0x9b, 0x9c, 0x10, 0x5c, 0x4b, 0x62, 0xa1, 0x55,
+	0x61, 0xad, 0xc1, 0x30, 0x23, 0x7c, 0xeb, 0x50, 0x94, 0xb7, 0x25, 0x4e, 0x14, 0x86, 0x06, 0xb7,
+	0x39, 0x0d, 0xc4, 0xb4, 0x67, 0xb0, 0xea, 0xa7, 0x57, 0x08, 0x78, 0x00, 0xa5, 0x78, 0x88, 0x63,
+	0xe7, 0x5f, 0x8c, 0xd1, 0xb9, 0x6b, 0xda, 0x53, 0xd8, 0x0c, 0xef, 0xd3, 0x11, 0xcf, 0x26, 0xb7,
+	0x10, 0xad, 0x0c, 0x5b, 0x49, 0xdc, 0x44, 0xc7, 0x0c, 0xd8, 0x3d, 0x76, 0xfa, 0x7d, 0x93, 0x31,
+	0x42, 0x6a, 0x94, 0x9a, 0x5d, 0xbb, 0x4f, 0x6c, 0x16, 0x6f, 0xcd, 0xfe, 0x2d, 0x29, 0x72, 0x3e,
+	0x88, 0xa3, 0x20, 0x89, 0x2a, 0x49, 0xf6, 0xee, 0x5c, 0xb2, 0x77, 0x6b, 0x04, 0xb6, 0x65, 0x2d,
+	0x9f, 0x10, 0xd7, 0xa1, 0x26, 0x8b, 0xea, 0xf8, 0x4b, 0x28, 0x05, 0x75, 0xdc, 0x91, 0x3c, 0x59,
+	0xc3, 0x7b, 0x59, 0x35, 0x2c, 0x75, 0xe8, 0x45, 0x77, 0x54, 0xa7, 0x76, 0x0e, 0xeb, 0x17, 0x9e,
+	0xd3, 0x19, 0xb4, 0x89, 0x7f, 0x87, 0x4e, 0x98, 0xc3, 0xc6, 0xca, 0x27, 0x37, 0x5e, 0x3e, 0xda,
+	0x21, 0xdc, 0x95, 0x66, 0x0b, 0x7d, 0xaf, 0x5d, 0xe2, 0x8d, 0x5e, 0x88, 0x29, 0x9a, 0xb5, 0x3f,
+	0xe7, 0xe0, 0x5e, 0x16, 0x4a, 0xfa, 0x7c, 0x09, 0xc8, 0x95, 0x79, 0x67, 0x50, 0x0b, 0xd3, 0x9e,
+	0x69, 0x77, 0x03, 0xaf, 0x0f, 0xb2, 0xbc, 0x0e, 0x32, 0xb5, 0x21, 0x01, 0xc1, 0x9d, 0x19, 0x51,
+	0x28, 0x57, 0xec, 0xe7, 0xd0, 0x88, 0xe2, 0xdc, 0x64, 0xc5, 0x35, 0x89, 0x88, 0x14, 0xe3, 0x04,
+	0x85, 0xa2, 0x73, 0x28, 0x0e, 0x1d, 0x6b, 0x60, 0x33, 0xde, 0x23, 0xc9, 0x5b, 0x7e, 0x48, 0x73,
+	0x42, 0xeb, 0xff, 0x67, 0x0e, 0x02, 0x81, 0x78, 0xfd, 0xad, 0xc9, 0xf4, 0xd5, 0x61, 0x7c, 0x49,
+	0xb5, 0xaa, 0x38, 0x29, 0x6e, 0xbd, 0x60, 0x07, 0x81, 0xd9, 0x85, 0x25, 0xae, 0x3c, 0x5e, 0x14,
+	0x8b, 0x9c, 0x20, 0xaa, 0xe1, 0xbf, 0xb9, 0xd4, 0x34, 0x0d, 0xc1, 0x5d, 0x00, 0x1c, 0x52, 0x65,
+	0x34, 0xbf, 0xc8, 0x9a, 0xee, 0x26, 0x28, 0x4a, 0xe5, 0xc5, 0x54, 0xab, 0xff, 0x54, 0x60, 0x3d,
+	0x45, 0x06, 0xdd, 0x81, 0xa5, 0x76, 0x40, 0x16, 0xfb, 0xe7, 0xf5, 0x88, 0x10, 0x8d, 0x3a, 0xb9,
+	0xb4, 0x51, 0x67, 0x2e, 0x96, 0x9b, 0x7b, 0xb0, 0x6c, 0x52, 0x23, 0x38, 0x5d, 0x71, 0x5b, 0x2f,
+	0xea, 0x60, 0xd2, 0x20, 0x03, 0x12, 0xe5, 0x3f, 0x9f, 0x9c, 0x20, 0x3f, 0x87, 0x05, 0xca, 0x30,
+	0x1b, 0x50, 0x71, 0x0b, 0xaf, 0x56, 0xef, 0x67, 0x05, 0x21, 0xbc, 0x24, 0x1a, 0x42, 0x5c, 0x97,
+	0x30, 0xed, 0x1b, 0xd8, 0x4e, 0xb2, 0xa2, 0x59, 0x30, 0xd0, 0xad, 0xfc, 0x30, 0xdd, 0x5f, 0x41,
+	0xa9, 0xce, 0x7a, 0x8f, 0x47, 0xe6, 0xb7, 0xe7, 0xb0, 0x44, 0x58, 0xef, 0xb1, 0x21, 0xe6, 0x5e,
+	0x7f, 0xc0, 0xdc, 0xcf, 0xca, 0xab, 0x10, 0xbc, 0x48, 0xe4, 0x97, 0xf6, 0x0a, 0x50, 0xe3, 0xc6,
+	0x6e, 0x27, 0x2c, 0xe5, 0x2d, 0xfb, 0xc6, 0x6e, 0x9b, 0x76, 0x37, 0x6c, 0xd9, 0xfe, 0x72, 0x74,
+	0x04, 0xca, 0x8d, 0x8e, 0x40, 0x0f, 0x3f, 0x83, 0x95, 0x68, 0x86, 0x75, 0x2c, 0x82, 0x96, 0xe1,
+	0xfd, 0xaf, 0xcf, 0x5f, 0x9d, 0xbf, 0xbe, 0x3c, 0x2f, 0xbd, 0x87, 0x0a, 0xb0, 0x58, 0x6b, 0x36,
+	0xeb, 0x8d, 0x66, 0x5d, 0x2f, 0x29, 0x7c, 0x75, 0xa1, 0xbf, 0xbe, 0x78, 0xdd, 0xa8, 0xeb, 0xa5,
+	0xdc, 0xc3, 0x3f, 0x2a, 0x50, 0x4c, 0x78, 0x8d, 0x10, 0xac, 0x4a, 0xb0, 0xd1, 0x68, 0xd6, 0x9a,
+	0x5f, 0x37, 0x4a, 0xef, 0x71, 0xda, 0x45, 0xfd, 0xfc, 0xe4, 0xf4, 0xfc, 0x0b, 0xa3, 0x76, 0xdc,
+	0x3c, 0x7d, 0x53, 0x2f, 0x29, 0x08, 0x60, 0x41, 0x7e, 0xe7, 0x38, 0xff, 0xf4, 0xfc, 0xb4, 0x79,
+	0x5a, 0x6b, 0xd6, 0x4f, 0x8c, 0xfa, 0xcf, 0x4e, 0x9b, 0xa5, 0x39, 0x54, 0x82, 0xc2, 0xe5, 0x69,
+	0xf3, 0xe5, 0x89, 0x5e, 0xbb, 0xac, 0x1d, 0x9d, 0xd5, 0x4b, 0x79, 0x8e, 0xe0, 0xbc, 0xfa, 0x49,
+	0x69, 0x9e, 0x23, 0xfc, 0x6f, 0xa3, 0x71, 0x56, 0x6b, 0xbc, 0xac, 0x9f, 0x94, 0x16, 0xaa, 0x7f,
+	0xcb, 0xc3, 0xca, 0x91, 0x88, 0x5c, 0xc3, 0x7f, 0x2b, 0xa3, 0x9f, 0xc3, 0xda, 0x25, 0x36, 0xd9,
+	0x0b, 0xc7, 0x8b, 0x06, 0x1c, 0xb4, 0x35, 0xd6, 0xa1, 0xeb, 0xfc, 0x05, 0xac, 0x3e, 0xcc, 0x2c,
+	0x9c, 0xb1, 0xe1, 0xe8, 0x63, 0x05, 0x9d, 0xc1, 0xca, 0x31, 0xb6, 0x1d, 0xdb, 0x6c, 0x63, 0xeb,
+	0x25, 0xc1, 0x9d, 0x4c, 0xb5, 0x99, 0x73, 0xd9, 0x51, 0x34, 0x9f, 0x23, 0x1d, 0xd6, 0xce, 0xc4,
+	0xd4, 0x1a, 0x7f, 0x35, 0xcd, 0xac, 0x31, 0x06, 0xfe, 0x58, 0x41, 0xdf, 0x40, 0x31, 0xd1, 0x81,
+	0x32, 0x35, 0x56, 0xb2, 0x5c, 0xcf, 0x6a, 0x61, 0x67, 0xb0, 0x18, 0x64, 0x65, 0xa6, 0xd2, 0x83,
+	0x2c, 0xa5, 0x63, 0xc5, 0xf0, 0x53, 0x58, 0x7c, 0xe1, 0x78, 0xd7, 0x13, 0xb5, 0xdd, 0xc9, 0x72,
+	0x9a, 0x23, 0xd1, 0x05, 0x40, 0x54, 0x0f, 0xb3, 0x9f, 0xf0, 0x78, 0x2d, 0x55, 0xff, 0xa3, 0x40,
+	0x31, 0x6c, 0x13, 0x61, 0x3a, 0x81, 0x4f, 0x12, 0x07, 0x3e, 0xcd, 0x31, 0xa8, 0x3f, 0xca, 0xda,
+	0x32, 0x31, 0x22, 0xbd, 0x85, 0xcd, 0xc4, 0x53, 0xaf, 0xc6, 0x78, 0x71, 0xa2, 0xf2, 0x64, 0x05,
+	0xc9, 0xe7, 0xa5, 0x5a, 0x99, 0x5a, 0x5e, 0x3a, 0xfa, 0x97, 0xf9, 0x70, 0x14, 0x0d, 0x1d, 0xb5,
+	0x60, 0x65, 0x64, 0x4a, 0x44, 0x3f, 0xce, 0x4c, 0x90, 0x94, 0x29, 0x54, 0x7d, 0x34, 0xa5, 0xb4,
+	0xf4, 0xfd, 0x7b, 0x58, 0x4f, 0x79, 0xf6, 0xa0, 0xea, 0x2d, 0x49, 0x99, 0xf2, 0x5c, 0x53, 0x0f,
+	0x67, 0xc2, 0xc8, 0xfd, 0x7f, 0x01, 0x05, 0x69, 0x98, 0x5f, 0x8c, 0xd3, 0x54, 0xac, 0x7a, 0xff,
+	0x16, 0x1f, 0x43, 0xed, 0x2d, 0x28, 0x1d, 0x3b, 0x7d, 0x77, 0xc0, 0x48, 0x38, 0x49, 0x4f, 0xb7,
+	0xc3, 0x83, 0xcc, 0x6c, 0x1d, 0x9b, 0xc8, 0x7f, 0xaf, 0xc0, 0x56, 0xfa, 0x00, 0x86, 0x3e, 0xb9,
+	0x25, 0x22, 0xe9, 0x63, 0x9e, 0xfa, 0x74, 0x56, 0x98, 0xb4, 0xe4, 0x97, 0x50, 0x88, 0xcf, 0xa3,
+	0xe8, 0xa3, 0x09, 0x61, 0x4a, 0x4e, 0xad, 0x53, 0x5d, 0x95, 0xd5, 0x7f, 0x2f, 0x40, 0x29, 0xea,
+	0x39, 0x32, 0x61, 0xbf, 0x0f, 0x2f, 0xfa, 0xe8, 0x67, 0x4e, 0x76, 0x02, 0x65, 0xff, 0x2f, 0x52,
+	0x0f, 0x67, 0xc2, 0x84, 0xdd, 0xc0, 0x81, 0xd5, 0xd1, 0xe7, 0x07, 0x7a, 0x74, 0xab, 0xa2, 0x91,
+	0x92, 0x29, 0x4f, 0x2b, 0x2e, 0xe3, 0xfc, 0xeb, 0xf4, 0x79, 0xec, 0x70, 0x86, 0xe1, 0xef, 0xf6,
+	0xa2, 0x99, 0x34, 0x7a, 0x7e, 0x37, 0xde, 0xf9, 0x67, 0x74, 0xb9, 0x32, 0xed, 0x1c, 0x15, 0x6c,
+	0xf9, 0x1b, 0x05, 0x36, 0xd2, 0xfe, 0x14, 0xa3, 0xdb, 0x0f, 0x6d, 0xfc, 0x57, 0xb5, 0xfa, 0x64,
+	0x36, 0x90, 0xb4, 0xe1, 0x77, 0x4a, 0xec, 0x81, 0x3a, 0x72, 0x5d, 0x3d, 0x99, 0xf1, 0xaf, 0xaa,
+	0x6f, 0xc5, 0x27, 0x3f, 0xe8, 0x5f, 0x2c, 0xc2, 0xb0, 0x1c, 0x7b, 0x4c, 0xa0, 0xe9, 0x9e, 0x24,
+	0xea, 0x47, 0xb7, 0xdc, 0x59, 0xf1, 0x87, 0xc9, 0x51, 0xe1, 0xaf, 0xef, 0xee, 0x29, 0x7f, 0x7f,
+	0x77, 0x4f, 0xf9, 0xd7, 0xbb, 0x7b, 0x4a, 0x6b, 0x41, 0xb4, 0xd2, 0xc3, 0xff, 0x0d, 0x00, 0x30,
+	0xb2, 0x9a, 0xc4, 0x9b, 0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1514,11 +1970,15 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type BeaconServiceClient interface {
 	WaitForChainStart(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (BeaconService_WaitForChainStartClient, error)
+	// CanonicalHead can be called on demand to fetch the current, head block of a beacon node.
 	CanonicalHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1.BeaconBlock, error)
+	// LatestAttestation streams the latest aggregated attestation to connected validator clients.
 	LatestAttestation(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (BeaconService_LatestAttestationClient, error)
 	PendingDeposits(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*PendingDepositsResponse, error)
 	Eth1Data(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*Eth1DataResponse, error)
 	ForkData(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1.Fork, error)
+	// SyncStatus reports whether the beacon node is still catching up with the network.
+	SyncStatus(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SyncStatusResponse, error)
 }
 
 type beaconServiceClient struct {
@@ -1629,14 +2089,27 @@ func (c *beaconServiceClient) ForkData(ctx context.Context, in *types.Empty, opt
 	return out, nil
 }
 
+func (c *beaconServiceClient) SyncStatus(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*SyncStatusResponse, error) {
+	out := new(SyncStatusResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/SyncStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BeaconServiceServer is the server API for BeaconService service.
 type BeaconServiceServer interface {
 	WaitForChainStart(*types.Empty, BeaconService_WaitForChainStartServer) error
+	// CanonicalHead can be called on demand to fetch the current, head block of a beacon node.
 	CanonicalHead(context.Context, *types.Empty) (*v1.BeaconBlock, error)
+	// LatestAttestation streams the latest aggregated attestation to connected validator clients.
 	LatestAttestation(*types.Empty, BeaconService_LatestAttestationServer) error
 	PendingDeposits(context.Context, *types.Empty) (*PendingDepositsResponse, error)
 	Eth1Data(context.Context, *types.Empty) (*Eth1DataResponse, error)
 	ForkData(context.Context, *types.Empty) (*v1.Fork, error)
+	// SyncStatus reports whether the beacon node is still catching up with the network.
+	SyncStatus(context.Context, *types.Empty) (*SyncStatusResponse, error)
 }
 
 func RegisterBeaconServiceServer(s *grpc.Server, srv BeaconServiceServer) {
@@ -1757,6 +2230,24 @@ func _BeaconService_ForkData_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BeaconService_SyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).SyncStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/SyncStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).SyncStatus(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _BeaconService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethereum.beacon.rpc.v1.BeaconService",
 	HandlerType: (*BeaconServiceServer)(nil),
@@ -1777,6 +2268,10 @@ var _BeaconService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ForkData",
 			Handler:    _BeaconService_ForkData_Handler,
 		},
+		{
+			MethodName: "SyncStatus",
+			Handler:    _BeaconService_SyncStatus_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1898,6 +2393,8 @@ type ProposerServiceClient interface {
 	PendingAttestations(ctx context.Context, in *PendingAttestationsRequest, opts ...grpc.CallOption) (*PendingAttestationsResponse, error)
 	ProposeBlock(ctx context.Context, in *v1.BeaconBlock, opts ...grpc.CallOption) (*ProposeResponse, error)
 	ComputeStateRoot(ctx context.Context, in *v1.BeaconBlock, opts ...grpc.CallOption) (*StateRootResponse, error)
+	PendingBlockOperations(ctx context.Context, in *PendingBlockOperationsRequest, opts ...grpc.CallOption) (*PendingBlockOperationsResponse, error)
+	ProduceBlock(ctx context.Context, in *ProduceBlockRequest, opts ...grpc.CallOption) (*v1.BeaconBlock, error)
 }
 
 type proposerServiceClient struct {
@@ -1944,12 +2441,32 @@ func (c *proposerServiceClient) ComputeStateRoot(ctx context.Context, in *v1.Bea
 	return out, nil
 }
 
+func (c *proposerServiceClient) PendingBlockOperations(ctx context.Context, in *PendingBlockOperationsRequest, opts ...grpc.CallOption) (*PendingBlockOperationsResponse, error) {
+	out := new(PendingBlockOperationsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ProposerService/PendingBlockOperations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proposerServiceClient) ProduceBlock(ctx context.Context, in *ProduceBlockRequest, opts ...grpc.CallOption) (*v1.BeaconBlock, error) {
+	out := new(v1.BeaconBlock)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ProposerService/ProduceBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProposerServiceServer is the server API for ProposerService service.
 type ProposerServiceServer interface {
 	ProposerIndex(context.Context, *ProposerIndexRequest) (*ProposerIndexResponse, error)
 	PendingAttestations(context.Context, *PendingAttestationsRequest) (*PendingAttestationsResponse, error)
 	ProposeBlock(context.Context, *v1.BeaconBlock) (*ProposeResponse, error)
 	ComputeStateRoot(context.Context, *v1.BeaconBlock) (*StateRootResponse, error)
+	PendingBlockOperations(context.Context, *PendingBlockOperationsRequest) (*PendingBlockOperationsResponse, error)
+	ProduceBlock(context.Context, *ProduceBlockRequest) (*v1.BeaconBlock, error)
 }
 
 func RegisterProposerServiceServer(s *grpc.Server, srv ProposerServiceServer) {
@@ -2028,13 +2545,49 @@ func _ProposerService_ComputeStateRoot_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
-var _ProposerService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.beacon.rpc.v1.ProposerService",
-	HandlerType: (*ProposerServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ProposerIndex",
-			Handler:    _ProposerService_ProposerIndex_Handler,
+func _ProposerService_PendingBlockOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingBlockOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProposerServiceServer).PendingBlockOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/PendingBlockOperations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposerServiceServer).PendingBlockOperations(ctx, req.(*PendingBlockOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProposerService_ProduceBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProduceBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProposerServiceServer).ProduceBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/ProduceBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposerServiceServer).ProduceBlock(ctx, req.(*ProduceBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ProposerService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.ProposerService",
+	HandlerType: (*ProposerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ProposerIndex",
+			Handler:    _ProposerService_ProposerIndex_Handler,
 		},
 		{
 			MethodName: "PendingAttestations",
@@ -2048,6 +2601,14 @@ var _ProposerService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ComputeStateRoot",
 			Handler:    _ProposerService_ComputeStateRoot_Handler,
 		},
+		{
+			MethodName: "PendingBlockOperations",
+			Handler:    _ProposerService_PendingBlockOperations_Handler,
+		},
+		{
+			MethodName: "ProduceBlock",
+			Handler:    _ProposerService_ProduceBlock_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/beacon/rpc/v1/services.proto",
@@ -2062,6 +2623,8 @@ type ValidatorServiceClient interface {
 	CommitteeAssignment(ctx context.Context, in *CommitteeAssignmentsRequest, opts ...grpc.CallOption) (*CommitteeAssignmentResponse, error)
 	ValidatorStatus(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorStatusResponse, error)
 	ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error)
+	ValidatorAttestations(ctx context.Context, in *ValidatorAttestationsRequest, opts ...grpc.CallOption) (*ValidatorAttestationsResponse, error)
+	ProposeExit(ctx context.Context, in *v1.VoluntaryExit, opts ...grpc.CallOption) (*ProposeExitResponse, error)
 }
 
 type validatorServiceClient struct {
@@ -2140,6 +2703,24 @@ func (c *validatorServiceClient) ValidatorPerformance(ctx context.Context, in *V
 	return out, nil
 }
 
+func (c *validatorServiceClient) ValidatorAttestations(ctx context.Context, in *ValidatorAttestationsRequest, opts ...grpc.CallOption) (*ValidatorAttestationsResponse, error) {
+	out := new(ValidatorAttestationsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorAttestations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorServiceClient) ProposeExit(ctx context.Context, in *v1.VoluntaryExit, opts ...grpc.CallOption) (*ProposeExitResponse, error) {
+	out := new(ProposeExitResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ProposeExit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ValidatorServiceServer is the server API for ValidatorService service.
 type ValidatorServiceServer interface {
 	WaitForActivation(*ValidatorActivationRequest, ValidatorService_WaitForActivationServer) error
@@ -2147,6 +2728,8 @@ type ValidatorServiceServer interface {
 	CommitteeAssignment(context.Context, *CommitteeAssignmentsRequest) (*CommitteeAssignmentResponse, error)
 	ValidatorStatus(context.Context, *ValidatorIndexRequest) (*ValidatorStatusResponse, error)
 	ValidatorPerformance(context.Context, *ValidatorPerformanceRequest) (*ValidatorPerformanceResponse, error)
+	ValidatorAttestations(context.Context, *ValidatorAttestationsRequest) (*ValidatorAttestationsResponse, error)
+	ProposeExit(context.Context, *v1.VoluntaryExit) (*ProposeExitResponse, error)
 }
 
 func RegisterValidatorServiceServer(s *grpc.Server, srv ValidatorServiceServer) {
@@ -2246,6 +2829,42 @@ func _ValidatorService_ValidatorPerformance_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ValidatorService_ValidatorAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorAttestationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ValidatorAttestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorAttestations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ValidatorAttestations(ctx, req.(*ValidatorAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_ProposeExit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.VoluntaryExit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ProposeExit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ProposeExit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ProposeExit(ctx, req.(*v1.VoluntaryExit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ValidatorService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethereum.beacon.rpc.v1.ValidatorService",
 	HandlerType: (*ValidatorServiceServer)(nil),
@@ -2266,6 +2885,14 @@ var _ValidatorService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ValidatorPerformance",
 			Handler:    _ValidatorService_ValidatorPerformance_Handler,
 		},
+		{
+			MethodName: "ValidatorAttestations",
+			Handler:    _ValidatorService_ValidatorAttestations_Handler,
+		},
+		{
+			MethodName: "ProposeExit",
+			Handler:    _ValidatorService_ProposeExit_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2351,6 +2978,110 @@ func (m *ValidatorPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ValidatorAttestationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorAttestationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintServices(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.SinceSlot != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.SinceSlot))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ValidatorAttestationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorAttestationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, msg := range m.Attestations {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintServices(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ValidatorAttestationsResponse_ValidatorAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorAttestationsResponse_ValidatorAttestation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.PublicKey) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i += copy(dAtA[i:], m.PublicKey)
+	}
+	if m.Data != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Data.Size()))
+		n1, err := m.Data.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *ValidatorActivationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2397,11 +3128,11 @@ func (m *ValidatorActivationResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintServices(dAtA, i, uint64(m.Validator.Size()))
-		n1, err := m.Validator.MarshalTo(dAtA[i:])
+		n2, err := m.Validator.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n1
+		i += n2
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2482,11 +3213,11 @@ func (m *AttestationDataResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintServices(dAtA, i, uint64(m.LatestCrosslink.Size()))
-		n2, err := m.LatestCrosslink.MarshalTo(dAtA[i:])
+		n3, err := m.LatestCrosslink.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n2
+		i += n3
 	}
 	if m.HeadSlot != 0 {
 		dAtA[i] = 0x30
@@ -2643,31 +3374,31 @@ func (m *ProposeRequest) MarshalTo(dAtA []byte) (int, error) {
 		i += copy(dAtA[i:], m.AttestationBitmask)
 	}
 	if len(m.AttestationAggregateSig) > 0 {
-		dAtA4 := make([]byte, len(m.AttestationAggregateSig)*10)
-		var j3 int
+		dAtA5 := make([]byte, len(m.AttestationAggregateSig)*10)
+		var j4 int
 		for _, num := range m.AttestationAggregateSig {
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j4++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
 		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintServices(dAtA, i, uint64(j3))
-		i += copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintServices(dAtA, i, uint64(j4))
+		i += copy(dAtA[i:], dAtA5[:j4])
 	}
 	if m.Timestamp != nil {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintServices(dAtA, i, uint64(m.Timestamp.Size()))
-		n5, err := m.Timestamp.MarshalTo(dAtA[i:])
+		n6, err := m.Timestamp.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n6
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2928,40 +3659,7 @@ func (m *PendingDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *CommitteeAssignmentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *CommitteeAssignmentResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Assignment) > 0 {
-		for _, msg := range m.Assignment {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
-}
-
-func (m *CommitteeAssignmentResponse_CommitteeAssignment) Marshal() (dAtA []byte, err error) {
+func (m *ProduceBlockRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2971,58 +3669,21 @@ func (m *CommitteeAssignmentResponse_CommitteeAssignment) Marshal() (dAtA []byte
 	return dAtA[:n], nil
 }
 
-func (m *CommitteeAssignmentResponse_CommitteeAssignment) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProduceBlockRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Committee) > 0 {
-		dAtA7 := make([]byte, len(m.Committee)*10)
-		var j6 int
-		for _, num := range m.Committee {
-			for num >= 1<<7 {
-				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j6++
-			}
-			dAtA7[j6] = uint8(num)
-			j6++
-		}
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(j6))
-		i += copy(dAtA[i:], dAtA7[:j6])
-	}
-	if m.Shard != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Shard))
-	}
 	if m.Slot != 0 {
-		dAtA[i] = 0x18
+		dAtA[i] = 0x8
 		i++
 		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
 	}
-	if m.IsProposer {
-		dAtA[i] = 0x20
-		i++
-		if m.IsProposer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if len(m.PublicKey) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
-		i += copy(dAtA[i:], m.PublicKey)
-	}
-	if m.Status != 0 {
-		dAtA[i] = 0x30
+	if len(m.RandaoReveal) > 0 {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Status))
+		i = encodeVarintServices(dAtA, i, uint64(len(m.RandaoReveal)))
+		i += copy(dAtA[i:], m.RandaoReveal)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -3030,7 +3691,7 @@ func (m *CommitteeAssignmentResponse_CommitteeAssignment) MarshalTo(dAtA []byte)
 	return i, nil
 }
 
-func (m *ValidatorStatusResponse) Marshal() (dAtA []byte, err error) {
+func (m *PendingBlockOperationsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3040,15 +3701,15 @@ func (m *ValidatorStatusResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PendingBlockOperationsRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
+	if m.Slot != 0 {
 		dAtA[i] = 0x8
 		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Status))
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -3056,7 +3717,7 @@ func (m *ValidatorStatusResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *Eth1DataResponse) Marshal() (dAtA []byte, err error) {
+func (m *PendingBlockOperationsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3066,20 +3727,268 @@ func (m *Eth1DataResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Eth1DataResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PendingBlockOperationsResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Eth1Data != nil {
+	if len(m.ProposerSlashings) > 0 {
+		for _, msg := range m.ProposerSlashings {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintServices(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.AttesterSlashings) > 0 {
+		for _, msg := range m.AttesterSlashings {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintServices(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.VoluntaryExits) > 0 {
+		for _, msg := range m.VoluntaryExits {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintServices(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ProposeExitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposeExitResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExitHash) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.ExitHash)))
+		i += copy(dAtA[i:], m.ExitHash)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CommitteeAssignmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitteeAssignmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Assignment) > 0 {
+		for _, msg := range m.Assignment {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintServices(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *CommitteeAssignmentResponse_CommitteeAssignment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitteeAssignmentResponse_CommitteeAssignment) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Committee) > 0 {
+		dAtA8 := make([]byte, len(m.Committee)*10)
+		var j7 int
+		for _, num := range m.Committee {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(j7))
+		i += copy(dAtA[i:], dAtA8[:j7])
+	}
+	if m.Shard != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Shard))
+	}
+	if m.Slot != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+	}
+	if m.IsProposer {
+		dAtA[i] = 0x20
+		i++
+		if m.IsProposer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.PublicKey) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i += copy(dAtA[i:], m.PublicKey)
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Status))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ValidatorStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Status))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Eth1DataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Eth1DataResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Eth1Data != nil {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintServices(dAtA, i, uint64(m.Eth1Data.Size()))
-		n8, err := m.Eth1Data.MarshalTo(dAtA[i:])
+		n9, err := m.Eth1Data.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n9
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SyncStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Syncing {
+		dAtA[i] = 0x8
+		i++
+		if m.Syncing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.HeadSlot != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.HeadSlot))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -3139,15 +4048,20 @@ func (m *ValidatorPerformanceResponse) Size() (n int) {
 	return n
 }
 
-func (m *ValidatorActivationRequest) Size() (n int) {
+func (m *ValidatorAttestationsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Pubkey)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.SinceSlot != 0 {
+		n += 1 + sovServices(uint64(m.SinceSlot))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -3155,15 +4069,17 @@ func (m *ValidatorActivationRequest) Size() (n int) {
 	return n
 }
 
-func (m *ValidatorActivationResponse) Size() (n int) {
+func (m *ValidatorAttestationsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Validator != nil {
-		l = m.Validator.Size()
-		n += 1 + l + sovServices(uint64(l))
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -3171,17 +4087,19 @@ func (m *ValidatorActivationResponse) Size() (n int) {
 	return n
 }
 
-func (m *AttestationDataRequest) Size() (n int) {
+func (m *ValidatorAttestationsResponse_ValidatorAttestation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Shard != 0 {
-		n += 1 + sovServices(uint64(m.Shard))
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
 	}
-	if m.Slot != 0 {
-		n += 1 + sovServices(uint64(m.Slot))
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovServices(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -3189,17 +4107,67 @@ func (m *AttestationDataRequest) Size() (n int) {
 	return n
 }
 
-func (m *AttestationDataResponse) Size() (n int) {
+func (m *ValidatorActivationRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.BeaconBlockRootHash32)
+	l = len(m.Pubkey)
 	if l > 0 {
 		n += 1 + l + sovServices(uint64(l))
 	}
-	l = len(m.EpochBoundaryRootHash32)
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorActivationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Validator != nil {
+		l = m.Validator.Size()
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttestationDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Shard != 0 {
+		n += 1 + sovServices(uint64(m.Shard))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttestationDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BeaconBlockRootHash32)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	l = len(m.EpochBoundaryRootHash32)
 	if l > 0 {
 		n += 1 + l + sovServices(uint64(l))
 	}
@@ -3463,6 +4431,86 @@ func (m *PendingDepositsResponse) Size() (n int) {
 	return n
 }
 
+func (m *ProduceBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	l = len(m.RandaoReveal)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PendingBlockOperationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PendingBlockOperationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProposerSlashings) > 0 {
+		for _, e := range m.ProposerSlashings {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if len(m.AttesterSlashings) > 0 {
+		for _, e := range m.AttesterSlashings {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if len(m.VoluntaryExits) > 0 {
+		for _, e := range m.VoluntaryExits {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ProposeExitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExitHash)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *CommitteeAssignmentResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3492,75 +4540,817 @@ func (m *CommitteeAssignmentResponse_CommitteeAssignment) Size() (n int) {
 		for _, e := range m.Committee {
 			l += sovServices(uint64(e))
 		}
-		n += 1 + sovServices(uint64(l)) + l
-	}
-	if m.Shard != 0 {
-		n += 1 + sovServices(uint64(m.Shard))
-	}
-	if m.Slot != 0 {
-		n += 1 + sovServices(uint64(m.Slot))
-	}
-	if m.IsProposer {
-		n += 2
-	}
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	if m.Status != 0 {
-		n += 1 + sovServices(uint64(m.Status))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *ValidatorStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovServices(uint64(m.Status))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *Eth1DataResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Eth1Data != nil {
-		l = m.Eth1Data.Size()
-		n += 1 + l + sovServices(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		n += 1 + sovServices(uint64(l)) + l
+	}
+	if m.Shard != 0 {
+		n += 1 + sovServices(uint64(m.Shard))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	if m.IsProposer {
+		n += 2
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovServices(uint64(m.Status))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovServices(uint64(m.Status))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Eth1DataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Eth1Data != nil {
+		l = m.Eth1Data.Size()
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SyncStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Syncing {
+		n += 2
+	}
+	if m.HeadSlot != 0 {
+		n += 1 + sovServices(uint64(m.HeadSlot))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovServices(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozServices(x uint64) (n int) {
+	return sovServices(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			m.Balance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Balance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalValidators", wireType)
+			}
+			m.TotalValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalValidators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalActiveValidators", wireType)
+			}
+			m.TotalActiveValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalActiveValidators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageValidatorBalance", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.AverageValidatorBalance = float32(math.Float32frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorAttestationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorAttestationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorAttestationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceSlot", wireType)
+			}
+			m.SinceSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorAttestationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorAttestationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, &ValidatorAttestationsResponse_ValidatorAttestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorAttestationsResponse_ValidatorAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &v1.AttestationData{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorActivationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorActivationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorActivationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pubkey = append(m.Pubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.Pubkey == nil {
+				m.Pubkey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorActivationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorActivationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorActivationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Validator == nil {
+				m.Validator = &v1.Validator{}
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovServices(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozServices(x uint64) (n int) {
-	return sovServices(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
+func (m *AttestationDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3583,17 +5373,17 @@ func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorPerformanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AttestationDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorPerformanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AttestationDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
 			}
-			m.Slot = 0
+			m.Shard = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -3603,16 +5393,16 @@ func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
+				m.Shard |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
 			}
-			var byteLen int
+			m.Slot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -3622,26 +5412,11 @@ func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Slot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -3667,7 +5442,7 @@ func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
+func (m *AttestationDataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3690,17 +5465,17 @@ func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorPerformanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AttestationDataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorPerformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AttestationDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeaconBlockRootHash32", wireType)
 			}
-			m.Balance = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -3710,16 +5485,31 @@ func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Balance |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeaconBlockRootHash32 = append(m.BeaconBlockRootHash32[:0], dAtA[iNdEx:postIndex]...)
+			if m.BeaconBlockRootHash32 == nil {
+				m.BeaconBlockRootHash32 = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalValidators", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochBoundaryRootHash32", wireType)
 			}
-			m.TotalValidators = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -3729,16 +5519,120 @@ func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalValidators |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochBoundaryRootHash32 = append(m.EpochBoundaryRootHash32[:0], dAtA[iNdEx:postIndex]...)
+			if m.EpochBoundaryRootHash32 == nil {
+				m.EpochBoundaryRootHash32 = []byte{}
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalActiveValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedEpoch", wireType)
+			}
+			m.JustifiedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JustifiedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedBlockRootHash32", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JustifiedBlockRootHash32 = append(m.JustifiedBlockRootHash32[:0], dAtA[iNdEx:postIndex]...)
+			if m.JustifiedBlockRootHash32 == nil {
+				m.JustifiedBlockRootHash32 = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestCrosslink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LatestCrosslink == nil {
+				m.LatestCrosslink = &v1.Crosslink{}
+			}
+			if err := m.LatestCrosslink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadSlot", wireType)
 			}
-			m.TotalActiveValidators = 0
+			m.HeadSlot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -3748,22 +5642,11 @@ func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalActiveValidators |= uint64(b&0x7F) << shift
+				m.HeadSlot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageValidatorBalance", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.AverageValidatorBalance = float32(math.Float32frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -3789,7 +5672,7 @@ func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorActivationRequest) Unmarshal(dAtA []byte) error {
+func (m *PendingAttestationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3812,17 +5695,17 @@ func (m *ValidatorActivationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorActivationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingAttestationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorActivationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingAttestationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilterReadyForInclusion", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -3832,26 +5715,31 @@ func (m *ValidatorActivationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.FilterReadyForInclusion = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalBlockSlot", wireType)
 			}
-			m.Pubkey = append(m.Pubkey[:0], dAtA[iNdEx:postIndex]...)
-			if m.Pubkey == nil {
-				m.Pubkey = []byte{}
+			m.ProposalBlockSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalBlockSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -3877,7 +5765,7 @@ func (m *ValidatorActivationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorActivationResponse) Unmarshal(dAtA []byte) error {
+func (m *PendingAttestationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3900,15 +5788,15 @@ func (m *ValidatorActivationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorActivationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingAttestationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorActivationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAttestations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3935,10 +5823,8 @@ func (m *ValidatorActivationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Validator == nil {
-				m.Validator = &v1.Validator{}
-			}
-			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingAttestations = append(m.PendingAttestations, &v1.Attestation{})
+			if err := m.PendingAttestations[len(m.PendingAttestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3967,7 +5853,7 @@ func (m *ValidatorActivationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AttestationDataRequest) Unmarshal(dAtA []byte) error {
+func (m *ChainStartResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3990,17 +5876,17 @@ func (m *AttestationDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttestationDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChainStartResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttestationDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChainStartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
-			m.Shard = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4010,16 +5896,17 @@ func (m *AttestationDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Shard |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Started = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisTime", wireType)
 			}
-			m.Slot = 0
+			m.GenesisTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4029,7 +5916,7 @@ func (m *AttestationDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
+				m.GenesisTime |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4059,7 +5946,7 @@ func (m *AttestationDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AttestationDataResponse) Unmarshal(dAtA []byte) error {
+func (m *ProposeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4082,15 +5969,15 @@ func (m *AttestationDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttestationDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProposeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttestationDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProposeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeaconBlockRootHash32", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4117,50 +6004,16 @@ func (m *AttestationDataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BeaconBlockRootHash32 = append(m.BeaconBlockRootHash32[:0], dAtA[iNdEx:postIndex]...)
-			if m.BeaconBlockRootHash32 == nil {
-				m.BeaconBlockRootHash32 = []byte{}
+			m.ParentHash = append(m.ParentHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ParentHash == nil {
+				m.ParentHash = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochBoundaryRootHash32", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EpochBoundaryRootHash32 = append(m.EpochBoundaryRootHash32[:0], dAtA[iNdEx:postIndex]...)
-			if m.EpochBoundaryRootHash32 == nil {
-				m.EpochBoundaryRootHash32 = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotNumber", wireType)
 			}
-			m.JustifiedEpoch = 0
+			m.SlotNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4170,14 +6023,14 @@ func (m *AttestationDataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.JustifiedEpoch |= uint64(b&0x7F) << shift
+				m.SlotNumber |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedBlockRootHash32", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RandaoReveal", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4204,16 +6057,16 @@ func (m *AttestationDataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JustifiedBlockRootHash32 = append(m.JustifiedBlockRootHash32[:0], dAtA[iNdEx:postIndex]...)
-			if m.JustifiedBlockRootHash32 == nil {
-				m.JustifiedBlockRootHash32 = []byte{}
+			m.RandaoReveal = append(m.RandaoReveal[:0], dAtA[iNdEx:postIndex]...)
+			if m.RandaoReveal == nil {
+				m.RandaoReveal = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestCrosslink", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationBitmask", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4223,33 +6076,107 @@ func (m *AttestationDataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LatestCrosslink == nil {
-				m.LatestCrosslink = &v1.Crosslink{}
-			}
-			if err := m.LatestCrosslink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.AttestationBitmask = append(m.AttestationBitmask[:0], dAtA[iNdEx:postIndex]...)
+			if m.AttestationBitmask == nil {
+				m.AttestationBitmask = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeadSlot", wireType)
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowServices
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AttestationAggregateSig = append(m.AttestationAggregateSig, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowServices
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthServices
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthServices
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AttestationAggregateSig) == 0 {
+					m.AttestationAggregateSig = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowServices
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AttestationAggregateSig = append(m.AttestationAggregateSig, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationAggregateSig", wireType)
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.HeadSlot = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4259,104 +6186,28 @@ func (m *AttestationDataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HeadSlot |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipServices(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthServices
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PendingAttestationsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowServices
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PendingAttestationsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PendingAttestationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FilterReadyForInclusion", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.FilterReadyForInclusion = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalBlockSlot", wireType)
+			if m.Timestamp == nil {
+				m.Timestamp = &types.Timestamp{}
 			}
-			m.ProposalBlockSlot = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalBlockSlot |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -4382,7 +6233,7 @@ func (m *PendingAttestationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PendingAttestationsResponse) Unmarshal(dAtA []byte) error {
+func (m *ProposeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4405,17 +6256,17 @@ func (m *PendingAttestationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PendingAttestationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProposeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PendingAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProposeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingAttestations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRootHash32", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4425,24 +6276,24 @@ func (m *PendingAttestationsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingAttestations = append(m.PendingAttestations, &v1.Attestation{})
-			if err := m.PendingAttestations[len(m.PendingAttestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BlockRootHash32 = append(m.BlockRootHash32[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRootHash32 == nil {
+				m.BlockRootHash32 = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4470,7 +6321,7 @@ func (m *PendingAttestationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChainStartResponse) Unmarshal(dAtA []byte) error {
+func (m *ProposerIndexRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4493,37 +6344,17 @@ func (m *ChainStartResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChainStartResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProposerIndexRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainStartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProposerIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Started = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotNumber", wireType)
 			}
-			m.GenesisTime = 0
+			m.SlotNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4533,7 +6364,7 @@ func (m *ChainStartResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GenesisTime |= uint64(b&0x7F) << shift
+				m.SlotNumber |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4563,7 +6394,7 @@ func (m *ChainStartResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProposeRequest) Unmarshal(dAtA []byte) error {
+func (m *ProposerIndexResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4571,119 +6402,32 @@ func (m *ProposeRequest) Unmarshal(dAtA []byte) error {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
-				return ErrIntOverflowServices
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProposeRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProposeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentHash", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ParentHash = append(m.ParentHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.ParentHash == nil {
-				m.ParentHash = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlotNumber", wireType)
-			}
-			m.SlotNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SlotNumber |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RandaoReveal", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
+				return ErrIntOverflowServices
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RandaoReveal = append(m.RandaoReveal[:0], dAtA[iNdEx:postIndex]...)
-			if m.RandaoReveal == nil {
-				m.RandaoReveal = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttestationBitmask", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposerIndexResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposerIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var byteLen int
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4693,107 +6437,70 @@ func (m *ProposeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthServices
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttestationBitmask = append(m.AttestationBitmask[:0], dAtA[iNdEx:postIndex]...)
-			if m.AttestationBitmask == nil {
-				m.AttestationBitmask = []byte{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateRootResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowServices
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.AttestationAggregateSig = append(m.AttestationAggregateSig, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowServices
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthServices
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthServices
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.AttestationAggregateSig) == 0 {
-					m.AttestationAggregateSig = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowServices
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.AttestationAggregateSig = append(m.AttestationAggregateSig, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttestationAggregateSig", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateRootResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4803,26 +6510,24 @@ func (m *ProposeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Timestamp == nil {
-				m.Timestamp = &types.Timestamp{}
-			}
-			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StateRoot = append(m.StateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateRoot == nil {
+				m.StateRoot = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4850,7 +6555,7 @@ func (m *ProposeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProposeResponse) Unmarshal(dAtA []byte) error {
+func (m *AttestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4873,15 +6578,15 @@ func (m *ProposeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProposeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AttestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProposeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AttestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRootHash32", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4908,9 +6613,9 @@ func (m *ProposeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockRootHash32 = append(m.BlockRootHash32[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockRootHash32 == nil {
-				m.BlockRootHash32 = []byte{}
+			m.AttestationHash = append(m.AttestationHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AttestationHash == nil {
+				m.AttestationHash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4938,7 +6643,7 @@ func (m *ProposeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProposerIndexRequest) Unmarshal(dAtA []byte) error {
+func (m *ValidatorIndexRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4961,17 +6666,17 @@ func (m *ProposerIndexRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProposerIndexRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorIndexRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProposerIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlotNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
-			m.SlotNumber = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4981,11 +6686,26 @@ func (m *ProposerIndexRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SlotNumber |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -5011,7 +6731,7 @@ func (m *ProposerIndexRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProposerIndexResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorIndexResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5034,10 +6754,10 @@ func (m *ProposerIndexResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProposerIndexResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorIndexResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProposerIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5084,7 +6804,7 @@ func (m *ProposerIndexResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StateRootResponse) Unmarshal(dAtA []byte) error {
+func (m *CommitteeAssignmentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5103,19 +6823,38 @@ func (m *StateRootResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StateRootResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StateRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitteeAssignmentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitteeAssignmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStart", wireType)
+			}
+			m.EpochStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochStart |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5142,10 +6881,8 @@ func (m *StateRootResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StateRoot = append(m.StateRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.StateRoot == nil {
-				m.StateRoot = []byte{}
-			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5172,7 +6909,7 @@ func (m *StateRootResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AttestResponse) Unmarshal(dAtA []byte) error {
+func (m *PendingDepositsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5195,17 +6932,17 @@ func (m *AttestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingDepositsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttestationHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDeposits", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5215,24 +6952,24 @@ func (m *AttestResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttestationHash = append(m.AttestationHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.AttestationHash == nil {
-				m.AttestationHash = []byte{}
+			m.PendingDeposits = append(m.PendingDeposits, &v1.Deposit{})
+			if err := m.PendingDeposits[len(m.PendingDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -5260,7 +6997,7 @@ func (m *AttestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorIndexRequest) Unmarshal(dAtA []byte) error {
+func (m *ProduceBlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5283,15 +7020,34 @@ func (m *ValidatorIndexRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorIndexRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProduceBlockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProduceBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RandaoReveal", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5318,9 +7074,9 @@ func (m *ValidatorIndexRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
+			m.RandaoReveal = append(m.RandaoReveal[:0], dAtA[iNdEx:postIndex]...)
+			if m.RandaoReveal == nil {
+				m.RandaoReveal = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -5348,7 +7104,7 @@ func (m *ValidatorIndexRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorIndexResponse) Unmarshal(dAtA []byte) error {
+func (m *PendingBlockOperationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5371,17 +7127,17 @@ func (m *ValidatorIndexResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorIndexResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingBlockOperationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingBlockOperationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
 			}
-			m.Index = 0
+			m.Slot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5391,7 +7147,7 @@ func (m *ValidatorIndexResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				m.Slot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5421,7 +7177,7 @@ func (m *ValidatorIndexResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitteeAssignmentsRequest) Unmarshal(dAtA []byte) error {
+func (m *PendingBlockOperationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5444,17 +7200,17 @@ func (m *CommitteeAssignmentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitteeAssignmentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingBlockOperationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitteeAssignmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingBlockOperationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochStart", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSlashings", wireType)
 			}
-			m.EpochStart = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5464,16 +7220,31 @@ func (m *CommitteeAssignmentsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochStart |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerSlashings = append(m.ProposerSlashings, &v1.ProposerSlashing{})
+			if err := m.ProposerSlashings[len(m.ProposerSlashings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttesterSlashings", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5483,23 +7254,59 @@ func (m *CommitteeAssignmentsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			m.AttesterSlashings = append(m.AttesterSlashings, &v1.AttesterSlashing{})
+			if err := m.AttesterSlashings[len(m.AttesterSlashings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoluntaryExits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoluntaryExits = append(m.VoluntaryExits, &v1.VoluntaryExit{})
+			if err := m.VoluntaryExits[len(m.VoluntaryExits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5526,7 +7333,7 @@ func (m *CommitteeAssignmentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PendingDepositsResponse) Unmarshal(dAtA []byte) error {
+func (m *ProposeExitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5549,17 +7356,17 @@ func (m *PendingDepositsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PendingDepositsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProposeExitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PendingDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProposeExitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingDeposits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5569,24 +7376,24 @@ func (m *PendingDepositsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingDeposits = append(m.PendingDeposits, &v1.Deposit{})
-			if err := m.PendingDeposits[len(m.PendingDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ExitHash = append(m.ExitHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExitHash == nil {
+				m.ExitHash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -6106,6 +7913,99 @@ func (m *Eth1DataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SyncStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Syncing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Syncing = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadSlot", wireType)
+			}
+			m.HeadSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipServices(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -17,3 +17,7 @@ for ((i = 0; i < ${#mocks[@]}; i++)); do
     echo "generating $file for interfaces: $interfaces";
     mockgen -package=internal -destination=$file github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1 $interfaces
 done
+
+# Mocks for the remote signer service in proto/signer/v1/signer.proto.
+echo "generating ./validator/internal/remote_signer_mock.go for interfaces: RemoteSignerClient";
+mockgen -package=internal -destination=./validator/internal/remote_signer_mock.go github.com/prysmaticlabs/prysm/proto/signer/v1 RemoteSignerClient
@@ -50,6 +50,45 @@ func BitSetCount(b []byte) int {
 	return hamming.CountBitsBytes(b)
 }
 
+// Overlaps returns true if any bit is set in both bitfields.
+func Overlaps(a []byte, b []byte) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i]&b[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Or returns a bitfield with the bits set in either of the two bitfields. The
+// result is as long as the longer of the two.
+func Or(a []byte, b []byte) []byte {
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	result := make([]byte, len(a))
+	copy(result, a)
+	for i := range b {
+		result[i] |= b[i]
+	}
+	return result
+}
+
+// AndNot returns a bitfield with the bits set in a which are not set in b.
+func AndNot(a []byte, b []byte) []byte {
+	result := make([]byte, len(a))
+	copy(result, a)
+	for i := 0; i < len(result) && i < len(b); i++ {
+		result[i] &^= b[i]
+	}
+	return result
+}
+
+// IsSubset returns true if every bit set in sub is also set in super.
+func IsSubset(sub []byte, super []byte) bool {
+	return BitSetCount(AndNot(sub, super)) == 0
+}
+
 // BitLength returns the length of the bitfield in bytes.
 func BitLength(b int) int {
 	return (b + 7) / 8
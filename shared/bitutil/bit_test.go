@@ -109,3 +109,35 @@ func TestSetBitfield_LargerCommitteesThanIndex(t *testing.T) {
 
 	}
 }
+
+func TestBitfieldSetOperations(t *testing.T) {
+	tests := []struct {
+		a        []byte
+		b        []byte
+		overlaps bool
+		or       []byte
+		andNot   []byte
+		isSubset bool
+	}{
+		{a: []byte{128}, b: []byte{64}, overlaps: false, or: []byte{192}, andNot: []byte{128}, isSubset: false},
+		{a: []byte{192}, b: []byte{64}, overlaps: true, or: []byte{192}, andNot: []byte{128}, isSubset: false},
+		{a: []byte{64}, b: []byte{192}, overlaps: true, or: []byte{192}, andNot: []byte{0}, isSubset: true},
+		{a: []byte{0, 1}, b: []byte{1}, overlaps: false, or: []byte{1, 1}, andNot: []byte{0, 1}, isSubset: false},
+		{a: []byte{1}, b: []byte{1, 1}, overlaps: true, or: []byte{1, 1}, andNot: []byte{0}, isSubset: true},
+		{a: []byte{}, b: []byte{4}, overlaps: false, or: []byte{4}, andNot: []byte{}, isSubset: true},
+	}
+	for _, tt := range tests {
+		if Overlaps(tt.a, tt.b) != tt.overlaps {
+			t.Errorf("Overlaps(%v, %v) = %v, want = %v", tt.a, tt.b, !tt.overlaps, tt.overlaps)
+		}
+		if or := Or(tt.a, tt.b); !bytes.Equal(or, tt.or) {
+			t.Errorf("Or(%v, %v) = %v, want = %v", tt.a, tt.b, or, tt.or)
+		}
+		if andNot := AndNot(tt.a, tt.b); !bytes.Equal(andNot, tt.andNot) {
+			t.Errorf("AndNot(%v, %v) = %v, want = %v", tt.a, tt.b, andNot, tt.andNot)
+		}
+		if IsSubset(tt.a, tt.b) != tt.isSubset {
+			t.Errorf("IsSubset(%v, %v) = %v, want = %v", tt.a, tt.b, !tt.isSubset, tt.isSubset)
+		}
+	}
+}
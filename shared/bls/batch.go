@@ -0,0 +1,110 @@
+package bls
+
+import (
+	"runtime"
+	"sync"
+)
+
+// SignatureSet is a signature together with everything needed to verify it:
+// the public key at index i is expected to have signed the message at index i
+// under the given domain.
+type SignatureSet struct {
+	Signature  *Signature
+	PublicKeys []*PublicKey
+	Messages   [][]byte
+	Domain     uint64
+}
+
+// Verify checks a single signature set.
+func (s *SignatureSet) Verify() bool {
+	if len(s.PublicKeys) == 1 && len(s.Messages) == 1 {
+		return s.Signature.Verify(s.Messages[0], s.PublicKeys[0], s.Domain)
+	}
+	return s.Signature.VerifyMultiple(s.PublicKeys, s.Messages, s.Domain)
+}
+
+// SignatureBatch collects signature sets so they can be verified in one go
+// across a pool of workers.
+//
+// Sets are not merged into a single aggregate check: without blinding every
+// set with a random scalar, two invalid signatures could be crafted to cancel
+// each other out in the aggregate, and the underlying library does not expose
+// the scalar multiplication needed for that. Each set is therefore checked on
+// its own, which also lets the batch name the offending set.
+type SignatureBatch struct {
+	sets    []*SignatureSet
+	workers int
+}
+
+// NewSignatureBatch creates an empty batch verified by one worker per CPU.
+func NewSignatureBatch() *SignatureBatch {
+	return &SignatureBatch{workers: runtime.NumCPU()}
+}
+
+// Add appends a signature set to the batch and returns its index.
+func (b *SignatureBatch) Add(set *SignatureSet) int {
+	b.sets = append(b.sets, set)
+	return len(b.sets) - 1
+}
+
+// Len returns the number of signature sets in the batch.
+func (b *SignatureBatch) Len() int {
+	return len(b.sets)
+}
+
+// Verify checks every set in the batch. If any of them is invalid, it returns
+// false along with the index of the first invalid set in the order they were
+// added, so the result does not depend on how work was scheduled.
+func (b *SignatureBatch) Verify() (bool, int) {
+	workers := b.workers
+	if workers > len(b.sets) {
+		workers = len(b.sets)
+	}
+	if workers <= 1 {
+		for i, set := range b.sets {
+			if !set.Verify() {
+				return false, i
+			}
+		}
+		return true, -1
+	}
+
+	jobs := make(chan int, len(b.sets))
+	for i := range b.sets {
+		jobs <- i
+	}
+	close(jobs)
+
+	var lock sync.Mutex
+	firstInvalid := len(b.sets)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				// Jobs are handed out in order, so once a set has failed, every
+				// later set can be skipped without changing the result.
+				lock.Lock()
+				skip := i > firstInvalid
+				lock.Unlock()
+				if skip {
+					continue
+				}
+				if !b.sets[i].Verify() {
+					lock.Lock()
+					if i < firstInvalid {
+						firstInvalid = i
+					}
+					lock.Unlock()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if firstInvalid < len(b.sets) {
+		return false, firstInvalid
+	}
+	return true, -1
+}
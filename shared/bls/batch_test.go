@@ -0,0 +1,109 @@
+package bls_test
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+)
+
+func signatureSets(t testing.TB, n int) []*bls.SignatureSet {
+	sets := make([]*bls.SignatureSet, n)
+	for i := 0; i < n; i++ {
+		priv, err := bls.RandKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		msg := []byte(fmt.Sprintf("message %d", i))
+		sets[i] = &bls.SignatureSet{
+			Signature:  priv.Sign(msg, 0),
+			PublicKeys: []*bls.PublicKey{priv.PublicKey()},
+			Messages:   [][]byte{msg},
+			Domain:     0,
+		}
+	}
+	return sets
+}
+
+func TestSignatureBatch_Empty(t *testing.T) {
+	if valid, _ := bls.NewSignatureBatch().Verify(); !valid {
+		t.Error("Expected empty batch to verify")
+	}
+}
+
+func TestSignatureBatch_AllValid(t *testing.T) {
+	batch := bls.NewSignatureBatch()
+	for _, set := range signatureSets(t, 20) {
+		batch.Add(set)
+	}
+	if batch.Len() != 20 {
+		t.Errorf("Expected 20 signature sets in batch, received %d", batch.Len())
+	}
+	if valid, failed := batch.Verify(); !valid {
+		t.Errorf("Expected batch to verify, set %d failed", failed)
+	}
+}
+
+func TestSignatureBatch_ReportsFirstInvalidSet(t *testing.T) {
+	sets := signatureSets(t, 20)
+	// Swap the signatures of two sets so both of them become invalid.
+	sets[7].Signature, sets[13].Signature = sets[13].Signature, sets[7].Signature
+	batch := bls.NewSignatureBatch()
+	for _, set := range sets {
+		batch.Add(set)
+	}
+	valid, failed := batch.Verify()
+	if valid {
+		t.Fatal("Expected batch with invalid signatures to fail verification")
+	}
+	if failed != 7 {
+		t.Errorf("Expected set 7 to be reported as invalid, received %d", failed)
+	}
+}
+
+func TestSignatureBatch_MultipleMessages(t *testing.T) {
+	pubKeys := make([]*bls.PublicKey, 0, 2)
+	msgs := make([][]byte, 0, 2)
+	sigs := make([]*bls.Signature, 0, 2)
+	for i := 0; i < 2; i++ {
+		priv, err := bls.RandKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		msg := []byte(fmt.Sprintf("custody bit %d", i))
+		pubKeys = append(pubKeys, priv.PublicKey())
+		msgs = append(msgs, msg)
+		sigs = append(sigs, priv.Sign(msg, 1))
+	}
+	batch := bls.NewSignatureBatch()
+	batch.Add(&bls.SignatureSet{
+		Signature:  bls.AggregateSignatures(sigs),
+		PublicKeys: pubKeys,
+		Messages:   msgs,
+		Domain:     1,
+	})
+	batch.Add(&bls.SignatureSet{
+		Signature:  sigs[0],
+		PublicKeys: pubKeys,
+		Messages:   msgs,
+		Domain:     1,
+	})
+	valid, failed := batch.Verify()
+	if valid || failed != 1 {
+		t.Errorf("Expected only the partial aggregate to fail, received valid=%t failed=%d", valid, failed)
+	}
+}
+
+func BenchmarkSignatureBatch_Verify(b *testing.B) {
+	batch := bls.NewSignatureBatch()
+	for _, set := range signatureSets(b, 128) {
+		batch.Add(set)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if valid, _ := batch.Verify(); !valid {
+			b.Fatal("Expected batch to verify")
+		}
+	}
+}
@@ -110,6 +110,19 @@ func (s *Signature) VerifyAggregate(pubKeys []*PublicKey, msg []byte, domain uin
 	return s.val.VerifyAggregateCommon(keys, msg, domain)
 }
 
+// VerifyMultiple verifies an aggregate signature over distinct messages, where
+// each public key at index i signed the message at index i.
+func (s *Signature) VerifyMultiple(pubKeys []*PublicKey, msgs [][]byte, domain uint64) bool {
+	if len(pubKeys) != len(msgs) {
+		return false
+	}
+	var keys []*gobls.PublicKey
+	for _, v := range pubKeys {
+		keys = append(keys, v.val)
+	}
+	return s.val.VerifyAggregate(keys, msgs, domain)
+}
+
 // Marshal a signature into a byte slice.
 func (s *Signature) Marshal() []byte {
 	k := s.val.Serialize()
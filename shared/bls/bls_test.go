@@ -51,3 +51,27 @@ func TestVerifyAggregate(t *testing.T) {
 		t.Error("Signature did not verify")
 	}
 }
+
+func TestVerifyMultiple(t *testing.T) {
+	pubkeys := make([]*bls.PublicKey, 0, 10)
+	msgs := make([][]byte, 0, 10)
+	sigs := make([]*bls.Signature, 0, 10)
+	for i := 0; i < 10; i++ {
+		priv, _ := bls.RandKey(rand.Reader)
+		msg := []byte{'h', 'e', 'l', 'l', 'o', byte(i)}
+		pubkeys = append(pubkeys, priv.PublicKey())
+		msgs = append(msgs, msg)
+		sigs = append(sigs, priv.Sign(msg, 0))
+	}
+	aggSig := bls.AggregateSignatures(sigs)
+	if !aggSig.VerifyMultiple(pubkeys, msgs, 0) {
+		t.Error("Signature did not verify")
+	}
+	msgs[0], msgs[1] = msgs[1], msgs[0]
+	if aggSig.VerifyMultiple(pubkeys, msgs, 0) {
+		t.Error("Signature verified with messages assigned to the wrong keys")
+	}
+	if aggSig.VerifyMultiple(pubkeys, msgs[1:], 0) {
+		t.Error("Signature verified with mismatched number of keys and messages")
+	}
+}
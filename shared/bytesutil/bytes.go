@@ -97,3 +97,12 @@ func Xor(x []byte, y []byte) []byte {
 	}
 	return result
 }
+
+// Trunc returns the first 6 bytes of a byte slice, or the whole slice if it is
+// shorter. It is used to shorten long values such as public keys in logs.
+func Trunc(x []byte) []byte {
+	if len(x) > 6 {
+		return x[:6]
+	}
+	return x
+}
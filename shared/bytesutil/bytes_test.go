@@ -122,3 +122,21 @@ func TestFromBytes8(t *testing.T) {
 		}
 	}
 }
+
+func TestTrunc(t *testing.T) {
+	tests := []struct {
+		a []byte
+		b []byte
+	}{
+		{[]byte{}, []byte{}},
+		{[]byte{1, 2, 3}, []byte{1, 2, 3}},
+		{[]byte{1, 2, 3, 4, 5, 6}, []byte{1, 2, 3, 4, 5, 6}},
+		{[]byte{1, 2, 3, 4, 5, 6, 7, 8}, []byte{1, 2, 3, 4, 5, 6}},
+	}
+	for _, tt := range tests {
+		b := Trunc(tt.a)
+		if !bytes.Equal(b, tt.b) {
+			t.Errorf("Trunc(%v) = %v, want = %v", tt.a, b, tt.b)
+		}
+	}
+}
@@ -27,10 +27,10 @@ var log = logrus.WithField("prefix", "flags")
 type FeatureFlagConfig struct {
 	VerifyAttestationSigs        bool // VerifyAttestationSigs declares if the client will verify attestations.
 	EnableComputeStateRoot       bool // EnableComputeStateRoot implementation on server side.
-	EnableCrosslinks             bool // EnableCrosslinks in epoch processing.
 	EnableCheckBlockStateRoot    bool // EnableCheckBlockStateRoot in block processing.
 	EnableHistoricalStatePruning bool // EnableHistoricalStatePruning when updatifinalized states.
 	EnableCommitteesCache        bool // EnableCommitteesCache for state transition.
+	EnableBlockSigVerification   bool // EnableBlockSigVerification in the state transition of received blocks.
 }
 
 var featureConfig *FeatureFlagConfig
@@ -57,10 +57,6 @@ func ConfigureBeaconFeatures(ctx *cli.Context) {
 		log.Info("Enabled compute state root server side")
 		cfg.EnableComputeStateRoot = true
 	}
-	if ctx.GlobalBool(EnableCrosslinksFlag.Name) {
-		log.Info("Enabled crosslink computations")
-		cfg.EnableCrosslinks = true
-	}
 	if ctx.GlobalBool(EnableCheckBlockStateRootFlag.Name) {
 		log.Info("Enabled check block state root")
 		cfg.EnableCheckBlockStateRoot = true
@@ -73,6 +69,10 @@ func ConfigureBeaconFeatures(ctx *cli.Context) {
 		log.Info("Enabled committees cache")
 		cfg.EnableCommitteesCache = true
 	}
+	if ctx.GlobalBool(EnableBlockSigVerificationFlag.Name) {
+		log.Info("Enabled block signature verification")
+		cfg.EnableBlockSigVerification = true
+	}
 
 	InitFeatureConfig(cfg)
 }
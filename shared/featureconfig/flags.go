@@ -20,11 +20,6 @@ var (
 		Name:  "enable-compute-state-root",
 		Usage: "Enable server side compute state root. Default is a no-op implementation.",
 	}
-	// EnableCrosslinksFlag enables the processing of crosslinks in epoch processing. It is disabled by default.
-	EnableCrosslinksFlag = cli.BoolFlag{
-		Name:  "enable-crosslinks",
-		Usage: "Enable crosslinks in epoch processing, default is disabled.",
-	}
 	// EnableCommitteesCacheFlag enables crosslink committees cache for state transition. It is disabled by default.
 	EnableCommitteesCacheFlag = cli.BoolFlag{
 		Name:  "enable-committees-cache",
@@ -40,6 +35,12 @@ var (
 		Name:  "enable-historical-state-pruning",
 		Usage: "Enable database pruning of historical states after finalized epochs",
 	}
+	// EnableBlockSigVerificationFlag verifies the signatures carried by blocks and their operations
+	// in the state transition of received blocks. It is disabled by default.
+	EnableBlockSigVerificationFlag = cli.BoolFlag{
+		Name:  "enable-block-signature-verification",
+		Usage: "Verify the signatures of blocks and block operations in the state transition, default is disabled.",
+	}
 )
 
 // ValidatorFlags contains a list of all the feature flags that apply to the validator client.
@@ -48,8 +49,8 @@ var ValidatorFlags = []cli.Flag{}
 // BeaconChainFlags contains a list of all the feature flags that apply to the beacon-chain client.
 var BeaconChainFlags = []cli.Flag{
 	EnableComputeStateRootFlag,
-	EnableCrosslinksFlag,
 	EnableCommitteesCacheFlag,
 	EnableCheckBlockStateRootFlag,
 	EnableHistoricalStatePruningFlag,
+	EnableBlockSigVerificationFlag,
 }
@@ -0,0 +1,20 @@
+package hashutil
+
+import (
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// HashAttestationDataAndCustodyBit returns the message signed by an attester,
+// that is the hash of the attestation data together with the attester's custody bit.
+//
+// Spec pseudocode definition:
+//	message_hash = hash_tree_root(AttestationDataAndCustodyBit(data=attestation.data, custody_bit=custody_bit))
+func HashAttestationDataAndCustodyBit(data *pb.AttestationData, custodyBit bool) ([32]byte, error) {
+	if data == nil {
+		return [32]byte{}, ErrNilProto
+	}
+	return HashProto(&pb.AttestationDataAndCustodyBit{
+		Data:       data,
+		CustodyBit: custodyBit,
+	})
+}
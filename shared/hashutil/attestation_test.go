@@ -0,0 +1,39 @@
+package hashutil_test
+
+import (
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+func TestHashAttestationDataAndCustodyBit_DependsOnCustodyBit(t *testing.T) {
+	data := &pb.AttestationData{
+		Slot:  123,
+		Shard: 456,
+	}
+	bit0, err := hashutil.HashAttestationDataAndCustodyBit(data, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bit1, err := hashutil.HashAttestationDataAndCustodyBit(data, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bit0 == bit1 {
+		t.Error("Expected messages for different custody bits to differ")
+	}
+	dataRoot, err := hashutil.HashProto(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bit0 == dataRoot {
+		t.Error("Expected message to commit to the custody bit, not only the data")
+	}
+}
+
+func TestHashAttestationDataAndCustodyBit_nil(t *testing.T) {
+	if _, err := hashutil.HashAttestationDataAndCustodyBit(nil, false); err != hashutil.ErrNilProto {
+		t.Fatalf("Error from hashing nil data is not the correct type, instead it is: %v", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"reflect"
 
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
 // HashBeaconBlock hashes the full block without the proposer signature.
@@ -20,3 +21,21 @@ func HashBeaconBlock(bb *pb.BeaconBlock) ([32]byte, error) {
 
 	return HashProto(bb)
 }
+
+// HashProposal returns the message signed by the proposer of a beacon block,
+// that is the hash of the proposal data committing to the block's slot and root.
+//
+// Spec pseudocode definition:
+//	proposal = ProposalSignedData(state.slot, BEACON_CHAIN_SHARD_NUMBER, signed_root(block, "signature"))
+//	message_hash = hash_tree_root(proposal)
+func HashProposal(bb *pb.BeaconBlock) ([32]byte, error) {
+	blockRoot, err := HashBeaconBlock(bb)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return HashProto(&pb.ProposalSignedData{
+		Slot:            bb.Slot,
+		Shard:           params.BeaconConfig().BeaconChainShardNumber,
+		BlockRootHash32: blockRoot[:],
+	})
+}
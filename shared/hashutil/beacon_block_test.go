@@ -41,3 +41,38 @@ func TestHashBeaconBlock_nil(t *testing.T) {
 		t.Fatalf("Error from hashing nil block is not the correct type, instead it is: %v", err)
 	}
 }
+
+func TestHashProposal_IgnoresSignature(t *testing.T) {
+	blk := &pb.BeaconBlock{
+		Slot:      5,
+		Signature: []byte{'S', 'I', 'G'},
+	}
+	withSig, err := hashutil.HashProposal(blk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blk.Signature = nil
+	withoutSig, err := hashutil.HashProposal(blk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if withSig != withoutSig {
+		t.Error("Expected proposal message to be independent of the block signature")
+	}
+
+	blk.Slot = 6
+	otherSlot, err := hashutil.HashProposal(blk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if otherSlot == withoutSig {
+		t.Error("Expected proposal message to commit to the block slot")
+	}
+}
+
+func TestHashProposal_nil(t *testing.T) {
+	var blk *pb.BeaconBlock
+	if _, err := hashutil.HashProposal(blk); err != hashutil.ErrNilProto {
+		t.Fatalf("Error from hashing nil block is not the correct type, instead it is: %v", err)
+	}
+}
@@ -0,0 +1,22 @@
+package hashutil
+
+import (
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// HashVoluntaryExit returns the message signed by a validator requesting to exit,
+// that is the hash of the exit with an empty signature.
+//
+// Spec pseudocode definition:
+//	exit_message = hash_tree_root(
+//	  Exit(epoch=exit.epoch, validator_index=exit.validator_index, signature=EMPTY_SIGNATURE)
+//	)
+func HashVoluntaryExit(exit *pb.VoluntaryExit) ([32]byte, error) {
+	if exit == nil {
+		return [32]byte{}, ErrNilProto
+	}
+	return HashProto(&pb.VoluntaryExit{
+		Epoch:          exit.Epoch,
+		ValidatorIndex: exit.ValidatorIndex,
+	})
+}
@@ -0,0 +1,30 @@
+package hashutil_test
+
+import (
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+func TestHashVoluntaryExit_IgnoresSignature(t *testing.T) {
+	exit := &pb.VoluntaryExit{
+		Epoch:          5,
+		ValidatorIndex: 10,
+		Signature:      []byte{'S', 'I', 'G'},
+	}
+	withSig, err := hashutil.HashVoluntaryExit(exit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	withoutSig, err := hashutil.HashProto(&pb.VoluntaryExit{Epoch: 5, ValidatorIndex: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if withSig != withoutSig {
+		t.Error("Expected exit message to be independent of the exit signature")
+	}
+	if len(exit.Signature) == 0 {
+		t.Error("Expected hashing not to mutate the exit")
+	}
+}
@@ -0,0 +1,66 @@
+// Package main is a reference remote signer for the validator client. It holds
+// the keys of a validator keystore and serves signatures over gRPC, so the keys
+// do not have to be stored on the validator client host.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net"
+
+	pb "github.com/prysmaticlabs/prysm/proto/signer/v1"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var (
+	port         = flag.Int("port", 4200, "The port to serve gRPC signing requests on")
+	keystorePath = flag.String("keystore-path", "", "Path to the validator keystore directory")
+	password     = flag.String("password", "", "Password of the validator keys in the keystore")
+	tlsCert      = flag.String("tls-cert", "", "Certificate for secure gRPC")
+	tlsKey       = flag.String("tls-key", "", "Key for secure gRPC")
+	verbose      = flag.Bool("verbose", false, "Enable debug logging")
+)
+
+var log = logrus.WithField("prefix", "main")
+
+func main() {
+	flag.Parse()
+	if *verbose {
+		logrus.SetLevel(logrus.DebugLevel)
+	}
+
+	ks := keystore.NewKeystore(*keystorePath)
+	keys, err := ks.GetKeys(*keystorePath, params.BeaconConfig().ValidatorPrivkeyFileName, *password)
+	if err != nil {
+		log.Fatalf("Could not get validator keys: %v", err)
+	}
+	if len(keys) == 0 {
+		log.Fatalf("No validator keys found in %s", *keystorePath)
+	}
+
+	var opts []grpc.ServerOption
+	if *tlsCert != "" && *tlsKey != "" {
+		creds, err := credentials.NewServerTLSFromFile(*tlsCert, *tlsKey)
+		if err != nil {
+			log.Fatalf("Could not load TLS keys: %v", err)
+		}
+		opts = append(opts, grpc.Creds(creds))
+	} else {
+		log.Warn("You are using an insecure gRPC connection! Provide a certificate and key to connect securely")
+	}
+	s := grpc.NewServer(opts...)
+	pb.RegisterRemoteSignerServer(s, newServer(keys))
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", *port))
+	if err != nil {
+		log.Fatalf("Could not listen on port %d: %v", *port, err)
+	}
+	log.WithField("numKeys", len(keys)).Infof("Listening for signing requests on port %d", *port)
+	if err := s.Serve(lis); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	pb "github.com/prysmaticlabs/prysm/proto/signer/v1"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+)
+
+// server signs on behalf of validator clients with keys decrypted from a local
+// keystore. It signs whatever signing root it is sent; slashing protection is
+// left to the validator client requesting the signature.
+type server struct {
+	keys map[string]*keystore.Key
+}
+
+func newServer(keys map[string]*keystore.Key) *server {
+	return &server{keys: keys}
+}
+
+// ListPublicKeys returns the public keys of every validator key held by the server.
+func (s *server) ListPublicKeys(ctx context.Context, req *pb.ListPublicKeysRequest) (*pb.ListPublicKeysResponse, error) {
+	ids := make([]string, 0, len(s.keys))
+	for id := range s.keys {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	pubKeys := make([][]byte, 0, len(ids))
+	for _, id := range ids {
+		pubKeys = append(pubKeys, s.keys[id].PublicKey.Marshal())
+	}
+	return &pb.ListPublicKeysResponse{PublicKeys: pubKeys}, nil
+}
+
+// Sign the requested signing root under the given domain with the key of the
+// requested validator.
+func (s *server) Sign(ctx context.Context, req *pb.SignRequest) (*pb.SignResponse, error) {
+	key, ok := s.keys[hex.EncodeToString(req.PublicKey)]
+	if !ok {
+		return nil, fmt.Errorf("no key held for public key %#x", req.PublicKey)
+	}
+	if len(req.SigningRoot) != 32 {
+		return nil, fmt.Errorf("expected signing root of length 32, received %d", len(req.SigningRoot))
+	}
+	log.WithField("publicKey", fmt.Sprintf("%#x", req.PublicKey)).Debug("Signing request")
+	return &pb.SignResponse{
+		Signature: key.SecretKey.Sign(req.SigningRoot, req.Domain).Marshal(),
+	}, nil
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/signer/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+)
+
+var _ = pb.RemoteSignerServer(&server{})
+
+func newTestServer(t *testing.T, n int) (*server, []*keystore.Key) {
+	keys := make(map[string]*keystore.Key, n)
+	list := make([]*keystore.Key, 0, n)
+	for i := 0; i < n; i++ {
+		key, err := keystore.NewKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys[hex.EncodeToString(key.PublicKey.Marshal())] = key
+		list = append(list, key)
+	}
+	return newServer(keys), list
+}
+
+func TestListPublicKeys(t *testing.T) {
+	srv, keys := newTestServer(t, 3)
+	resp, err := srv.ListPublicKeys(context.Background(), &pb.ListPublicKeysRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.PublicKeys) != len(keys) {
+		t.Fatalf("Expected %d public keys, received %d", len(keys), len(resp.PublicKeys))
+	}
+	for i := 1; i < len(resp.PublicKeys); i++ {
+		if hex.EncodeToString(resp.PublicKeys[i-1]) >= hex.EncodeToString(resp.PublicKeys[i]) {
+			t.Errorf("Expected public keys to be sorted, received %#x before %#x", resp.PublicKeys[i-1], resp.PublicKeys[i])
+		}
+	}
+}
+
+func TestSign(t *testing.T) {
+	srv, keys := newTestServer(t, 2)
+	root := make([]byte, 32)
+	copy(root, "signing root")
+	resp, err := srv.Sign(context.Background(), &pb.SignRequest{
+		PublicKey:   keys[1].PublicKey.Marshal(),
+		SigningRoot: root,
+		Domain:      7,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := bls.SignatureFromBytes(resp.Signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sig.Verify(root, keys[1].PublicKey, 7) {
+		t.Error("Expected signature to verify against the requested key and domain")
+	}
+}
+
+func TestSign_UnknownKey(t *testing.T) {
+	srv, _ := newTestServer(t, 1)
+	other, err := keystore.NewKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = srv.Sign(context.Background(), &pb.SignRequest{
+		PublicKey:   other.PublicKey.Marshal(),
+		SigningRoot: make([]byte, 32),
+	})
+	if err == nil || !strings.Contains(err.Error(), "no key held") {
+		t.Errorf("Expected unknown key to be rejected, received %v", err)
+	}
+}
+
+func TestSign_InvalidSigningRoot(t *testing.T) {
+	srv, keys := newTestServer(t, 1)
+	_, err := srv.Sign(context.Background(), &pb.SignRequest{
+		PublicKey:   keys[0].PublicKey.Marshal(),
+		SigningRoot: []byte("short"),
+	})
+	if err == nil || !strings.Contains(err.Error(), "signing root of length 32") {
+		t.Errorf("Expected malformed signing root to be rejected, received %v", err)
+	}
+}
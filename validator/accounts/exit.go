@@ -0,0 +1,132 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// ValidatorKey loads the validator key with the given hex encoded public key from
+// the keystore in the provided directory. The public key may be omitted when the
+// keystore holds a single validator key.
+func ValidatorKey(directory string, password string, pubKey string) (*keystore.Key, error) {
+	if directory == "" || password == "" {
+		return nil, errors.New("expected a path to the validator keystore and password to be provided, received nil")
+	}
+	ks := keystore.NewKeystore(directory)
+	keys, err := ks.GetKeys(directory, params.BeaconConfig().ValidatorPrivkeyFileName, password)
+	if err != nil {
+		return nil, fmt.Errorf("could not get validator keys: %v", err)
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no validator keys found in %s", directory)
+	}
+	if pubKey == "" {
+		if len(keys) > 1 {
+			available := make([]string, 0, len(keys))
+			for k := range keys {
+				available = append(available, "0x"+k)
+			}
+			sort.Strings(available)
+			return nil, fmt.Errorf(
+				"keystore holds %d validator keys, choose one with its public key: %s",
+				len(keys), strings.Join(available, ", "),
+			)
+		}
+		for _, key := range keys {
+			return key, nil
+		}
+	}
+	key, ok := keys[strings.TrimPrefix(pubKey, "0x")]
+	if !ok {
+		return nil, fmt.Errorf("no validator key in keystore for public key %s", pubKey)
+	}
+	return key, nil
+}
+
+// Exit connects to the beacon node at the given endpoint, secured with the
+// certificate if one is provided, and voluntarily exits the validator holding the
+// given key.
+func Exit(ctx context.Context, endpoint string, cert string, key *keystore.Key) error {
+	dialOpt := grpc.WithInsecure()
+	if cert != "" {
+		creds, err := credentials.NewClientTLSFromFile(cert, "")
+		if err != nil {
+			return fmt.Errorf("could not get valid credentials: %v", err)
+		}
+		dialOpt = grpc.WithTransportCredentials(creds)
+	}
+	conn, err := grpc.DialContext(ctx, endpoint, dialOpt)
+	if err != nil {
+		return fmt.Errorf("could not dial endpoint %s: %v", endpoint, err)
+	}
+	defer conn.Close()
+	_, err = ExitValidator(ctx, key, pb.NewBeaconServiceClient(conn), pb.NewValidatorServiceClient(conn))
+	return err
+}
+
+// ExitValidator signs a voluntary exit for the validator holding the given key,
+// effective at the current epoch of the beacon node's canonical head, and submits
+// it to the beacon node. Once included in the beacon chain, an exit cannot be
+// undone. The hash of the submitted exit is returned.
+func ExitValidator(
+	ctx context.Context,
+	key *keystore.Key,
+	beaconClient pb.BeaconServiceClient,
+	validatorClient pb.ValidatorServiceClient,
+) ([]byte, error) {
+	pubKey := key.PublicKey.Marshal()
+	indexResp, err := validatorClient.ValidatorIndex(ctx, &pb.ValidatorIndexRequest{PublicKey: pubKey})
+	if err != nil {
+		return nil, fmt.Errorf("could not get validator index: %v", err)
+	}
+	head, err := beaconClient.CanonicalHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("could not get canonical head: %v", err)
+	}
+	fork, err := beaconClient.ForkData(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("could not get fork data: %v", err)
+	}
+
+	// Let exit_message = hash_tree_root(
+	//   Exit(epoch=exit.epoch, validator_index=exit.validator_index, signature=EMPTY_SIGNATURE)
+	// )
+	// exit.signature = bls_sign(privkey=validator.privkey, message_hash=exit_message,
+	//   domain=get_domain(fork, exit.epoch, DOMAIN_EXIT)).
+	exit := &pbp2p.VoluntaryExit{
+		Epoch:          head.Slot / params.BeaconConfig().SlotsPerEpoch,
+		ValidatorIndex: indexResp.Index,
+	}
+	exitMessage, err := hashutil.HashVoluntaryExit(exit)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash exit: %v", err)
+	}
+	domain := forkutil.DomainVersion(fork, exit.Epoch, params.BeaconConfig().DomainExit)
+	exit.Signature = key.SecretKey.Sign(exitMessage[:], domain).Marshal()
+
+	resp, err := validatorClient.ProposeExit(ctx, exit)
+	if err != nil {
+		return nil, fmt.Errorf("could not propose exit: %v", err)
+	}
+	log.WithFields(logrus.Fields{
+		"pubKey":         fmt.Sprintf("%#x", pubKey),
+		"validatorIndex": exit.ValidatorIndex,
+		"epoch":          exit.Epoch - params.BeaconConfig().GenesisEpoch,
+		"exitHash":       fmt.Sprintf("%#x", resp.ExitHash),
+	}).Info("Submitted voluntary exit")
+	return resp.ExitHash, nil
+}
@@ -0,0 +1,112 @@
+package accounts
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"strings"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/validator/internal"
+)
+
+func TestValidatorKey(t *testing.T) {
+	directory := testutil.TempDir() + "/exitkeystore"
+	defer os.RemoveAll(directory)
+	ks := keystore.NewKeystore(directory)
+	keys := make([]*keystore.Key, 2)
+	for i := range keys {
+		key, err := keystore.NewKey(rand.Reader)
+		if err != nil {
+			t.Fatalf("Cannot create new key: %v", err)
+		}
+		file := directory + params.BeaconConfig().ValidatorPrivkeyFileName + string(rune('a'+i))
+		if err := ks.StoreKey(file, key, "password"); err != nil {
+			t.Fatalf("Unable to store key %v", err)
+		}
+		keys[i] = key
+	}
+
+	if _, err := ValidatorKey(directory, "password", ""); err == nil ||
+		!strings.Contains(err.Error(), "choose one with its public key") {
+		t.Errorf("Expected an error asking to choose a key, received %v", err)
+	}
+	pubKey := keys[1].PublicKey.Marshal()
+	key, err := ValidatorKey(directory, "password", "0x"+hex.EncodeToString(pubKey))
+	if err != nil {
+		t.Fatalf("Could not load validator key: %v", err)
+	}
+	if !bytes.Equal(key.PublicKey.Marshal(), pubKey) {
+		t.Error("Loaded the wrong validator key")
+	}
+	if _, err := ValidatorKey(directory, "password", "0x1234"); err == nil {
+		t.Error("Expected an error for an unknown public key")
+	}
+}
+
+func TestExitValidator(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := internal.NewMockBeaconServiceClient(ctrl)
+	validatorClient := internal.NewMockValidatorServiceClient(ctrl)
+
+	key, err := keystore.NewKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("Cannot create new key: %v", err)
+	}
+	epoch := params.BeaconConfig().GenesisEpoch + 3
+	fork := &pbp2p.Fork{Epoch: params.BeaconConfig().GenesisEpoch}
+
+	validatorClient.EXPECT().ValidatorIndex(
+		gomock.Any(), // ctx
+		&pb.ValidatorIndexRequest{PublicKey: key.PublicKey.Marshal()},
+	).Return(&pb.ValidatorIndexResponse{Index: 5}, nil)
+	beaconClient.EXPECT().CanonicalHead(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.BeaconBlock{Slot: epoch*params.BeaconConfig().SlotsPerEpoch + 1}, nil)
+	beaconClient.EXPECT().ForkData(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(fork, nil)
+
+	var proposed *pbp2p.VoluntaryExit
+	validatorClient.EXPECT().ProposeExit(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pbp2p.VoluntaryExit{}),
+	).DoAndReturn(func(_ context.Context, exit *pbp2p.VoluntaryExit) (*pb.ProposeExitResponse, error) {
+		proposed = exit
+		return &pb.ProposeExitResponse{ExitHash: []byte{'A'}}, nil
+	})
+
+	if _, err := ExitValidator(context.Background(), key, beaconClient, validatorClient); err != nil {
+		t.Fatalf("Could not exit validator: %v", err)
+	}
+	if proposed.ValidatorIndex != 5 || proposed.Epoch != epoch {
+		t.Errorf("Unexpected exit %v", proposed)
+	}
+	exitMessage, err := hashutil.HashVoluntaryExit(proposed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := bls.SignatureFromBytes(proposed.Signature)
+	if err != nil {
+		t.Fatalf("Could not deserialize signature: %v", err)
+	}
+	domain := forkutil.DomainVersion(fork, epoch, params.BeaconConfig().DomainExit)
+	if !sig.Verify(exitMessage[:], key.PublicKey, domain) {
+		t.Error("Exit signature did not verify")
+	}
+}
@@ -0,0 +1,63 @@
+package accounts
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/prysmaticlabs/prysm/validator/db"
+)
+
+// ExportSigningHistory writes the slashing protection history stored in the
+// validator database at dbPath to the given file, so it can be imported by the
+// validator client the keys are moved to.
+func ExportSigningHistory(dbPath string, file string) error {
+	if file == "" {
+		return errors.New("expected a path to the history file to be provided, received nil")
+	}
+	validatorDB, err := db.NewDB(dbPath)
+	if err != nil {
+		return fmt.Errorf("could not open validator database: %v", err)
+	}
+	defer validatorDB.Close()
+
+	f, err := os.OpenFile(file, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0600)
+	if err != nil {
+		return fmt.Errorf("could not create history file: %v", err)
+	}
+	if err := validatorDB.ExportHistory(f); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("could not write history file: %v", err)
+	}
+	log.WithField("path", file).Info("Exported validator signing history")
+	return nil
+}
+
+// ImportSigningHistory merges the slashing protection history from the given
+// file into the validator database at dbPath. Records already present in the
+// database are kept.
+func ImportSigningHistory(dbPath string, file string) error {
+	if file == "" {
+		return errors.New("expected a path to the history file to be provided, received nil")
+	}
+	f, err := os.Open(file)
+	if err != nil {
+		return fmt.Errorf("could not open history file: %v", err)
+	}
+	defer f.Close()
+
+	validatorDB, err := db.NewDB(dbPath)
+	if err != nil {
+		return fmt.Errorf("could not open validator database: %v", err)
+	}
+	defer validatorDB.Close()
+
+	if err := validatorDB.ImportHistory(f); err != nil {
+		return err
+	}
+	log.WithField("path", file).Info("Imported validator signing history")
+	return nil
+}
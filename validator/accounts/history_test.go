@@ -0,0 +1,49 @@
+package accounts
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/validator/db"
+)
+
+func TestExportImportSigningHistory(t *testing.T) {
+	sourceDir := path.Join(testutil.TempDir(), "/sourcehistory")
+	targetDir := path.Join(testutil.TempDir(), "/targethistory")
+	file := path.Join(testutil.TempDir(), "/history.json")
+	defer os.RemoveAll(sourceDir)
+	defer os.RemoveAll(targetDir)
+	defer os.RemoveAll(file)
+
+	sourceDB, err := db.NewDB(sourceDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sourceDB.CheckAndSaveProposal([]byte("A"), 10, []byte("block")); err != nil {
+		t.Fatal(err)
+	}
+	if err := sourceDB.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ExportSigningHistory(sourceDir, file); err != nil {
+		t.Fatalf("Could not export history: %v", err)
+	}
+	if err := ExportSigningHistory(sourceDir, file); err == nil {
+		t.Error("Expected export to refuse overwriting an existing file")
+	}
+	if err := ImportSigningHistory(targetDir, file); err != nil {
+		t.Fatalf("Could not import history: %v", err)
+	}
+
+	targetDB, err := db.NewDB(targetDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer targetDB.Close()
+	if err := targetDB.CheckAndSaveProposal([]byte("A"), 10, []byte("other block")); err != db.ErrDoubleProposal {
+		t.Errorf("Expected imported history to refuse double proposal, received %v", err)
+	}
+}
@@ -0,0 +1,175 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxHeadSlotLag is how many slots a beacon node's head may trail the highest
+// head seen across all beacon nodes while still being considered in sync. Nodes
+// routinely receive blocks a moment apart, so a small lag is tolerated to avoid
+// switching back and forth between equally healthy nodes.
+const maxHeadSlotLag = 2
+
+var activeBeaconNodeGaugeVec = promauto.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "validator_active_beacon_node",
+		Help: "Set to 1 for the beacon node endpoint duties are currently sent to, 0 for the others.",
+	},
+	[]string{
+		// Beacon node RPC endpoint.
+		"endpoint",
+	},
+)
+
+// beaconNode holds the service clients of one of the beacon nodes the validator
+// client can perform its duties through, along with its last known health.
+type beaconNode struct {
+	endpoint        string
+	beaconClient    pb.BeaconServiceClient
+	validatorClient pb.ValidatorServiceClient
+	attesterClient  pb.AttesterServiceClient
+	proposerClient  pb.ProposerServiceClient
+
+	healthy  bool
+	syncing  bool
+	headSlot uint64
+}
+
+// beaconNodeSet health checks a list of beacon nodes, given in order of
+// preference, and selects the one duties should be sent to.
+type beaconNodeSet struct {
+	nodes  []*beaconNode
+	active int
+	lock   sync.Mutex
+}
+
+func newBeaconNodeSet(nodes []*beaconNode) *beaconNodeSet {
+	for i, node := range nodes {
+		// Nodes are assumed healthy until the first health check says otherwise.
+		node.healthy = true
+		gauge := activeBeaconNodeGaugeVec.WithLabelValues(node.endpoint)
+		if i == 0 {
+			gauge.Set(1)
+		} else {
+			gauge.Set(0)
+		}
+	}
+	return &beaconNodeSet{nodes: nodes}
+}
+
+// run health checks every beacon node at the given interval until the context
+// is canceled.
+func (s *beaconNodeSet) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		s.checkHealth(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkHealth queries the sync status of every beacon node concurrently.
+func (s *beaconNodeSet) checkHealth(ctx context.Context) {
+	type result struct {
+		healthy  bool
+		syncing  bool
+		headSlot uint64
+	}
+	results := make([]result, len(s.nodes))
+	var wg sync.WaitGroup
+	for i, node := range s.nodes {
+		wg.Add(1)
+		go func(i int, node *beaconNode) {
+			defer wg.Done()
+			syncing, headSlot, err := nodeSyncStatus(ctx, node)
+			if err != nil {
+				log.WithField("endpoint", node.endpoint).Debugf("Beacon node health check failed: %v", err)
+				return
+			}
+			results[i] = result{healthy: true, syncing: syncing, headSlot: headSlot}
+		}(i, node)
+	}
+	wg.Wait()
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for i, node := range s.nodes {
+		node.healthy = results[i].healthy
+		node.syncing = results[i].syncing
+		node.headSlot = results[i].headSlot
+	}
+}
+
+// nodeSyncStatus asks a beacon node whether it is syncing and for the slot of
+// its canonical head. Beacon nodes which do not implement the sync status RPC
+// yet are considered synced, with their head slot taken from CanonicalHead.
+func nodeSyncStatus(ctx context.Context, node *beaconNode) (bool, uint64, error) {
+	resp, err := node.beaconClient.SyncStatus(ctx, &ptypes.Empty{})
+	if err == nil {
+		return resp.Syncing, resp.HeadSlot, nil
+	}
+	if errCode, ok := status.FromError(err); !ok || errCode.Code() != codes.Unimplemented {
+		return false, 0, err
+	}
+	head, err := node.beaconClient.CanonicalHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return false, 0, err
+	}
+	return false, head.Slot, nil
+}
+
+// selectNode returns the beacon node duties should be sent to and whether it
+// differs from the previously selected one. The current node is kept as long as
+// it is healthy, synced and not trailing the highest head. Otherwise the first
+// such node in order of preference is selected. If no node qualifies, the
+// current node is kept as there is nothing better to switch to.
+func (s *beaconNodeSet) selectNode() (*beaconNode, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var highestHead uint64
+	for _, node := range s.nodes {
+		if node.healthy && !node.syncing && node.headSlot > highestHead {
+			highestHead = node.headSlot
+		}
+	}
+	qualifies := func(node *beaconNode) bool {
+		return node.healthy && !node.syncing && node.headSlot+maxHeadSlotLag >= highestHead
+	}
+
+	current := s.nodes[s.active]
+	if qualifies(current) {
+		return current, false
+	}
+	for i, node := range s.nodes {
+		if !qualifies(node) {
+			continue
+		}
+		log.WithFields(logrus.Fields{
+			"from":        current.endpoint,
+			"to":          node.endpoint,
+			"fromHealthy": current.healthy,
+			"fromSyncing": current.syncing,
+		}).Warn("Switching beacon node")
+		activeBeaconNodeGaugeVec.WithLabelValues(current.endpoint).Set(0)
+		activeBeaconNodeGaugeVec.WithLabelValues(node.endpoint).Set(1)
+		s.active = i
+		return node, true
+	}
+	log.WithField("endpoint", current.endpoint).Warn("No healthy beacon node to switch to")
+	return current, false
+}
@@ -0,0 +1,164 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/validator/internal"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSelectNode_KeepsHealthyCurrentNode(t *testing.T) {
+	set := newBeaconNodeSet([]*beaconNode{
+		{endpoint: "a"},
+		{endpoint: "b"},
+	})
+	set.nodes[1].headSlot = 1
+
+	node, switched := set.selectNode()
+	if switched {
+		t.Error("Expected to keep the current beacon node")
+	}
+	if node.endpoint != "a" {
+		t.Errorf("Expected beacon node a, received %s", node.endpoint)
+	}
+}
+
+func TestSelectNode_SwitchesFromUnhealthyNode(t *testing.T) {
+	tests := []struct {
+		name    string
+		current beaconNode
+	}{
+		{
+			name:    "unreachable",
+			current: beaconNode{endpoint: "a", healthy: false, headSlot: 10},
+		},
+		{
+			name:    "syncing",
+			current: beaconNode{endpoint: "a", healthy: true, syncing: true, headSlot: 10},
+		},
+		{
+			name:    "lagging",
+			current: beaconNode{endpoint: "a", healthy: true, headSlot: 10 - maxHeadSlotLag - 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hook := logTest.NewGlobal()
+			current := tt.current
+			set := &beaconNodeSet{nodes: []*beaconNode{
+				&current,
+				{endpoint: "b", healthy: true, syncing: true, headSlot: 10},
+				{endpoint: "c", healthy: true, headSlot: 10},
+			}}
+
+			node, switched := set.selectNode()
+			if !switched {
+				t.Fatal("Expected to switch beacon nodes")
+			}
+			if node.endpoint != "c" {
+				t.Errorf("Expected beacon node c, received %s", node.endpoint)
+			}
+			if set.active != 2 {
+				t.Errorf("Expected active index 2, received %d", set.active)
+			}
+			testutil.AssertLogsContain(t, hook, "Switching beacon node")
+		})
+	}
+}
+
+func TestSelectNode_NoHealthyNode(t *testing.T) {
+	hook := logTest.NewGlobal()
+	set := &beaconNodeSet{nodes: []*beaconNode{
+		{endpoint: "a", healthy: false},
+		{endpoint: "b", healthy: true, syncing: true},
+	}}
+
+	node, switched := set.selectNode()
+	if switched {
+		t.Error("Expected to keep the current beacon node")
+	}
+	if node.endpoint != "a" {
+		t.Errorf("Expected beacon node a, received %s", node.endpoint)
+	}
+	testutil.AssertLogsContain(t, hook, "No healthy beacon node to switch to")
+}
+
+func TestCheckHealth_UpdatesNodes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	failing := internal.NewMockBeaconServiceClient(ctrl)
+	syncing := internal.NewMockBeaconServiceClient(ctrl)
+	legacy := internal.NewMockBeaconServiceClient(ctrl)
+
+	failing.EXPECT().SyncStatus(
+		gomock.Any(), // ctx
+		gomock.Any(), // empty
+	).Return(nil, errors.New("connection refused"))
+	syncing.EXPECT().SyncStatus(
+		gomock.Any(), // ctx
+		gomock.Any(), // empty
+	).Return(&pb.SyncStatusResponse{Syncing: true, HeadSlot: 5}, nil)
+	legacy.EXPECT().SyncStatus(
+		gomock.Any(), // ctx
+		gomock.Any(), // empty
+	).Return(nil, status.Error(codes.Unimplemented, "unknown method SyncStatus"))
+	legacy.EXPECT().CanonicalHead(
+		gomock.Any(), // ctx
+		gomock.Any(), // empty
+	).Return(&pbp2p.BeaconBlock{Slot: 7}, nil)
+
+	set := newBeaconNodeSet([]*beaconNode{
+		{endpoint: "failing", beaconClient: failing},
+		{endpoint: "syncing", beaconClient: syncing},
+		{endpoint: "legacy", beaconClient: legacy},
+	})
+	set.checkHealth(context.Background())
+
+	if set.nodes[0].healthy {
+		t.Error("Expected failing beacon node to be unhealthy")
+	}
+	if !set.nodes[1].healthy || !set.nodes[1].syncing || set.nodes[1].headSlot != 5 {
+		t.Errorf("Unexpected status for syncing beacon node: %+v", set.nodes[1])
+	}
+	if !set.nodes[2].healthy || set.nodes[2].syncing || set.nodes[2].headSlot != 7 {
+		t.Errorf("Unexpected status for legacy beacon node: %+v", set.nodes[2])
+	}
+
+	node, switched := set.selectNode()
+	if !switched || node.endpoint != "legacy" {
+		t.Errorf("Expected to switch to the legacy beacon node, received %s", node.endpoint)
+	}
+}
+
+func TestSelectBeaconNode_SwitchesClientsAndClearsAssignments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	primary := internal.NewMockValidatorServiceClient(ctrl)
+	backup := internal.NewMockValidatorServiceClient(ctrl)
+
+	set := &beaconNodeSet{nodes: []*beaconNode{
+		{endpoint: "primary", validatorClient: primary, healthy: false},
+		{endpoint: "backup", validatorClient: backup, healthy: true},
+	}}
+	v := validator{
+		validatorClient: primary,
+		beaconNodes:     set,
+		assignments:     &pb.CommitteeAssignmentResponse{},
+	}
+
+	v.SelectBeaconNode()
+	if v.validatorClient != backup {
+		t.Error("Expected the validator to use the backup beacon node")
+	}
+	if v.assignments != nil {
+		t.Error("Expected assignments to be cleared after switching beacon nodes")
+	}
+}
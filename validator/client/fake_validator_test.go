@@ -12,7 +12,9 @@ var _ = Validator(&fakeValidator{})
 
 type fakeValidator struct {
 	DoneCalled                       bool
+	SelectBeaconNodeCalled           bool
 	WaitForActivationCalled          bool
+	DetectDoppelgangerCalled         bool
 	WaitForChainStartCalled          bool
 	NextSlotRet                      <-chan uint64
 	NextSlotCalled                   bool
@@ -20,13 +22,15 @@ type fakeValidator struct {
 	UpdateAssignmentsCalled          bool
 	UpdateAssignmentsArg1            uint64
 	UpdateAssignmentsRet             error
-	RoleAtCalled                     bool
-	RoleAtArg1                       uint64
-	RoleAtRet                        pb.ValidatorRole
+	RolesAtCalled                    bool
+	RolesAtArg1                      uint64
+	RolesAtRet                       map[string]pb.ValidatorRole
 	AttestToBlockHeadCalled          bool
 	AttestToBlockHeadArg1            uint64
+	AttestToBlockHeadArg2            string
 	ProposeBlockCalled               bool
 	ProposeBlockArg1                 uint64
+	ProposeBlockArg2                 string
 	LogValidatorGainsAndLossesCalled bool
 	SlotDeadlineCalled               bool
 }
@@ -35,6 +39,10 @@ func (fv *fakeValidator) Done() {
 	fv.DoneCalled = true
 }
 
+func (fv *fakeValidator) SelectBeaconNode() {
+	fv.SelectBeaconNodeCalled = true
+}
+
 func (fv *fakeValidator) WaitForChainStart(_ context.Context) error {
 	fv.WaitForChainStartCalled = true
 	return nil
@@ -45,6 +53,11 @@ func (fv *fakeValidator) WaitForActivation(_ context.Context) error {
 	return nil
 }
 
+func (fv *fakeValidator) DetectDoppelganger(_ context.Context) error {
+	fv.DetectDoppelgangerCalled = true
+	return nil
+}
+
 func (fv *fakeValidator) CanonicalHeadSlot(_ context.Context) (uint64, error) {
 	fv.CanonicalHeadSlotCalled = true
 	return params.BeaconConfig().GenesisSlot, nil
@@ -71,18 +84,20 @@ func (fv *fakeValidator) LogValidatorGainsAndLosses(_ context.Context, slot uint
 	return nil
 }
 
-func (fv *fakeValidator) RoleAt(slot uint64) pb.ValidatorRole {
-	fv.RoleAtCalled = true
-	fv.RoleAtArg1 = slot
-	return fv.RoleAtRet
+func (fv *fakeValidator) RolesAt(slot uint64) map[string]pb.ValidatorRole {
+	fv.RolesAtCalled = true
+	fv.RolesAtArg1 = slot
+	return fv.RolesAtRet
 }
 
-func (fv *fakeValidator) AttestToBlockHead(_ context.Context, slot uint64) {
+func (fv *fakeValidator) AttestToBlockHead(_ context.Context, slot uint64, pubKey string) {
 	fv.AttestToBlockHeadCalled = true
 	fv.AttestToBlockHeadArg1 = slot
+	fv.AttestToBlockHeadArg2 = pubKey
 }
 
-func (fv *fakeValidator) ProposeBlock(_ context.Context, slot uint64) {
+func (fv *fakeValidator) ProposeBlock(_ context.Context, slot uint64, pubKey string) {
 	fv.ProposeBlockCalled = true
 	fv.ProposeBlockArg1 = slot
+	fv.ProposeBlockArg2 = pubKey
 }
@@ -0,0 +1,45 @@
+package client
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/prysmaticlabs/prysm/proto/signer/v1"
+)
+
+// RemoteSigner delegates signing to a remote signing service over gRPC. Only the
+// signing root, domain and public key leave the validator client; the private
+// keys stay with the signing service.
+type RemoteSigner struct {
+	client pb.RemoteSignerClient
+}
+
+// NewRemoteSigner creates a signer backed by a remote signing service.
+func NewRemoteSigner(client pb.RemoteSignerClient) *RemoteSigner {
+	return &RemoteSigner{client: client}
+}
+
+// PublicKeys returns the public keys of every validator the remote signer holds a key for.
+func (s *RemoteSigner) PublicKeys(ctx context.Context) ([][]byte, error) {
+	resp, err := s.client.ListPublicKeys(ctx, &pb.ListPublicKeysRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.PublicKeys, nil
+}
+
+// Sign requests a signature over the signing root from the remote signer.
+func (s *RemoteSigner) Sign(ctx context.Context, pubKey []byte, signingRoot []byte, domain uint64) ([]byte, error) {
+	resp, err := s.client.Sign(ctx, &pb.SignRequest{
+		PublicKey:   pubKey,
+		SigningRoot: signingRoot,
+		Domain:      domain,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Signature) == 0 {
+		return nil, errors.New("remote signer returned an empty signature")
+	}
+	return resp.Signature, nil
+}
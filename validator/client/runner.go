@@ -2,9 +2,13 @@ package client
 
 import (
 	"context"
+	"encoding/hex"
+	"fmt"
+	"sync"
 	"time"
 
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -15,16 +19,18 @@ import (
 // Validator interface defines the primary methods of a validator client.
 type Validator interface {
 	Done()
+	SelectBeaconNode()
 	WaitForChainStart(ctx context.Context) error
 	WaitForActivation(ctx context.Context) error
+	DetectDoppelganger(ctx context.Context) error
 	CanonicalHeadSlot(ctx context.Context) (uint64, error)
 	NextSlot() <-chan uint64
 	SlotDeadline(slot uint64) time.Time
 	LogValidatorGainsAndLosses(ctx context.Context, slot uint64) error
 	UpdateAssignments(ctx context.Context, slot uint64) error
-	RoleAt(slot uint64) pb.ValidatorRole
-	AttestToBlockHead(ctx context.Context, slot uint64)
-	ProposeBlock(ctx context.Context, slot uint64)
+	RolesAt(slot uint64) map[string]pb.ValidatorRole // validator pubKey -> role
+	AttestToBlockHead(ctx context.Context, slot uint64, pubKey string)
+	ProposeBlock(ctx context.Context, slot uint64, pubKey string)
 }
 
 // Run the main validator routine. This routine exits if the context is
@@ -33,10 +39,12 @@ type Validator interface {
 // Order of operations:
 // 1 - Initialize validator data
 // 2 - Wait for validator activation
-// 3 - Wait for the next slot start
-// 4 - Update assignments
-// 5 - Determine role at current slot
-// 6 - Perform assigned role, if any
+// 3 - Watch the network for another instance running the same keys
+// 4 - Wait for the next slot start
+// 5 - Select the healthiest beacon node
+// 6 - Update assignments
+// 7 - Determine role of each validator key at current slot
+// 8 - Perform assigned roles, if any, concurrently for every key
 func run(ctx context.Context, v Validator) {
 	defer v.Done()
 	if err := v.WaitForChainStart(ctx); err != nil {
@@ -45,6 +53,9 @@ func run(ctx context.Context, v Validator) {
 	if err := v.WaitForActivation(ctx); err != nil {
 		log.Fatalf("Could not wait for validator activation: %v", err)
 	}
+	if err := v.DetectDoppelganger(ctx); err != nil {
+		log.Fatalf("Refusing to perform validator duties: %v", err)
+	}
 	headSlot, err := v.CanonicalHeadSlot(ctx)
 	if err != nil {
 		log.Fatalf("Could not get current canonical head slot: %v", err)
@@ -63,6 +74,8 @@ func run(ctx context.Context, v Validator) {
 		case slot := <-v.NextSlot():
 			span.AddAttributes(trace.Int64Attribute("slot", int64(slot)))
 			slotCtx, _ := context.WithDeadline(ctx, v.SlotDeadline(slot))
+			// Switch beacon nodes, if needed, before any of this slot's duties start.
+			v.SelectBeaconNode()
 			// Report this validator client's rewards and penalties throughout its lifecycle.
 			if err := v.LogValidatorGainsAndLosses(slotCtx, slot); err != nil {
 				log.Errorf("Could not report validator's rewards/penalties for slot %d: %v",
@@ -75,22 +88,32 @@ func run(ctx context.Context, v Validator) {
 				handleAssignmentError(err, slot)
 				continue
 			}
-			role := v.RoleAt(slot)
 
-			switch role {
-			case pb.ValidatorRole_ATTESTER:
-				v.AttestToBlockHead(slotCtx, slot)
-			case pb.ValidatorRole_PROPOSER:
-				v.ProposeBlock(slotCtx, slot)
-				v.AttestToBlockHead(slotCtx, slot)
-			case pb.ValidatorRole_UNKNOWN:
-				log.WithFields(logrus.Fields{
-					"slot": slot - params.BeaconConfig().GenesisSlot,
-					"role": role,
-				}).Info("No active assignment, doing nothing")
-			default:
-				// Do nothing :)
+			var wg sync.WaitGroup
+			for pubKey, role := range v.RolesAt(slot) {
+				wg.Add(1)
+				go func(role pb.ValidatorRole, pubKey string) {
+					defer wg.Done()
+					switch role {
+					case pb.ValidatorRole_ATTESTER:
+						v.AttestToBlockHead(slotCtx, slot, pubKey)
+					case pb.ValidatorRole_PROPOSER:
+						v.ProposeBlock(slotCtx, slot, pubKey)
+						v.AttestToBlockHead(slotCtx, slot, pubKey)
+					case pb.ValidatorRole_UNKNOWN:
+						pk, _ := hex.DecodeString(pubKey)
+						log.WithFields(logrus.Fields{
+							"pubKey": fmt.Sprintf("%#x", bytesutil.Trunc(pk)),
+							"slot":   slot - params.BeaconConfig().GenesisSlot,
+							"role":   role,
+						}).Info("No active assignment, doing nothing")
+					default:
+						// Do nothing :)
+					}
+				}(role, pubKey)
 			}
+			// Wait for all of this slot's duties to finish before moving on.
+			wg.Wait()
 		}
 	}
 }
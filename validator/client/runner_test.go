@@ -40,6 +40,14 @@ func TestCancelledContext_WaitsForActivation(t *testing.T) {
 	}
 }
 
+func TestCancelledContext_DetectsDoppelganger(t *testing.T) {
+	v := &fakeValidator{}
+	run(cancelledContext(), v)
+	if !v.DetectDoppelgangerCalled {
+		t.Error("Expected DetectDoppelganger() to be called")
+	}
+}
+
 func TestUpdateAssignments_NextSlot(t *testing.T) {
 	v := &fakeValidator{}
 	ctx, cancel := context.WithCancel(context.Background())
@@ -63,6 +71,25 @@ func TestUpdateAssignments_NextSlot(t *testing.T) {
 	}
 }
 
+func TestSelectBeaconNode_NextSlot(t *testing.T) {
+	v := &fakeValidator{}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	ticker := make(chan uint64)
+	v.NextSlotRet = ticker
+	go func() {
+		ticker <- 55
+
+		cancel()
+	}()
+
+	run(ctx, v)
+
+	if !v.SelectBeaconNodeCalled {
+		t.Fatal("Expected SelectBeaconNode to be called")
+	}
+}
+
 func TestUpdateAssignments_HandlesError(t *testing.T) {
 	hook := logTest.NewGlobal()
 	v := &fakeValidator{}
@@ -83,7 +110,7 @@ func TestUpdateAssignments_HandlesError(t *testing.T) {
 	testutil.AssertLogsContain(t, hook, "Failed to update assignments")
 }
 
-func TestRoleAt_NextSlot(t *testing.T) {
+func TestRolesAt_NextSlot(t *testing.T) {
 	v := &fakeValidator{}
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -98,11 +125,11 @@ func TestRoleAt_NextSlot(t *testing.T) {
 
 	run(ctx, v)
 
-	if !v.RoleAtCalled {
-		t.Fatalf("Expected RoleAt(%d) to be called", slot)
+	if !v.RolesAtCalled {
+		t.Fatalf("Expected RolesAt(%d) to be called", slot)
 	}
-	if v.RoleAtArg1 != slot {
-		t.Errorf("RoleAt called with the wrong arg. Want=%d, got=%d", slot, v.RoleAtArg1)
+	if v.RolesAtArg1 != slot {
+		t.Errorf("RolesAt called with the wrong arg. Want=%d, got=%d", slot, v.RolesAtArg1)
 	}
 }
 
@@ -113,7 +140,7 @@ func TestAttests_NextSlot(t *testing.T) {
 	slot := uint64(55)
 	ticker := make(chan uint64)
 	v.NextSlotRet = ticker
-	v.RoleAtRet = pb.ValidatorRole_ATTESTER
+	v.RolesAtRet = map[string]pb.ValidatorRole{"abcd": pb.ValidatorRole_ATTESTER}
 	go func() {
 		ticker <- slot
 
@@ -128,6 +155,9 @@ func TestAttests_NextSlot(t *testing.T) {
 	if v.AttestToBlockHeadArg1 != slot {
 		t.Errorf("AttestToBlockHead was called with wrong arg. Want=%d, got=%d", slot, v.AttestToBlockHeadArg1)
 	}
+	if v.AttestToBlockHeadArg2 != "abcd" {
+		t.Errorf("AttestToBlockHead was called with wrong key. Want=%s, got=%s", "abcd", v.AttestToBlockHeadArg2)
+	}
 }
 
 func TestProposes_NextSlot(t *testing.T) {
@@ -137,7 +167,7 @@ func TestProposes_NextSlot(t *testing.T) {
 	slot := uint64(55)
 	ticker := make(chan uint64)
 	v.NextSlotRet = ticker
-	v.RoleAtRet = pb.ValidatorRole_PROPOSER
+	v.RolesAtRet = map[string]pb.ValidatorRole{"abcd": pb.ValidatorRole_PROPOSER}
 	go func() {
 		ticker <- slot
 
@@ -152,6 +182,9 @@ func TestProposes_NextSlot(t *testing.T) {
 	if v.ProposeBlockArg1 != slot {
 		t.Errorf("ProposeBlock was called with wrong arg. Want=%d, got=%d", slot, v.AttestToBlockHeadArg1)
 	}
+	if v.ProposeBlockArg2 != "abcd" {
+		t.Errorf("ProposeBlock was called with wrong key. Want=%s, got=%s", "abcd", v.ProposeBlockArg2)
+	}
 }
 
 func TestBothProposesAndAttests_NextSlot(t *testing.T) {
@@ -161,7 +194,7 @@ func TestBothProposesAndAttests_NextSlot(t *testing.T) {
 	slot := uint64(55)
 	ticker := make(chan uint64)
 	v.NextSlotRet = ticker
-	v.RoleAtRet = pb.ValidatorRole_PROPOSER
+	v.RolesAtRet = map[string]pb.ValidatorRole{"abcd": pb.ValidatorRole_PROPOSER}
 	go func() {
 		ticker <- slot
 
@@ -176,10 +209,16 @@ func TestBothProposesAndAttests_NextSlot(t *testing.T) {
 	if v.AttestToBlockHeadArg1 != slot {
 		t.Errorf("AttestToBlockHead was called with wrong arg. Want=%d, got=%d", slot, v.AttestToBlockHeadArg1)
 	}
+	if v.AttestToBlockHeadArg2 != "abcd" {
+		t.Errorf("AttestToBlockHead was called with wrong key. Want=%s, got=%s", "abcd", v.AttestToBlockHeadArg2)
+	}
 	if !v.ProposeBlockCalled {
 		t.Fatalf("ProposeBlock(%d) was not called", slot)
 	}
 	if v.ProposeBlockArg1 != slot {
 		t.Errorf("ProposeBlock was called with wrong arg. Want=%d, got=%d", slot, v.AttestToBlockHeadArg1)
 	}
+	if v.ProposeBlockArg2 != "abcd" {
+		t.Errorf("ProposeBlock was called with wrong key. Want=%s, got=%s", "abcd", v.ProposeBlockArg2)
+	}
 }
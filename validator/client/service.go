@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	signerpb "github.com/prysmaticlabs/prysm/proto/signer/v1"
 	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
@@ -19,28 +22,54 @@ var log = logrus.WithField("prefix", "validator")
 // ValidatorService represents a service to manage the validator client
 // routine.
 type ValidatorService struct {
-	ctx       context.Context
-	cancel    context.CancelFunc
-	validator Validator
-	conn      *grpc.ClientConn
-	endpoint  string
-	withCert  string
-	key       *keystore.Key
-	keys      map[string]*keystore.Key
+	ctx            context.Context
+	cancel         context.CancelFunc
+	validator      Validator
+	conns          []*grpc.ClientConn
+	signerConn     *grpc.ClientConn
+	endpoints      []string
+	withCert       string
+	signerEndpoint string
+	signerCert     string
+	signer         Signer
+	db             *db.ValidatorDB
+
+	doppelgangerEpochs uint64
 }
 
 // Config for the validator service.
 type Config struct {
-	Endpoint     string
-	CertFlag     string
-	KeystorePath string
-	Password     string
+	Endpoints            []string
+	CertFlag             string
+	KeystorePath         string
+	Password             string
+	RemoteSignerEndpoint string
+	RemoteSignerCertFlag string
+	ValidatorDB          *db.ValidatorDB
+	DoppelgangerEpochs   uint64
 }
 
 // NewValidatorService creates a new validator service for the service
-// registry.
+// registry. Every key found in the keystore is loaded and performs its duties
+// from this single service. If a remote signer endpoint is configured, the
+// keystore is not used; duties are performed for every key held by the remote
+// signer instead. Duties are sent to the healthiest of the configured beacon
+// node endpoints, in order of preference.
 func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, error) {
 	ctx, cancel := context.WithCancel(ctx)
+	if cfg.RemoteSignerEndpoint != "" {
+		return &ValidatorService{
+			ctx:            ctx,
+			cancel:         cancel,
+			endpoints:      cfg.Endpoints,
+			withCert:       cfg.CertFlag,
+			signerEndpoint: cfg.RemoteSignerEndpoint,
+			signerCert:     cfg.RemoteSignerCertFlag,
+			db:             cfg.ValidatorDB,
+
+			doppelgangerEpochs: cfg.DoppelgangerEpochs,
+		}, nil
+	}
 	validatorFolder := cfg.KeystorePath
 	validatorPrefix := params.BeaconConfig().ValidatorPrivkeyFileName
 	ks := keystore.NewKeystore(cfg.KeystorePath)
@@ -48,61 +77,121 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 	if err != nil {
 		return nil, fmt.Errorf("could not get private key: %v", err)
 	}
-	var key *keystore.Key
-	for _, v := range keys {
-		key = v
-		break
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no validator keys found in %s", validatorFolder)
 	}
 	return &ValidatorService{
-		ctx:      ctx,
-		cancel:   cancel,
-		endpoint: cfg.Endpoint,
-		withCert: cfg.CertFlag,
-		keys:     keys,
-		key:      key,
+		ctx:       ctx,
+		cancel:    cancel,
+		endpoints: cfg.Endpoints,
+		withCert:  cfg.CertFlag,
+		signer:    NewKeystoreSigner(keys),
+		db:        cfg.ValidatorDB,
+
+		doppelgangerEpochs: cfg.DoppelgangerEpochs,
 	}, nil
 }
 
 // Start the validator service. Launches the main go routine for the validator
 // client.
 func (v *ValidatorService) Start() {
-	log.WithField("publicKey", fmt.Sprintf("%#x", v.key.PublicKey.Marshal())).Info("Initializing new validator service")
-
-	var dialOpt grpc.DialOption
-	if v.withCert != "" {
-		creds, err := credentials.NewClientTLSFromFile(v.withCert, "")
+	nodes := make([]*beaconNode, 0, len(v.endpoints))
+	for _, endpoint := range v.endpoints {
+		conn, err := v.dial(endpoint, v.withCert)
 		if err != nil {
-			log.Errorf("Could not get valid credentials: %v", err)
+			log.Errorf("Could not dial endpoint: %s, %v", endpoint, err)
 			return
 		}
-		dialOpt = grpc.WithTransportCredentials(creds)
-	} else {
-		dialOpt = grpc.WithInsecure()
-		log.Warn("You are using an insecure gRPC connection! Please provide a certificate and key to use a secure connection.")
+		v.conns = append(v.conns, conn)
+		nodes = append(nodes, &beaconNode{
+			endpoint:        endpoint,
+			beaconClient:    pb.NewBeaconServiceClient(conn),
+			validatorClient: pb.NewValidatorServiceClient(conn),
+			attesterClient:  pb.NewAttesterServiceClient(conn),
+			proposerClient:  pb.NewProposerServiceClient(conn),
+		})
+	}
+	if len(nodes) == 0 {
+		log.Error("No beacon node endpoint configured")
+		return
+	}
+	log.WithField("endpoints", v.endpoints).Info("Successfully started gRPC connection")
+	if v.signerEndpoint != "" {
+		signerConn, err := v.dial(v.signerEndpoint, v.signerCert)
+		if err != nil {
+			log.Errorf("Could not dial remote signer endpoint: %s, %v", v.signerEndpoint, err)
+			return
+		}
+		log.WithField("endpoint", v.signerEndpoint).Info("Using remote signer")
+		v.signerConn = signerConn
+		v.signer = NewRemoteSigner(signerpb.NewRemoteSignerClient(signerConn))
 	}
-	conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpt, grpc.WithStatsHandler(&ocgrpc.ClientHandler{}))
+	pubkeys, err := v.signer.PublicKeys(v.ctx)
 	if err != nil {
-		log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
+		log.Errorf("Could not get validator public keys from signer: %v", err)
+		return
+	}
+	if len(pubkeys) == 0 {
+		log.Error("Signer holds no validator keys")
 		return
 	}
-	log.Info("Successfully started gRPC connection")
-	v.conn = conn
-	v.validator = &validator{
-		beaconClient:    pb.NewBeaconServiceClient(v.conn),
-		validatorClient: pb.NewValidatorServiceClient(v.conn),
-		attesterClient:  pb.NewAttesterServiceClient(v.conn),
-		proposerClient:  pb.NewProposerServiceClient(v.conn),
-		key:             v.key,
+	log.WithField("numValidators", len(pubkeys)).Info("Initializing new validator service")
+	for _, pubkey := range pubkeys {
+		log.WithField("publicKey", fmt.Sprintf("%#x", pubkey)).Info("Loaded validator key")
+	}
+	beaconNodes := newBeaconNodeSet(nodes)
+	if len(nodes) > 1 {
+		// Pick the healthiest beacon node before the first duty, then keep
+		// checking all of them in the background.
+		beaconNodes.checkHealth(v.ctx)
+		interval := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second / 2
+		go beaconNodes.run(v.ctx, interval)
+	}
+	node, _ := beaconNodes.selectNode()
+	val := &validator{
+		signer:      v.signer,
+		pubkeys:     pubkeys,
+		prevBalance: make(map[string]uint64),
+		db:          v.db,
+		beaconNodes: beaconNodes,
+
+		doppelgangerEpochs: v.doppelgangerEpochs,
 	}
+	val.useBeaconNode(node)
+	v.validator = val
 	go run(v.ctx, v.validator)
 }
 
+// dial opens a gRPC connection to the given endpoint, secured with the
+// certificate if one is provided.
+func (v *ValidatorService) dial(endpoint string, cert string) (*grpc.ClientConn, error) {
+	var dialOpt grpc.DialOption
+	if cert != "" {
+		creds, err := credentials.NewClientTLSFromFile(cert, "")
+		if err != nil {
+			return nil, fmt.Errorf("could not get valid credentials: %v", err)
+		}
+		dialOpt = grpc.WithTransportCredentials(creds)
+	} else {
+		dialOpt = grpc.WithInsecure()
+		log.WithField("endpoint", endpoint).Warn("You are using an insecure gRPC connection! Please provide a certificate and key to use a secure connection.")
+	}
+	return grpc.DialContext(v.ctx, endpoint, dialOpt, grpc.WithStatsHandler(&ocgrpc.ClientHandler{}))
+}
+
 // Stop the validator service.
 func (v *ValidatorService) Stop() error {
 	v.cancel()
 	log.Info("Stopping service")
-	if v.conn != nil {
-		return v.conn.Close()
+	if v.signerConn != nil {
+		if err := v.signerConn.Close(); err != nil {
+			log.Errorf("Could not close remote signer connection: %v", err)
+		}
+	}
+	for _, conn := range v.conns {
+		if err := conn.Close(); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -111,7 +200,7 @@ func (v *ValidatorService) Stop() error {
 //
 // WIP - not done.
 func (v *ValidatorService) Status() error {
-	if v.conn == nil {
+	if len(v.conns) == 0 {
 		return errors.New("no connection to beacon RPC")
 	}
 	return nil
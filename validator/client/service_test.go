@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"crypto/rand"
+	"encoding/hex"
 	"os"
 	"strings"
 	"testing"
@@ -17,12 +18,16 @@ import (
 
 var _ = shared.Service(&ValidatorService{})
 var validatorKey *keystore.Key
+var validatorPubKey string
+var keyMap map[string]*keystore.Key
 
 func TestMain(m *testing.M) {
 	dir := testutil.TempDir() + "/keystore1"
 	defer os.RemoveAll(dir)
 	accounts.NewValidatorAccount(dir, "1234")
 	validatorKey, _ = keystore.NewKey(rand.Reader)
+	validatorPubKey = hex.EncodeToString(validatorKey.PublicKey.Marshal())
+	keyMap = map[string]*keystore.Key{validatorPubKey: validatorKey}
 	os.Exit(m.Run())
 }
 
@@ -50,11 +55,11 @@ func TestLifecycle(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel()
 	validatorService := &ValidatorService{
-		ctx:      ctx,
-		cancel:   cancel,
-		endpoint: "merkle tries",
-		withCert: "alice.crt",
-		key:      validatorKey,
+		ctx:       ctx,
+		cancel:    cancel,
+		endpoints: []string{"merkle tries"},
+		withCert:  "alice.crt",
+		signer:    NewKeystoreSigner(keyMap),
 	}
 	validatorService.Start()
 	if err := validatorService.Stop(); err != nil {
@@ -69,10 +74,10 @@ func TestLifecycle_Insecure(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel()
 	validatorService := &ValidatorService{
-		ctx:      ctx,
-		cancel:   cancel,
-		endpoint: "merkle tries",
-		key:      validatorKey,
+		ctx:       ctx,
+		cancel:    cancel,
+		endpoints: []string{"merkle tries"},
+		signer:    NewKeystoreSigner(keyMap),
 	}
 	validatorService.Start()
 	testutil.AssertLogsContain(t, hook, "You are using an insecure gRPC connection")
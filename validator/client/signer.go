@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+)
+
+// Signer manages the private keys of the validators run by this client and signs
+// on their behalf. The validator client itself only ever deals with public keys,
+// so the private keys may be held in-process or by a separate signing service.
+type Signer interface {
+	// PublicKeys returns the public keys of every validator the signer holds a key for.
+	PublicKeys(ctx context.Context) ([][]byte, error)
+	// Sign signs the signing root under the given domain with the private key
+	// belonging to pubKey, returning the serialized BLS signature.
+	Sign(ctx context.Context, pubKey []byte, signingRoot []byte, domain uint64) ([]byte, error)
+}
+
+// KeystoreSigner signs in-process with validator keys decrypted from a local keystore.
+type KeystoreSigner struct {
+	keys map[string]*keystore.Key
+}
+
+// NewKeystoreSigner creates a signer from keys indexed by their hex encoded public key,
+// as returned by the keystore.
+func NewKeystoreSigner(keys map[string]*keystore.Key) *KeystoreSigner {
+	return &KeystoreSigner{keys: keys}
+}
+
+// PublicKeys returns the public keys held in the keystore, sorted so duties and
+// logs are processed in a stable order.
+func (s *KeystoreSigner) PublicKeys(ctx context.Context) ([][]byte, error) {
+	ids := make([]string, 0, len(s.keys))
+	for id := range s.keys {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	pubKeys := make([][]byte, 0, len(ids))
+	for _, id := range ids {
+		pubKeys = append(pubKeys, s.keys[id].PublicKey.Marshal())
+	}
+	return pubKeys, nil
+}
+
+// Sign the signing root with the keystore key of the given validator.
+func (s *KeystoreSigner) Sign(ctx context.Context, pubKey []byte, signingRoot []byte, domain uint64) ([]byte, error) {
+	key, ok := s.keys[hex.EncodeToString(pubKey)]
+	if !ok {
+		return nil, fmt.Errorf("no key in keystore for public key %#x", pubKey)
+	}
+	return key.SecretKey.Sign(signingRoot, domain).Marshal(), nil
+}
@@ -0,0 +1,126 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	pb "github.com/prysmaticlabs/prysm/proto/signer/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/validator/internal"
+)
+
+var _ = Signer(&KeystoreSigner{})
+var _ = Signer(&RemoteSigner{})
+
+func TestKeystoreSigner_Sign(t *testing.T) {
+	signer := NewKeystoreSigner(keyMap)
+	root := []byte("signing root")
+	sig, err := signer.Sign(context.Background(), validatorKey.PublicKey.Marshal(), root, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := validatorKey.SecretKey.Sign(root, 5).Marshal()
+	if !bytes.Equal(sig, expected) {
+		t.Errorf("Expected signature %#x, received %#x", expected, sig)
+	}
+}
+
+func TestKeystoreSigner_UnknownKey(t *testing.T) {
+	signer := NewKeystoreSigner(keyMap)
+	other, err := keystore.NewKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := signer.Sign(context.Background(), other.PublicKey.Marshal(), []byte("root"), 0); err == nil {
+		t.Error("Expected signing with an unknown key to fail")
+	}
+}
+
+func TestKeystoreSigner_PublicKeys(t *testing.T) {
+	signer := NewKeystoreSigner(keyMap)
+	pubKeys, err := signer.PublicKeys(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pubKeys) != 1 || !bytes.Equal(pubKeys[0], validatorKey.PublicKey.Marshal()) {
+		t.Errorf("Expected the keystore public key, received %#x", pubKeys)
+	}
+}
+
+func TestRemoteSigner_Sign(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockRemoteSignerClient(ctrl)
+	root := []byte("signing root")
+	pubKey := validatorKey.PublicKey.Marshal()
+	client.EXPECT().Sign(
+		gomock.Any(), // ctx
+		&pb.SignRequest{PublicKey: pubKey, SigningRoot: root, Domain: 3},
+	).Return(&pb.SignResponse{Signature: validatorKey.SecretKey.Sign(root, 3).Marshal()}, nil)
+
+	sigBytes, err := NewRemoteSigner(client).Sign(context.Background(), pubKey, root, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := bls.SignatureFromBytes(sigBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sig.Verify(root, validatorKey.PublicKey, 3) {
+		t.Error("Expected remote signature to verify")
+	}
+}
+
+func TestRemoteSigner_SignFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockRemoteSignerClient(ctrl)
+	client.EXPECT().Sign(
+		gomock.Any(), // ctx
+		gomock.Any(), // req
+	).Return(nil, errors.New("signer unavailable"))
+
+	_, err := NewRemoteSigner(client).Sign(context.Background(), []byte("pubkey"), []byte("root"), 0)
+	if err == nil || !strings.Contains(err.Error(), "signer unavailable") {
+		t.Errorf("Expected remote signer error, received %v", err)
+	}
+}
+
+func TestRemoteSigner_EmptySignature(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockRemoteSignerClient(ctrl)
+	client.EXPECT().Sign(
+		gomock.Any(), // ctx
+		gomock.Any(), // req
+	).Return(&pb.SignResponse{}, nil)
+
+	if _, err := NewRemoteSigner(client).Sign(context.Background(), []byte("pubkey"), []byte("root"), 0); err == nil {
+		t.Error("Expected empty signature to be rejected")
+	}
+}
+
+func TestRemoteSigner_PublicKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockRemoteSignerClient(ctrl)
+	pubKey := validatorKey.PublicKey.Marshal()
+	client.EXPECT().ListPublicKeys(
+		gomock.Any(), // ctx
+		gomock.Any(), // req
+	).Return(&pb.ListPublicKeysResponse{PublicKeys: [][]byte{pubKey}}, nil)
+
+	pubKeys, err := NewRemoteSigner(client).PublicKeys(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pubKeys) != 1 || !bytes.Equal(pubKeys[0], pubKey) {
+		t.Errorf("Expected remote public keys, received %#x", pubKeys)
+	}
+}
@@ -2,7 +2,9 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"time"
@@ -10,9 +12,10 @@ import (
 	ptypes "github.com/gogo/protobuf/types"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
-	"github.com/prysmaticlabs/prysm/shared/keystore"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -20,13 +23,18 @@ import (
 type validator struct {
 	genesisTime     uint64
 	ticker          *slotutil.SlotTicker
-	assignment      *pb.CommitteeAssignmentResponse
+	assignments     *pb.CommitteeAssignmentResponse
 	proposerClient  pb.ProposerServiceClient
 	validatorClient pb.ValidatorServiceClient
 	beaconClient    pb.BeaconServiceClient
 	attesterClient  pb.AttesterServiceClient
-	key             *keystore.Key
-	prevBalance     uint64
+	signer          Signer
+	beaconNodes     *beaconNodeSet
+	pubkeys         [][]byte
+	prevBalance     map[string]uint64
+	db              *db.ValidatorDB
+
+	doppelgangerEpochs uint64
 }
 
 // Done cleans up the validator.
@@ -34,6 +42,33 @@ func (v *validator) Done() {
 	v.ticker.Done()
 }
 
+// SelectBeaconNode routes the duties of the coming slot to the healthiest beacon
+// node. It is called between slots, once every duty of the previous slot has
+// completed, so a single duty is never split across beacon nodes. The signing
+// history is kept by the validator client itself, so switching beacon nodes
+// mid-epoch cannot lead to signing slashable messages.
+func (v *validator) SelectBeaconNode() {
+	if v.beaconNodes == nil {
+		return
+	}
+	node, switched := v.beaconNodes.selectNode()
+	if !switched {
+		return
+	}
+	v.useBeaconNode(node)
+	// Assignments were computed by the previous beacon node, so they are
+	// fetched again from the new one.
+	v.assignments = nil
+}
+
+// useBeaconNode sends all further requests to the given beacon node.
+func (v *validator) useBeaconNode(node *beaconNode) {
+	v.beaconClient = node.beaconClient
+	v.validatorClient = node.validatorClient
+	v.attesterClient = node.attesterClient
+	v.proposerClient = node.proposerClient
+}
+
 // WaitForChainStart checks whether the beacon node has started its runtime. That is,
 // it calls to the beacon node which then verifies the ETH1.0 deposit contract logs to check
 // for the ChainStart log to have been emitted. If so, it starts a ticker based on the ChainStart
@@ -70,14 +105,25 @@ func (v *validator) WaitForChainStart(ctx context.Context) error {
 	return nil
 }
 
-// WaitForActivation checks whether the validator pubkey is in the active
+// WaitForActivation checks whether each of the validator pubkeys is in the active
 // validator set. If not, this operation will block until an activation message is
-// received.
+// received for every key.
 func (v *validator) WaitForActivation(ctx context.Context) error {
 	ctx, span := trace.StartSpan(ctx, "validator.WaitForActivation")
 	defer span.End()
+	for _, pubKey := range v.pubkeys {
+		if err := v.waitForKeyActivation(ctx, pubKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// waitForKeyActivation blocks until the beacon node reports the validator with the
+// given public key as activated.
+func (v *validator) waitForKeyActivation(ctx context.Context, pubKey []byte) error {
 	req := &pb.ValidatorActivationRequest{
-		Pubkey: v.key.PublicKey.Marshal(),
+		Pubkey: pubKey,
 	}
 	stream, err := v.validatorClient.WaitForActivation(ctx, req)
 	if err != nil {
@@ -85,7 +131,9 @@ func (v *validator) WaitForActivation(ctx context.Context) error {
 	}
 	var validatorActivatedRecord *pbp2p.Validator
 	for {
-		log.Info("Waiting for validator to be activated in the beacon chain")
+		log.WithField(
+			"pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey)),
+		).Info("Waiting for validator to be activated in the beacon chain")
 		res, err := stream.Recv()
 		// If the stream is closed, we stop the loop.
 		if err == io.EOF {
@@ -102,6 +150,7 @@ func (v *validator) WaitForActivation(ctx context.Context) error {
 		break
 	}
 	log.WithFields(logrus.Fields{
+		"pubKey":          fmt.Sprintf("%#x", bytesutil.Trunc(pubKey)),
 		"activationEpoch": validatorActivatedRecord.ActivationEpoch - params.BeaconConfig().GenesisEpoch,
 	}).Info("Validator activated")
 	return nil
@@ -132,11 +181,12 @@ func (v *validator) SlotDeadline(slot uint64) time.Time {
 
 // UpdateAssignments checks the slot number to determine if the validator's
 // list of upcoming assignments needs to be updated. For example, at the
-// beginning of a new epoch.
+// beginning of a new epoch. A single request covers every public key
+// managed by this validator client.
 func (v *validator) UpdateAssignments(ctx context.Context, slot uint64) error {
 	// Testing run time for fetching every slot. This is not meant for production!
 	// https://github.com/prysmaticlabs/prysm/issues/2167
-	if slot%params.BeaconConfig().SlotsPerEpoch != 0 && v.assignment != nil && false {
+	if slot%params.BeaconConfig().SlotsPerEpoch != 0 && v.assignments != nil && false {
 		// Do nothing if not epoch start AND assignments already exist.
 		return nil
 	}
@@ -146,43 +196,69 @@ func (v *validator) UpdateAssignments(ctx context.Context, slot uint64) error {
 
 	req := &pb.CommitteeAssignmentsRequest{
 		EpochStart: slot,
-		PublicKeys: [][]byte{v.key.PublicKey.Marshal()},
+		PublicKeys: v.pubkeys,
 	}
 
 	resp, err := v.validatorClient.CommitteeAssignment(ctx, req)
 	if err != nil {
-		v.assignment = nil // Clear assignments so we know to retry the request.
+		v.assignments = nil // Clear assignments so we know to retry the request.
 		return err
 	}
 
-	v.assignment = resp
+	v.assignments = resp
 
-	lFields := logrus.Fields{
-		"attesterSlot": resp.Assignment[0].Slot - params.BeaconConfig().GenesisSlot,
-		"proposerSlot": "Not proposing",
-		"shard":        resp.Assignment[0].Shard,
-	}
-	if v.assignment.Assignment[0].IsProposer {
-		lFields["proposerSlot"] = resp.Assignment[0].Slot - params.BeaconConfig().GenesisSlot
+	for _, assignment := range resp.Assignment {
+		lFields := logrus.Fields{
+			"pubKey":       fmt.Sprintf("%#x", bytesutil.Trunc(assignment.PublicKey)),
+			"attesterSlot": assignment.Slot - params.BeaconConfig().GenesisSlot,
+			"proposerSlot": "Not proposing",
+			"shard":        assignment.Shard,
+		}
+		if assignment.IsProposer {
+			lFields["proposerSlot"] = assignment.Slot - params.BeaconConfig().GenesisSlot
+		}
+		log.WithFields(lFields).Info("Updated validator assignments")
 	}
-
-	log.WithFields(lFields).Info("Updated validator assignments")
 	return nil
 }
 
-// RoleAt slot returns the validator role at the given slot. Returns nil if the
-// validator is known to not have a role at the at slot. Returns UNKNOWN if the
-// validator assignments are unknown. Otherwise returns a valid ValidatorRole.
-func (v *validator) RoleAt(slot uint64) pb.ValidatorRole {
-	if v.assignment == nil {
-		return pb.ValidatorRole_UNKNOWN
+// RolesAt slot returns the validator roles at the given slot, keyed by the hex
+// encoded public key of each validator. A validator is UNKNOWN if its assignments
+// are unknown or if it has no role at the given slot. Otherwise the role is a valid
+// ValidatorRole.
+func (v *validator) RolesAt(slot uint64) map[string]pb.ValidatorRole {
+	rolesAt := make(map[string]pb.ValidatorRole)
+	for _, pubKey := range v.pubkeys {
+		rolesAt[hex.EncodeToString(pubKey)] = pb.ValidatorRole_UNKNOWN
 	}
-	if v.assignment.Assignment[0].Slot == slot {
-		if v.assignment.Assignment[0].IsProposer {
+	if v.assignments == nil {
+		return rolesAt
+	}
+	for _, assignment := range v.assignments.Assignment {
+		if assignment.Slot != slot {
+			continue
+		}
+		id := hex.EncodeToString(assignment.PublicKey)
+		if assignment.IsProposer {
 			// Note: A proposer also attests to the slot.
-			return pb.ValidatorRole_PROPOSER
+			rolesAt[id] = pb.ValidatorRole_PROPOSER
+		} else {
+			rolesAt[id] = pb.ValidatorRole_ATTESTER
+		}
+	}
+	return rolesAt
+}
+
+// assignment returns the committee assignment of the validator with the given
+// public key, or nil if no assignment is known for it.
+func (v *validator) assignment(pubKey []byte) *pb.CommitteeAssignmentResponse_CommitteeAssignment {
+	if v.assignments == nil {
+		return nil
+	}
+	for _, assignment := range v.assignments.Assignment {
+		if bytes.Equal(assignment.PublicKey, pubKey) {
+			return assignment
 		}
-		return pb.ValidatorRole_ATTESTER
 	}
-	return pb.ValidatorRole_UNKNOWN
+	return nil
 }
@@ -2,12 +2,17 @@ package client
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"time"
 
+	ptypes "github.com/gogo/protobuf/types"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bitutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/mathutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
@@ -19,13 +24,25 @@ var delay = params.BeaconConfig().SecondsPerSlot / 2
 // AttestToBlockHead completes the validator client's attester responsibility at a given slot.
 // It fetches the latest beacon block head along with the latest canonical beacon state
 // information in order to sign the block and include information about the validator's
-// participation in voting on the block.
-func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64) {
+// participation in voting on the block. The attestation is produced on behalf of the
+// validator identified by the hex encoded public key pubKey.
+func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64, pubKey string) {
 	ctx, span := trace.StartSpan(ctx, "validator.AttestToBlockHead")
 	defer span.End()
+	key, err := hex.DecodeString(pubKey)
+	if err != nil {
+		log.Errorf("Invalid validator public key %s: %v", pubKey, err)
+		return
+	}
+	tpk := fmt.Sprintf("%#x", bytesutil.Trunc(key))
 	span.AddAttributes(
-		trace.StringAttribute("validator", fmt.Sprintf("%#x", v.key.PublicKey.Marshal())),
+		trace.StringAttribute("validator", fmt.Sprintf("%#x", key)),
 	)
+	assignment := v.assignment(key)
+	if assignment == nil {
+		log.WithField("pubKey", tpk).Error("No assignment found for validator, not attesting")
+		return
+	}
 
 	v.waitToSlotMidpoint(ctx, slot)
 
@@ -36,33 +53,32 @@ func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64) {
 	}
 	// We fetch the validator index as it is necessary to generate the aggregation
 	// bitfield of the attestation itself.
-	pubKey := v.key.PublicKey.Marshal()
 	idxReq := &pb.ValidatorIndexRequest{
-		PublicKey: pubKey,
+		PublicKey: key,
 	}
 	validatorIndexRes, err := v.validatorClient.ValidatorIndex(ctx, idxReq)
 	if err != nil {
-		log.Errorf("Could not fetch validator index: %v", err)
+		log.WithField("pubKey", tpk).Errorf("Could not fetch validator index: %v", err)
 		return
 	}
 	// Set the attestation data's shard as the shard associated with the validator's
 	// committee as retrieved by CrosslinkCommitteesAtSlot.
-	attData.Shard = v.assignment.Assignment[0].Shard
+	attData.Shard = assignment.Shard
 
 	// Fetch other necessary information from the beacon node in order to attest
 	// including the justified epoch, epoch boundary information, and more.
 	infoReq := &pb.AttestationDataRequest{
 		Slot:  slot,
-		Shard: v.assignment.Assignment[0].Shard,
+		Shard: assignment.Shard,
 	}
 	infoRes, err := v.attesterClient.AttestationDataAtSlot(ctx, infoReq)
 	if err != nil {
-		log.Errorf("Could not fetch necessary info to produce attestation at slot %d: %v",
+		log.WithField("pubKey", tpk).Errorf("Could not fetch necessary info to produce attestation at slot %d: %v",
 			slot-params.BeaconConfig().GenesisSlot, err)
 		return
 	}
 
-	committeeLength := mathutil.CeilDiv8(len(v.assignment.Assignment[0].Committee))
+	committeeLength := mathutil.CeilDiv8(len(assignment.Committee))
 
 	// Set the attestation data's slot to head_state.slot where the slot
 	// is the canonical head of the beacon chain.
@@ -85,6 +101,21 @@ func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64) {
 	// On the server side, this is fetched by calling get_block_root(state, justified_epoch).
 	attData.JustifiedBlockRootHash32 = infoRes.JustifiedBlockRootHash32
 
+	// Retrieve the current fork data from the beacon node in order to compute
+	// the signature domain of the attestation.
+	fork, err := v.beaconClient.ForkData(ctx, &ptypes.Empty{})
+	if err != nil {
+		log.WithField("pubKey", tpk).Errorf("Failed to get fork data from beacon node's state: %v", err)
+		return
+	}
+
+	// Before signing, the attestation data is checked against the key's signing
+	// history so the validator never produces a double vote or surround vote.
+	if err := v.db.CheckAndSaveAttestation(key, attData); err != nil {
+		log.WithField("pubKey", tpk).Errorf("Not attesting! Attestation would be slashable: %v", err)
+		return
+	}
+
 	// The validator now creates an Attestation object using the AttestationData as
 	// set in the code above after all properties have been set.
 	attestation := &pbp2p.Attestation{
@@ -98,7 +129,7 @@ func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64) {
 	// Find the index in committee to be used for
 	// the aggregation bitfield
 	var indexInCommittee int
-	for i, vIndex := range v.assignment.Assignment[0].Committee {
+	for i, vIndex := range assignment.Committee {
 		if vIndex == validatorIndexRes.Index {
 			indexInCommittee = i
 			break
@@ -108,25 +139,45 @@ func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64) {
 	aggregationBitfield := bitutil.SetBitfield(indexInCommittee, committeeLength)
 	attestation.AggregationBitfield = aggregationBitfield
 
-	// TODO(#1366): Use BLS to generate an aggregate signature.
-	attestation.AggregateSignature = []byte("signed")
+	// The validator signs the attestation data together with its custody bit,
+	// which is always 0b0 in phase 0.
+	// attestation.aggregate_signature = bls_sign(
+	//   privkey=validator.privkey,
+	//   message_hash=hash_tree_root(AttestationDataAndCustodyBit(data=attestation.data, custody_bit=0b0)),
+	//   domain=get_domain(fork, slot_to_epoch(attestation.data.slot), DOMAIN_ATTESTATION),
+	// )
+	message, err := hashutil.HashAttestationDataAndCustodyBit(attData, false /* custody bit */)
+	if err != nil {
+		log.WithField("pubKey", tpk).Errorf("Could not hash attestation data: %v", err)
+		return
+	}
+	epoch := attData.Slot / params.BeaconConfig().SlotsPerEpoch
+	domain := forkutil.DomainVersion(fork, epoch, params.BeaconConfig().DomainAttestation)
+	attestation.AggregateSignature, err = v.signer.Sign(ctx, key, message[:], domain)
+	if err != nil {
+		log.WithField("pubKey", tpk).Errorf("Could not sign attestation: %v", err)
+		return
+	}
 
-	log.WithField(
-		"blockRoot", fmt.Sprintf("%#x", attData.BeaconBlockRootHash32),
-	).Info("Current beacon chain head block")
 	log.WithFields(logrus.Fields{
+		"pubKey":    tpk,
+		"blockRoot": fmt.Sprintf("%#x", attData.BeaconBlockRootHash32),
+	}).Info("Current beacon chain head block")
+	log.WithFields(logrus.Fields{
+		"pubKey":         tpk,
 		"justifiedEpoch": attData.JustifiedEpoch - params.BeaconConfig().GenesisEpoch,
 		"shard":          attData.Shard,
 		"slot":           slot - params.BeaconConfig().GenesisSlot,
 	}).Info("Attesting to beacon chain head...")
 
-	log.Infof("Produced attestation with block root: %#x", attestation.Data.BeaconBlockRootHash32)
+	log.WithField("pubKey", tpk).Infof("Produced attestation with block root: %#x", attestation.Data.BeaconBlockRootHash32)
 	attResp, err := v.attesterClient.AttestHead(ctx, attestation)
 	if err != nil {
-		log.Errorf("Could not submit attestation to beacon node: %v", err)
+		log.WithField("pubKey", tpk).Errorf("Could not submit attestation to beacon node: %v", err)
 		return
 	}
 	log.WithFields(logrus.Fields{
+		"pubKey":          tpk,
 		"attestationHash": fmt.Sprintf("%#x", attResp.AttestationHash),
 		"shard":           attData.Shard,
 		"slot":            slot - params.BeaconConfig().GenesisSlot,
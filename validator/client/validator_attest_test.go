@@ -8,10 +8,13 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	ptypes "github.com/gogo/protobuf/types"
 	"github.com/golang/mock/gomock"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bitutil"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/mathutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -23,23 +26,50 @@ func TestAttestToBlockHead_ValidatorIndexRequestFailure(t *testing.T) {
 
 	validator, m, finish := setup(t)
 	defer finish()
+	validator.assignments = &pb.CommitteeAssignmentResponse{Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
+		{
+			PublicKey: validatorKey.PublicKey.Marshal(),
+			Shard:     5,
+		},
+	}}
 	m.validatorClient.EXPECT().ValidatorIndex(
 		gomock.Any(), // ctx
 		gomock.AssignableToTypeOf(&pb.ValidatorIndexRequest{}),
 	).Return(nil /* Validator Index Response*/, errors.New("something bad happened"))
 
-	validator.AttestToBlockHead(context.Background(), 30)
+	validator.AttestToBlockHead(context.Background(), 30, validatorPubKey)
 	testutil.AssertLogsContain(t, hook, "Could not fetch validator index")
 }
 
+func TestAttestToBlockHead_NoAssignmentForKey(t *testing.T) {
+	hook := logTest.NewGlobal()
+
+	validator, m, finish := setup(t)
+	defer finish()
+	validator.assignments = &pb.CommitteeAssignmentResponse{Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
+		{
+			PublicKey: []byte("some other validator"),
+			Shard:     5,
+		},
+	}}
+	m.validatorClient.EXPECT().ValidatorIndex(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pb.ValidatorIndexRequest{}),
+	).Times(0)
+
+	validator.AttestToBlockHead(context.Background(), 30, validatorPubKey)
+	testutil.AssertLogsContain(t, hook, "No assignment found for validator")
+}
+
 func TestAttestToBlockHead_AttestationDataAtSlotFailure(t *testing.T) {
 	hook := logTest.NewGlobal()
 
 	validator, m, finish := setup(t)
 	defer finish()
-	validator.assignment = &pb.CommitteeAssignmentResponse{Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
+	validator.assignments = &pb.CommitteeAssignmentResponse{Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
 		{
-			Shard: 5,
+			PublicKey: validatorKey.PublicKey.Marshal(),
+			Shard:     5,
 		},
 	}}
 	m.validatorClient.EXPECT().ValidatorIndex(
@@ -51,17 +81,58 @@ func TestAttestToBlockHead_AttestationDataAtSlotFailure(t *testing.T) {
 		gomock.AssignableToTypeOf(&pb.AttestationDataRequest{}),
 	).Return(nil, errors.New("something went wrong"))
 
-	validator.AttestToBlockHead(context.Background(), 30)
+	validator.AttestToBlockHead(context.Background(), 30, validatorPubKey)
 	testutil.AssertLogsContain(t, hook, "Could not fetch necessary info to produce attestation")
 }
 
+func TestAttestToBlockHead_ForkDataFailure(t *testing.T) {
+	hook := logTest.NewGlobal()
+
+	validator, m, finish := setup(t)
+	defer finish()
+	validator.assignments = &pb.CommitteeAssignmentResponse{Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
+		{
+			PublicKey: validatorKey.PublicKey.Marshal(),
+			Shard:     5,
+			Committee: make([]uint64, 111),
+		}}}
+	m.validatorClient.EXPECT().ValidatorIndex(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pb.ValidatorIndexRequest{}),
+	).Return(&pb.ValidatorIndexResponse{
+		Index: 0,
+	}, nil)
+	m.attesterClient.EXPECT().AttestationDataAtSlot(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pb.AttestationDataRequest{}),
+	).Return(&pb.AttestationDataResponse{
+		BeaconBlockRootHash32:    []byte{},
+		EpochBoundaryRootHash32:  []byte{},
+		JustifiedBlockRootHash32: []byte{},
+		LatestCrosslink:          &pbp2p.Crosslink{},
+		JustifiedEpoch:           0,
+	}, nil)
+	m.beaconClient.EXPECT().ForkData(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(nil /*fork*/, errors.New("something went wrong"))
+	m.attesterClient.EXPECT().AttestHead(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pbp2p.Attestation{}),
+	).Times(0)
+
+	validator.AttestToBlockHead(context.Background(), 30, validatorPubKey)
+	testutil.AssertLogsContain(t, hook, "Failed to get fork data from beacon node's state")
+}
+
 func TestAttestToBlockHead_AttestHeadRequestFailure(t *testing.T) {
 	hook := logTest.NewGlobal()
 
 	validator, m, finish := setup(t)
 	defer finish()
-	validator.assignment = &pb.CommitteeAssignmentResponse{Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
+	validator.assignments = &pb.CommitteeAssignmentResponse{Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
 		{
+			PublicKey: validatorKey.PublicKey.Marshal(),
 			Shard:     5,
 			Committee: make([]uint64, 111),
 		}}}
@@ -81,15 +152,76 @@ func TestAttestToBlockHead_AttestHeadRequestFailure(t *testing.T) {
 		LatestCrosslink:          &pbp2p.Crosslink{},
 		JustifiedEpoch:           0,
 	}, nil)
+	m.beaconClient.EXPECT().ForkData(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.Fork{
+		Epoch:           params.BeaconConfig().GenesisEpoch,
+		CurrentVersion:  0,
+		PreviousVersion: 0,
+	}, nil /*err*/)
 	m.attesterClient.EXPECT().AttestHead(
 		gomock.Any(), // ctx
 		gomock.AssignableToTypeOf(&pbp2p.Attestation{}),
 	).Return(nil, errors.New("something went wrong"))
 
-	validator.AttestToBlockHead(context.Background(), 30)
+	validator.AttestToBlockHead(context.Background(), 30, validatorPubKey)
 	testutil.AssertLogsContain(t, hook, "Could not submit attestation to beacon node")
 }
 
+func TestAttestToBlockHead_RefusesSlashableAttestation(t *testing.T) {
+	hook := logTest.NewGlobal()
+
+	validator, m, finish := setup(t)
+	defer finish()
+	validator.assignments = &pb.CommitteeAssignmentResponse{Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
+		{
+			PublicKey: validatorKey.PublicKey.Marshal(),
+			Shard:     5,
+			Committee: make([]uint64, 111),
+		}}}
+	// A vote from epoch 1 to epoch 2 was signed previously, the new vote from
+	// epoch 0 to epoch 3 would surround it.
+	if err := validator.db.CheckAndSaveAttestation(validatorKey.PublicKey.Marshal(), &pbp2p.AttestationData{
+		Slot:           2 * params.BeaconConfig().SlotsPerEpoch,
+		JustifiedEpoch: 1,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	m.validatorClient.EXPECT().ValidatorIndex(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pb.ValidatorIndexRequest{}),
+	).Return(&pb.ValidatorIndexResponse{
+		Index: 0,
+	}, nil)
+	m.attesterClient.EXPECT().AttestationDataAtSlot(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pb.AttestationDataRequest{}),
+	).Return(&pb.AttestationDataResponse{
+		HeadSlot:                 3 * params.BeaconConfig().SlotsPerEpoch,
+		BeaconBlockRootHash32:    []byte{},
+		EpochBoundaryRootHash32:  []byte{},
+		JustifiedBlockRootHash32: []byte{},
+		LatestCrosslink:          &pbp2p.Crosslink{},
+		JustifiedEpoch:           0,
+	}, nil)
+	m.beaconClient.EXPECT().ForkData(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.Fork{
+		Epoch:           params.BeaconConfig().GenesisEpoch,
+		CurrentVersion:  0,
+		PreviousVersion: 0,
+	}, nil /*err*/)
+	m.attesterClient.EXPECT().AttestHead(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&pbp2p.Attestation{}),
+	).Times(0)
+
+	validator.AttestToBlockHead(context.Background(), 30, validatorPubKey)
+	testutil.AssertLogsContain(t, hook, "Not attesting! Attestation would be slashable")
+}
+
 func TestAttestToBlockHead_AttestsCorrectly(t *testing.T) {
 	hook := logTest.NewGlobal()
 
@@ -97,8 +229,9 @@ func TestAttestToBlockHead_AttestsCorrectly(t *testing.T) {
 	defer finish()
 	validatorIndex := uint64(7)
 	committee := []uint64{0, 3, 4, 2, validatorIndex, 6, 8, 9, 10}
-	validator.assignment = &pb.CommitteeAssignmentResponse{Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
+	validator.assignments = &pb.CommitteeAssignmentResponse{Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
 		{
+			PublicKey: validatorKey.PublicKey.Marshal(),
 			Shard:     5,
 			Committee: committee,
 		}}}
@@ -120,6 +253,15 @@ func TestAttestToBlockHead_AttestsCorrectly(t *testing.T) {
 		JustifiedEpoch:           3,
 	}, nil)
 
+	m.beaconClient.EXPECT().ForkData(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.Fork{
+		Epoch:           params.BeaconConfig().GenesisEpoch,
+		CurrentVersion:  0,
+		PreviousVersion: 0,
+	}, nil /*err*/)
+
 	var generatedAttestation *pbp2p.Attestation
 	m.attesterClient.EXPECT().AttestHead(
 		gomock.Any(), // ctx
@@ -128,7 +270,7 @@ func TestAttestToBlockHead_AttestsCorrectly(t *testing.T) {
 		generatedAttestation = att
 	}).Return(&pb.AttestResponse{}, nil /* error */)
 
-	validator.AttestToBlockHead(context.Background(), 30)
+	validator.AttestToBlockHead(context.Background(), 30, validatorPubKey)
 
 	// Validator index is at index 4 in the mocked committee defined in this test.
 	expectedAttestation := &pbp2p.Attestation{
@@ -142,9 +284,14 @@ func TestAttestToBlockHead_AttestsCorrectly(t *testing.T) {
 			CrosslinkDataRootHash32:  params.BeaconConfig().ZeroHash[:],
 			JustifiedEpoch:           3,
 		},
-		CustodyBitfield:    make([]byte, (len(committee)+7)/8),
-		AggregateSignature: []byte("signed"),
+		CustodyBitfield: make([]byte, (len(committee)+7)/8),
+	}
+	message, err := hashutil.HashAttestationDataAndCustodyBit(expectedAttestation.Data, false)
+	if err != nil {
+		t.Fatal(err)
 	}
+	domain := forkutil.DomainVersion(&pbp2p.Fork{}, 30/params.BeaconConfig().SlotsPerEpoch, params.BeaconConfig().DomainAttestation)
+	expectedAttestation.AggregateSignature = validatorKey.SecretKey.Sign(message[:], domain).Marshal()
 	aggregationBitfield := bitutil.SetBitfield(4, mathutil.CeilDiv8(len(committee)))
 	expectedAttestation.AggregationBitfield = aggregationBitfield
 	if !proto.Equal(generatedAttestation, expectedAttestation) {
@@ -158,6 +305,12 @@ func TestAttestToBlockHead_DoesNotAttestBeforeDelay(t *testing.T) {
 	defer finish()
 
 	validator.genesisTime = uint64(time.Now().Unix())
+	validator.assignments = &pb.CommitteeAssignmentResponse{Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
+		{
+			PublicKey: validatorKey.PublicKey.Marshal(),
+			Shard:     5,
+		},
+	}}
 	m.validatorClient.EXPECT().CommitteeAssignment(
 		gomock.Any(), // ctx
 		gomock.AssignableToTypeOf(&pb.CommitteeAssignmentsRequest{}),
@@ -181,7 +334,7 @@ func TestAttestToBlockHead_DoesNotAttestBeforeDelay(t *testing.T) {
 
 	delay = 3
 	timer := time.NewTimer(time.Duration(1 * time.Second))
-	go validator.AttestToBlockHead(context.Background(), 0)
+	go validator.AttestToBlockHead(context.Background(), 0, validatorPubKey)
 	<-timer.C
 }
 
@@ -196,8 +349,9 @@ func TestAttestToBlockHead_DoesAttestAfterDelay(t *testing.T) {
 	validator.genesisTime = uint64(time.Now().Unix())
 	validatorIndex := uint64(5)
 	committee := []uint64{0, 3, 4, 2, validatorIndex, 6, 8, 9, 10}
-	validator.assignment = &pb.CommitteeAssignmentResponse{Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
+	validator.assignments = &pb.CommitteeAssignmentResponse{Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
 		{
+			PublicKey: validatorKey.PublicKey.Marshal(),
 			Shard:     5,
 			Committee: committee,
 		}}}
@@ -224,13 +378,21 @@ func TestAttestToBlockHead_DoesAttestAfterDelay(t *testing.T) {
 		wg.Done()
 	})
 
+	m.beaconClient.EXPECT().ForkData(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.Fork{
+		Epoch:           params.BeaconConfig().GenesisEpoch,
+		CurrentVersion:  0,
+		PreviousVersion: 0,
+	}, nil /*err*/)
 	m.attesterClient.EXPECT().AttestHead(
 		gomock.Any(), // ctx
 		gomock.Any(),
 	).Return(&pb.AttestResponse{}, nil).Times(1)
 
 	delay = 0
-	validator.AttestToBlockHead(context.Background(), 0)
+	validator.AttestToBlockHead(context.Background(), 0, validatorPubKey)
 }
 
 func TestAttestToBlockHead_CorrectBitfieldLength(t *testing.T) {
@@ -238,8 +400,9 @@ func TestAttestToBlockHead_CorrectBitfieldLength(t *testing.T) {
 	defer finish()
 	validatorIndex := uint64(2)
 	committee := []uint64{0, 3, 4, 2, validatorIndex, 6, 8, 9, 10}
-	validator.assignment = &pb.CommitteeAssignmentResponse{Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
+	validator.assignments = &pb.CommitteeAssignmentResponse{Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
 		{
+			PublicKey: validatorKey.PublicKey.Marshal(),
 			Shard:     5,
 			Committee: committee,
 		}}}
@@ -261,6 +424,15 @@ func TestAttestToBlockHead_CorrectBitfieldLength(t *testing.T) {
 		JustifiedEpoch:           3,
 	}, nil)
 
+	m.beaconClient.EXPECT().ForkData(
+		gomock.Any(), // ctx
+		gomock.Eq(&ptypes.Empty{}),
+	).Return(&pbp2p.Fork{
+		Epoch:           params.BeaconConfig().GenesisEpoch,
+		CurrentVersion:  0,
+		PreviousVersion: 0,
+	}, nil /*err*/)
+
 	var generatedAttestation *pbp2p.Attestation
 	m.attesterClient.EXPECT().AttestHead(
 		gomock.Any(), // ctx
@@ -269,7 +441,7 @@ func TestAttestToBlockHead_CorrectBitfieldLength(t *testing.T) {
 		generatedAttestation = att
 	}).Return(&pb.AttestResponse{}, nil /* error */)
 
-	validator.AttestToBlockHead(context.Background(), 30)
+	validator.AttestToBlockHead(context.Background(), 30, validatorPubKey)
 
 	if len(generatedAttestation.AggregationBitfield) != 2 {
 		t.Errorf("Wanted length %d, received %d", 2, len(generatedAttestation.AggregationBitfield))
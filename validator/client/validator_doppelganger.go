@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+// DetectDoppelganger watches the network for the configured number of epochs
+// before any duty is performed, looking for attestations made with this client's
+// keys. Nothing is signed while watching, so any such attestation for a slot at
+// or after the first watched slot comes from another instance running the same
+// keys, and signing alongside it would get both slashed. An error is returned in
+// that case, and the validator client must not start performing its duties.
+func (v *validator) DetectDoppelganger(ctx context.Context) error {
+	if v.doppelgangerEpochs == 0 {
+		return nil
+	}
+	ctx, span := trace.StartSpan(ctx, "validator.DetectDoppelganger")
+	defer span.End()
+	return v.detectDoppelganger(ctx, v.NextSlot())
+}
+
+// detectDoppelganger performs doppelganger detection, receiving the start of
+// every slot from the given channel.
+func (v *validator) detectDoppelganger(ctx context.Context, slots <-chan uint64) error {
+	var startSlot, endSlot uint64
+	for {
+		var slot uint64
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("context has been canceled so stopping doppelganger detection: %v", ctx.Err())
+		case slot = <-slots:
+		}
+		if endSlot == 0 {
+			startSlot = slot
+			endSlot = slot + v.doppelgangerEpochs*params.BeaconConfig().SlotsPerEpoch
+			log.WithField("epochs", v.doppelgangerEpochs).Info(
+				"Watching the network for other instances of this validator's keys before performing duties")
+			continue
+		}
+		v.SelectBeaconNode()
+		// Check once per epoch while watching, then on every slot until a check
+		// succeeds once the watch period is over.
+		if slot < endSlot && (slot-startSlot)%params.BeaconConfig().SlotsPerEpoch != 0 {
+			continue
+		}
+		att, err := v.doppelgangerAttestation(ctx, startSlot)
+		if err != nil {
+			log.Errorf("Could not check for doppelganger attestations: %v", err)
+			continue
+		}
+		if att != nil {
+			return fmt.Errorf(
+				"found an attestation by validator %#x at slot %d which was not signed by this client, "+
+					"another validator client may be running with the same key",
+				bytesutil.Trunc(att.PublicKey), att.Data.Slot-params.BeaconConfig().GenesisSlot,
+			)
+		}
+		if slot >= endSlot {
+			log.Info("No doppelganger detected")
+			return nil
+		}
+	}
+}
+
+// doppelgangerAttestation returns an attestation made with one of this client's
+// keys for a slot at or after the given one, if the beacon node knows of any.
+func (v *validator) doppelgangerAttestation(
+	ctx context.Context, sinceSlot uint64,
+) (*pb.ValidatorAttestationsResponse_ValidatorAttestation, error) {
+	req := &pb.ValidatorAttestationsRequest{
+		PublicKeys: v.pubkeys,
+		SinceSlot:  sinceSlot,
+	}
+	resp, err := v.validatorClient.ValidatorAttestations(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Attestations) == 0 {
+		return nil, nil
+	}
+	return resp.Attestations[0], nil
+}
@@ -0,0 +1,137 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/validator/internal"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+// slotsChannel returns a channel which emits the given slots in order.
+func slotsChannel(slots ...uint64) <-chan uint64 {
+	c := make(chan uint64, len(slots))
+	for _, slot := range slots {
+		c <- slot
+	}
+	return c
+}
+
+func TestDetectDoppelganger_Disabled(t *testing.T) {
+	v := validator{}
+	if err := v.DetectDoppelganger(context.Background()); err != nil {
+		t.Errorf("Expected no error when doppelganger detection is disabled, received %v", err)
+	}
+}
+
+func TestDetectDoppelganger_NoneFound(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockValidatorServiceClient(ctrl)
+
+	v := validator{
+		pubkeys:            [][]byte{validatorKey.PublicKey.Marshal()},
+		validatorClient:    client,
+		doppelgangerEpochs: 2,
+	}
+	start := params.BeaconConfig().GenesisSlot + 10
+	epoch := params.BeaconConfig().SlotsPerEpoch
+
+	client.EXPECT().ValidatorAttestations(
+		gomock.Any(), // ctx
+		&pb.ValidatorAttestationsRequest{
+			PublicKeys: v.pubkeys,
+			SinceSlot:  start,
+		},
+	).Times(2).Return(&pb.ValidatorAttestationsResponse{}, nil)
+
+	// Only epoch boundaries relative to the first watched slot are checked.
+	slots := slotsChannel(start, start+1, start+epoch, start+epoch+1, start+2*epoch)
+	if err := v.detectDoppelganger(context.Background(), slots); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	testutil.AssertLogsContain(t, hook, "No doppelganger detected")
+}
+
+func TestDetectDoppelganger_Found(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockValidatorServiceClient(ctrl)
+
+	v := validator{
+		pubkeys:            [][]byte{validatorKey.PublicKey.Marshal()},
+		validatorClient:    client,
+		doppelgangerEpochs: 2,
+	}
+	start := params.BeaconConfig().GenesisSlot + 10
+	epoch := params.BeaconConfig().SlotsPerEpoch
+
+	client.EXPECT().ValidatorAttestations(
+		gomock.Any(), // ctx
+		gomock.Any(), // req
+	).Return(&pb.ValidatorAttestationsResponse{
+		Attestations: []*pb.ValidatorAttestationsResponse_ValidatorAttestation{
+			{
+				PublicKey: v.pubkeys[0],
+				Data:      &pbp2p.AttestationData{Slot: start + 3},
+			},
+		},
+	}, nil)
+
+	slots := slotsChannel(start, start+epoch, start+2*epoch)
+	err := v.detectDoppelganger(context.Background(), slots)
+	if err == nil || !strings.Contains(err.Error(), "not signed by this client") {
+		t.Errorf("Expected doppelganger to be detected, received %v", err)
+	}
+}
+
+func TestDetectDoppelganger_RetriesFailedFinalCheck(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockValidatorServiceClient(ctrl)
+
+	v := validator{
+		pubkeys:            [][]byte{validatorKey.PublicKey.Marshal()},
+		validatorClient:    client,
+		doppelgangerEpochs: 1,
+	}
+	start := params.BeaconConfig().GenesisSlot + 10
+	epoch := params.BeaconConfig().SlotsPerEpoch
+
+	gomock.InOrder(
+		client.EXPECT().ValidatorAttestations(
+			gomock.Any(), // ctx
+			gomock.Any(), // req
+		).Return(nil, errors.New("connection refused")),
+		client.EXPECT().ValidatorAttestations(
+			gomock.Any(), // ctx
+			gomock.Any(), // req
+		).Return(&pb.ValidatorAttestationsResponse{}, nil),
+	)
+
+	slots := slotsChannel(start, start+epoch, start+epoch+1)
+	if err := v.detectDoppelganger(context.Background(), slots); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	testutil.AssertLogsContain(t, hook, "Could not check for doppelganger attestations")
+	testutil.AssertLogsContain(t, hook, "No doppelganger detected")
+}
+
+func TestDetectDoppelganger_ContextCanceled(t *testing.T) {
+	v := validator{doppelgangerEpochs: 1}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := v.detectDoppelganger(ctx, make(chan uint64))
+	if err == nil || !strings.Contains(err.Error(), "context has been canceled") {
+		t.Errorf("Expected context canceled error, received %v", err)
+	}
+}
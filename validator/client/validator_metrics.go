@@ -2,60 +2,99 @@ package client
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
 )
 
+var validatorBalancesGaugeVec = promauto.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "validator_balance",
+		Help: "The balance of each validator managed by this client, in ETH.",
+	},
+	[]string{
+		// Validator pubkey.
+		"pubkey",
+	},
+)
+
 // LogValidatorGainsAndLosses logs important metrics related to this validator client's
 // responsibilities throughout the beacon chain's lifecycle. It logs absolute accrued rewards
 // and penalties over time, percentage gain/loss, and gives the end user a better idea
-// of how the validator performs with respect to the rest.
+// of how each of its validators performs with respect to the rest.
 func (v *validator) LogValidatorGainsAndLosses(ctx context.Context, slot uint64) error {
 	if slot%params.BeaconConfig().SlotsPerEpoch != 0 {
 		// Do nothing if we are not at the start of a new epoch.
 		return nil
 	}
-	epoch := slot / params.BeaconConfig().SlotsPerEpoch
-	if epoch == params.BeaconConfig().GenesisEpoch {
-		v.prevBalance = params.BeaconConfig().MaxDepositAmount
+	if v.prevBalance == nil {
+		v.prevBalance = make(map[string]uint64)
 	}
-	req := &pb.ValidatorPerformanceRequest{
-		Slot:      slot,
-		PublicKey: v.key.PublicKey.Marshal(),
-	}
-	resp, err := v.validatorClient.ValidatorPerformance(ctx, req)
-	if err != nil {
-		return err
-	}
-	newBalance := float64(resp.Balance) / float64(params.BeaconConfig().GweiPerEth)
+	epoch := slot / params.BeaconConfig().SlotsPerEpoch
 	log.WithFields(logrus.Fields{
 		"slot":  slot - params.BeaconConfig().GenesisSlot,
-		"epoch": (slot / params.BeaconConfig().SlotsPerEpoch) - params.BeaconConfig().GenesisEpoch,
+		"epoch": epoch - params.BeaconConfig().GenesisEpoch,
 	}).Info("Start of a new epoch!")
+	log.Info("Generating validator performance report from the previous epoch...")
+	var resp *pb.ValidatorPerformanceResponse
+	for _, pubKey := range v.pubkeys {
+		req := &pb.ValidatorPerformanceRequest{
+			Slot:      slot,
+			PublicKey: pubKey,
+		}
+		var err error
+		resp, err = v.validatorClient.ValidatorPerformance(ctx, req)
+		if err != nil {
+			return err
+		}
+		v.logValidatorBalance(pubKey, epoch, resp.Balance)
+	}
+	if resp == nil {
+		return nil
+	}
 	log.WithFields(logrus.Fields{
 		"totalValidators":     resp.TotalValidators,
 		"numActiveValidators": resp.TotalActiveValidators,
 	}).Infof("Validator registry information")
-	log.Info("Generating validator performance report from the previous epoch...")
+	avgBalance := resp.AverageValidatorBalance / float32(params.BeaconConfig().GweiPerEth)
+	log.WithField(
+		"averageEthBalance", fmt.Sprintf("%f", avgBalance),
+	).Info("Average eth balance per validator in the beacon chain")
+	return nil
+}
+
+// logValidatorBalance reports the new balance of a single validator together with
+// its net gains or losses since the previous report.
+func (v *validator) logValidatorBalance(pubKey []byte, epoch uint64, balance uint64) {
+	id := hex.EncodeToString(pubKey)
+	if epoch == params.BeaconConfig().GenesisEpoch {
+		v.prevBalance[id] = params.BeaconConfig().MaxDepositAmount
+	}
+	tpk := fmt.Sprintf("%#x", bytesutil.Trunc(pubKey))
+	newBalance := float64(balance) / float64(params.BeaconConfig().GweiPerEth)
+	validatorBalancesGaugeVec.WithLabelValues(fmt.Sprintf("%#x", pubKey)).Set(newBalance)
 	log.WithFields(logrus.Fields{
+		"pubKey":     tpk,
 		"ethBalance": newBalance,
 	}).Info("New validator balance")
-	avgBalance := resp.AverageValidatorBalance / float32(params.BeaconConfig().GweiPerEth)
-	if v.prevBalance > 0 {
-		prevBalance := float64(v.prevBalance) / float64(params.BeaconConfig().GweiPerEth)
+	if v.prevBalance[id] > 0 {
+		prevBalance := float64(v.prevBalance[id]) / float64(params.BeaconConfig().GweiPerEth)
 		percentNet := (newBalance - prevBalance) / prevBalance
-		log.WithField("prevEthBalance", prevBalance).Info("Previous validator balance")
 		log.WithFields(logrus.Fields{
+			"pubKey":         tpk,
+			"prevEthBalance": prevBalance,
+		}).Info("Previous validator balance")
+		log.WithFields(logrus.Fields{
+			"pubKey":        tpk,
 			"eth":           fmt.Sprintf("%f", newBalance-prevBalance),
 			"percentChange": fmt.Sprintf("%.2f%%", percentNet*100),
 		}).Info("Net gains/losses in eth")
 	}
-	log.WithField(
-		"averageEthBalance", fmt.Sprintf("%f", avgBalance),
-	).Info("Average eth balance per validator in the beacon chain")
-	v.prevBalance = resp.Balance
-	return nil
+	v.prevBalance[id] = balance
 }
@@ -4,12 +4,12 @@ package client
 import (
 	"context"
 	"encoding/binary"
+	"encoding/hex"
 	"fmt"
 
-	"github.com/gogo/protobuf/proto"
 	ptypes "github.com/gogo/protobuf/types"
-	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/forkutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -17,48 +17,28 @@ import (
 	"go.opencensus.io/trace"
 )
 
-// ProposeBlock A new beacon block for a given slot. This method collects the
-// previous beacon block, any pending deposits, and ETH1 data from the beacon
-// chain node to construct the new block. The new block is then processed with
-// the state root computation, and finally signed by the validator before being
-// sent back to the beacon node for broadcasting.
-func (v *validator) ProposeBlock(ctx context.Context, slot uint64) {
+// ProposeBlock A new beacon block for a given slot. This method signs a randao reveal for
+// the slot and asks the beacon chain node to produce a block carrying it, built from the
+// node's head state with its pending deposits, attestations, slashings, exits, ETH1 data
+// and the resulting state root. The block is then signed by the validator before being
+// sent back to the beacon node for broadcasting. The block is proposed on behalf
+// of the validator identified by the hex encoded public key pubKey.
+func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey string) {
 	if slot == params.BeaconConfig().GenesisSlot {
 		log.Info("Assigned to genesis slot, skipping proposal")
 		return
 	}
 	ctx, span := trace.StartSpan(ctx, "validator.ProposeBlock")
 	defer span.End()
-	span.AddAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", v.key.PublicKey.Marshal())))
-	log.Info("Performing a beacon block proposal...")
-	// 1. Fetch data from Beacon Chain node.
-	// Get current head beacon block.
-	headBlock, err := v.beaconClient.CanonicalHead(ctx, &ptypes.Empty{})
-	if err != nil {
-		log.Errorf("Failed to fetch CanonicalHead: %v", err)
-		return
-	}
-	parentTreeRoot, err := hashutil.HashBeaconBlock(headBlock)
-	if err != nil {
-		log.Errorf("Failed to hash parent block: %v", err)
-		return
-	}
-
-	// Get validator ETH1 deposits which have not been included in the beacon chain.
-	pDepResp, err := v.beaconClient.PendingDeposits(ctx, &ptypes.Empty{})
+	key, err := hex.DecodeString(pubKey)
 	if err != nil {
-		log.Errorf("Failed to get pending pendings: %v", err)
+		log.Errorf("Invalid validator public key %s: %v", pubKey, err)
 		return
 	}
-
-	// Get ETH1 data.
-	eth1DataResp, err := v.beaconClient.Eth1Data(ctx, &ptypes.Empty{})
-	if err != nil {
-		log.Errorf("Failed to get ETH1 data: %v", err)
-		return
-	}
-
-	// Retrieve the current fork data from the beacon node.
+	log := log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(key)))
+	span.AddAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", key)))
+	log.Info("Performing a beacon block proposal...")
+	// 1. Retrieve the current fork data from the beacon node.
 	fork, err := v.beaconClient.ForkData(ctx, &ptypes.Empty{})
 	if err != nil {
 		log.Errorf("Failed to get fork data from beacon node's state: %v", err)
@@ -79,46 +59,54 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64) {
 	buf := make([]byte, 32)
 	binary.LittleEndian.PutUint64(buf, epoch)
 	domain := forkutil.DomainVersion(fork, epoch, params.BeaconConfig().DomainRandao)
-	epochSignature := v.key.SecretKey.Sign(buf, domain)
-
-	// Fetch pending attestations seen by the beacon node.
-	attResp, err := v.proposerClient.PendingAttestations(ctx, &pb.PendingAttestationsRequest{
-		FilterReadyForInclusion: true,
-		ProposalBlockSlot:       slot,
-	})
+	epochSignature, err := v.signer.Sign(ctx, key, buf, domain)
 	if err != nil {
-		log.Errorf("Failed to fetch pending attestations from the beacon node: %v", err)
+		log.Errorf("Failed to sign randao reveal: %v", err)
 		return
 	}
 
-	// 2. Construct block.
-	block := &pbp2p.BeaconBlock{
-		Slot:             slot,
-		ParentRootHash32: parentTreeRoot[:],
-		RandaoReveal:     epochSignature.Marshal(),
-		Eth1Data:         eth1DataResp.Eth1Data,
-		Body: &pbp2p.BeaconBlockBody{
-			Attestations:      attResp.PendingAttestations,
-			ProposerSlashings: nil, // TODO(1438): Add after operations pool
-			AttesterSlashings: nil, // TODO(1438): Add after operations pool
-			Deposits:          pDepResp.PendingDeposits,
-			VoluntaryExits:    nil, // TODO(1323): Add validator exits
-		},
+	// 2. Have the beacon node produce the block on top of its head, computing its
+	// contents and state root from a single head state.
+	block, err := v.proposerClient.ProduceBlock(ctx, &pb.ProduceBlockRequest{
+		Slot:         slot,
+		RandaoReveal: epochSignature,
+	})
+	if err != nil {
+		log.Errorf("Not proposing! Failed to produce block: %v", err)
+		return
 	}
 
-	// 3. Compute state root transition from parent block to the new block.
-	resp, err := v.proposerClient.ComputeStateRoot(ctx, block)
+	// 3. Record the block in the slashing protection database, refusing to
+	// sign it if a different block was already signed for this slot.
+	blockRoot, err := hashutil.HashBeaconBlock(block)
 	if err != nil {
+		log.Errorf("Failed to hash block: %v", err)
+		return
+	}
+	if err := v.db.CheckAndSaveProposal(key, slot, blockRoot[:]); err != nil {
 		log.WithField(
-			"block", proto.MarshalTextString(block),
-		).Errorf("Not proposing! Unable to compute state root: %v", err)
+			"slot", slot-params.BeaconConfig().GenesisSlot,
+		).Errorf("Not proposing! Block would be slashable: %v", err)
 		return
 	}
-	block.StateRootHash32 = resp.GetStateRoot()
 
 	// 4. Sign the complete block.
-	// TODO(1366): BLS sign block
-	block.Signature = nil
+	// block.signature = bls_sign(
+	//   privkey=validator.privkey,
+	//   message_hash=hash_tree_root(ProposalSignedData(block.slot, BEACON_CHAIN_SHARD_NUMBER, block_root)),
+	//   domain=get_domain(fork, slot_to_epoch(block.slot), DOMAIN_PROPOSAL),
+	// )
+	proposal, err := hashutil.HashProposal(block)
+	if err != nil {
+		log.Errorf("Failed to hash proposal: %v", err)
+		return
+	}
+	proposalDomain := forkutil.DomainVersion(fork, epoch, params.BeaconConfig().DomainProposal)
+	block.Signature, err = v.signer.Sign(ctx, key, proposal[:], proposalDomain)
+	if err != nil {
+		log.Errorf("Failed to sign block: %v", err)
+		return
+	}
 
 	// 5. Broadcast to the network via beacon chain node.
 	blkResp, err := v.proposerClient.ProposeBlock(ctx, block)
@@ -1,17 +1,22 @@
 package client
 
 import (
-	"bytes"
 	"context"
+	"encoding/binary"
 	"errors"
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
 	ptypes "github.com/gogo/protobuf/types"
 	"github.com/golang/mock/gomock"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/internal"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
@@ -32,84 +37,56 @@ func setup(t *testing.T) (*validator, *mocks, func()) {
 		attesterClient:  internal.NewMockAttesterServiceClient(ctrl),
 	}
 
+	validatorDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("Could not setup validator db: %v", err)
+	}
+
 	validator := &validator{
 		proposerClient:  m.proposerClient,
 		beaconClient:    m.beaconClient,
 		attesterClient:  m.attesterClient,
 		validatorClient: m.validatorClient,
-		key:             validatorKey,
+		signer:          NewKeystoreSigner(keyMap),
+		pubkeys:         [][]byte{validatorKey.PublicKey.Marshal()},
+		db:              validatorDB,
 	}
 
-	return validator, m, ctrl.Finish
+	return validator, m, func() {
+		ctrl.Finish()
+		db.TeardownDB(validatorDB)
+	}
 }
 
 func TestProposeBlock_DoesNotProposeGenesisBlock(t *testing.T) {
 	hook := logTest.NewGlobal()
 	validator, _, finish := setup(t)
 	defer finish()
-	validator.ProposeBlock(context.Background(), params.BeaconConfig().GenesisSlot)
+	validator.ProposeBlock(context.Background(), params.BeaconConfig().GenesisSlot, validatorPubKey)
 
 	testutil.AssertLogsContain(t, hook, "Assigned to genesis slot, skipping proposal")
 }
 
-func TestProposeBlock_LogsCanonicalHeadFailure(t *testing.T) {
+func TestProposeBlock_LogsForkDataFailure(t *testing.T) {
 	hook := logTest.NewGlobal()
 	validator, m, finish := setup(t)
 	defer finish()
 
-	m.beaconClient.EXPECT().CanonicalHead(
+	m.beaconClient.EXPECT().ForkData(
 		gomock.Any(), // ctx
 		gomock.Eq(&ptypes.Empty{}),
-	).Return(nil /*beaconBlock*/, errors.New("something bad happened"))
+	).Return(nil /*fork*/, errors.New("something bad happened"))
 
-	validator.ProposeBlock(context.Background(), 55)
+	validator.ProposeBlock(context.Background(), 55, validatorPubKey)
 
 	testutil.AssertLogsContain(t, hook, "something bad happened")
 }
 
-func TestProposeBlock_PendingDepositsFailure(t *testing.T) {
+func TestProposeBlock_ProduceBlockFailure(t *testing.T) {
 	hook := logTest.NewGlobal()
 	validator, m, finish := setup(t)
 	defer finish()
 
-	m.beaconClient.EXPECT().CanonicalHead(
-		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
-	).Return(&pbp2p.BeaconBlock{}, nil /*err*/)
-
-	m.beaconClient.EXPECT().PendingDeposits(
-		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
-	).Return(nil /*response*/, errors.New("something bad happened"))
-
-	validator.ProposeBlock(context.Background(), 55)
-
-	testutil.AssertLogsContain(t, hook, "something bad happened")
-}
-
-func TestProposeBlock_UsePendingDeposits(t *testing.T) {
-	validator, m, finish := setup(t)
-	defer finish()
-
-	m.beaconClient.EXPECT().CanonicalHead(
-		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
-	).Return(&pbp2p.BeaconBlock{}, nil /*err*/)
-
-	m.beaconClient.EXPECT().PendingDeposits(
-		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
-	).Return(&pb.PendingDepositsResponse{
-		PendingDeposits: []*pbp2p.Deposit{
-			{DepositData: []byte{'D', 'A', 'T', 'A'}},
-		},
-	}, nil /*err*/)
-
-	m.beaconClient.EXPECT().Eth1Data(
-		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
-	).Return(&pb.Eth1DataResponse{}, nil /*err*/)
-
 	m.beaconClient.EXPECT().ForkData(
 		gomock.Any(), // ctx
 		gomock.Eq(&ptypes.Empty{}),
@@ -119,197 +96,75 @@ func TestProposeBlock_UsePendingDeposits(t *testing.T) {
 		PreviousVersion: 0,
 	}, nil /*err*/)
 
-	m.proposerClient.EXPECT().PendingAttestations(
+	m.proposerClient.EXPECT().ProduceBlock(
 		gomock.Any(), // ctx
-		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
-	).Return(&pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil)
-
-	m.proposerClient.EXPECT().ComputeStateRoot(
-		gomock.Any(), // context
-		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
-	).Return(&pb.StateRootResponse{
-		StateRoot: []byte{'F'},
-	}, nil /*err*/)
+		gomock.AssignableToTypeOf(&pb.ProduceBlockRequest{}),
+	).Return(nil /*block*/, errors.New("something bad happened"))
 
-	var broadcastedBlock *pbp2p.BeaconBlock
 	m.proposerClient.EXPECT().ProposeBlock(
-		gomock.Any(), // context
-		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
-	).Do(func(_ context.Context, blk *pbp2p.BeaconBlock) {
-		broadcastedBlock = blk
-	}).Return(&pb.ProposeResponse{}, nil /*error*/)
-
-	validator.ProposeBlock(context.Background(), 55)
-
-	if !bytes.Equal(broadcastedBlock.Body.Deposits[0].DepositData, []byte{'D', 'A', 'T', 'A'}) {
-		t.Errorf("Unexpected deposit data: %v", broadcastedBlock.Body.Deposits)
-	}
-}
-
-func TestProposeBlock_Eth1DataFailure(t *testing.T) {
-	hook := logTest.NewGlobal()
-	validator, m, finish := setup(t)
-	defer finish()
-
-	m.beaconClient.EXPECT().CanonicalHead(
-		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
-	).Return(&pbp2p.BeaconBlock{}, nil /*err*/)
-
-	m.beaconClient.EXPECT().PendingDeposits(
 		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
-	).Return(&pb.PendingDepositsResponse{}, nil /*err*/)
-
-	m.beaconClient.EXPECT().Eth1Data(
-		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
-	).Return(nil /*response*/, errors.New("something bad happened"))
-
-	validator.ProposeBlock(context.Background(), 55)
+		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
+	).Times(0)
 
-	testutil.AssertLogsContain(t, hook, "something bad happened")
+	validator.ProposeBlock(context.Background(), 55, validatorPubKey)
+	testutil.AssertLogsContain(t, hook, "Not proposing! Failed to produce block")
 }
 
-func TestProposeBlock_UsesEth1Data(t *testing.T) {
+func TestProposeBlock_ProduceBlockUsesSlotAndRandaoReveal(t *testing.T) {
 	validator, m, finish := setup(t)
 	defer finish()
 
-	m.beaconClient.EXPECT().CanonicalHead(
-		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
-	).Return(&pbp2p.BeaconBlock{}, nil /*err*/)
-
-	m.beaconClient.EXPECT().PendingDeposits(
-		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
-	).Return(&pb.PendingDepositsResponse{}, nil /*err*/)
-
-	m.beaconClient.EXPECT().Eth1Data(
-		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
-	).Return(&pb.Eth1DataResponse{
-		Eth1Data: &pbp2p.Eth1Data{BlockHash32: []byte{'B', 'L', 'O', 'C', 'K'}},
-	}, nil /*err*/)
-
-	m.beaconClient.EXPECT().ForkData(
-		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
-	).Return(&pbp2p.Fork{
+	fork := &pbp2p.Fork{
 		Epoch:           params.BeaconConfig().GenesisEpoch,
-		CurrentVersion:  0,
+		CurrentVersion:  1,
 		PreviousVersion: 0,
-	}, nil /*err*/)
-
-	m.proposerClient.EXPECT().PendingAttestations(
-		gomock.Any(), // ctx
-		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
-	).Return(&pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil)
-
-	m.proposerClient.EXPECT().ComputeStateRoot(
-		gomock.Any(), // context
-		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
-	).Return(&pb.StateRootResponse{
-		StateRoot: []byte{'F'},
-	}, nil /*err*/)
-
-	var broadcastedBlock *pbp2p.BeaconBlock
-	m.proposerClient.EXPECT().ProposeBlock(
-		gomock.Any(), // ctx
-		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
-	).Do(func(_ context.Context, blk *pbp2p.BeaconBlock) {
-		broadcastedBlock = blk
-	}).Return(&pb.ProposeResponse{}, nil /*error*/)
-
-	validator.ProposeBlock(context.Background(), 55)
-
-	if !bytes.Equal(broadcastedBlock.Eth1Data.BlockHash32, []byte{'B', 'L', 'O', 'C', 'K'}) {
-		t.Errorf("Unexpected ETH1 data: %v", broadcastedBlock.Eth1Data)
 	}
-}
-
-func TestProposeBlock_PendingAttestations_UsesCurrentSlot(t *testing.T) {
-	validator, m, finish := setup(t)
-	defer finish()
-
-	m.beaconClient.EXPECT().CanonicalHead(
-		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
-	).Return(&pbp2p.BeaconBlock{}, nil /*err*/)
-
-	m.beaconClient.EXPECT().PendingDeposits(
-		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
-	).Return(&pb.PendingDepositsResponse{}, nil /*err*/)
-
-	m.beaconClient.EXPECT().Eth1Data(
-		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
-	).Return(&pb.Eth1DataResponse{
-		Eth1Data: &pbp2p.Eth1Data{BlockHash32: []byte{'B', 'L', 'O', 'C', 'K'}},
-	}, nil /*err*/)
-
 	m.beaconClient.EXPECT().ForkData(
 		gomock.Any(), // ctx
 		gomock.Eq(&ptypes.Empty{}),
-	).Return(&pbp2p.Fork{
-		Epoch:           params.BeaconConfig().GenesisEpoch,
-		CurrentVersion:  0,
-		PreviousVersion: 0,
-	}, nil /*err*/)
+	).Return(fork, nil /*err*/)
 
-	var req *pb.PendingAttestationsRequest
-	m.proposerClient.EXPECT().PendingAttestations(
+	var req *pb.ProduceBlockRequest
+	m.proposerClient.EXPECT().ProduceBlock(
 		gomock.Any(), // ctx
-		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
-	).DoAndReturn(func(_ context.Context, r *pb.PendingAttestationsRequest) (*pb.PendingAttestationsResponse, error) {
+		gomock.AssignableToTypeOf(&pb.ProduceBlockRequest{}),
+	).DoAndReturn(func(_ context.Context, r *pb.ProduceBlockRequest) (*pbp2p.BeaconBlock, error) {
 		req = r
-		return &pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil
+		return &pbp2p.BeaconBlock{Slot: r.Slot, RandaoReveal: r.RandaoReveal, Body: &pbp2p.BeaconBlockBody{}}, nil
 	})
 
-	m.proposerClient.EXPECT().ComputeStateRoot(
-		gomock.Any(), // context
-		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
-	).Return(&pb.StateRootResponse{
-		StateRoot: []byte{'F'},
-	}, nil /*err*/)
-
 	m.proposerClient.EXPECT().ProposeBlock(
-		gomock.Any(), // context
+		gomock.Any(), // ctx
 		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
 	).Return(&pb.ProposeResponse{}, nil /*error*/)
 
-	validator.ProposeBlock(context.Background(), 55)
-	if req.ProposalBlockSlot != 55 {
-		t.Errorf(
-			"expected request to use the current proposal slot %d, but got %d",
-			55,
-			req.ProposalBlockSlot,
-		)
+	slot := params.BeaconConfig().GenesisSlot + 55
+	validator.ProposeBlock(context.Background(), slot, validatorPubKey)
+
+	if req.Slot != slot {
+		t.Errorf("Expected request to use the proposal slot %d, but got %d", slot, req.Slot)
+	}
+	sig, err := bls.SignatureFromBytes(req.RandaoReveal)
+	if err != nil {
+		t.Fatalf("Could not deserialize randao reveal: %v", err)
+	}
+	epoch := slot / params.BeaconConfig().SlotsPerEpoch
+	buf := make([]byte, 32)
+	binary.LittleEndian.PutUint64(buf, epoch)
+	domain := forkutil.DomainVersion(fork, epoch, params.BeaconConfig().DomainRandao)
+	if !sig.Verify(buf, validatorKey.PublicKey, domain) {
+		t.Error("Randao reveal did not verify against the proposer's public key")
 	}
 }
 
-func TestProposeBlock_PendingAttestationsFailure(t *testing.T) {
+func TestProposeBlock_RefusesDoubleProposal(t *testing.T) {
 	hook := logTest.NewGlobal()
 	validator, m, finish := setup(t)
 	defer finish()
 
-	m.beaconClient.EXPECT().CanonicalHead(
-		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
-	).Return(&pbp2p.BeaconBlock{}, nil /*err*/)
-
-	m.beaconClient.EXPECT().PendingDeposits(
-		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
-	).Return(&pb.PendingDepositsResponse{}, nil /*err*/)
-
-	m.beaconClient.EXPECT().Eth1Data(
-		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
-	).Return(&pb.Eth1DataResponse{
-		Eth1Data: &pbp2p.Eth1Data{BlockHash32: []byte{'B', 'L', 'O', 'C', 'K'}},
-	}, nil /*err*/)
+	if err := validator.db.CheckAndSaveProposal(validatorKey.PublicKey.Marshal(), 55, []byte("other block")); err != nil {
+		t.Fatal(err)
+	}
 
 	m.beaconClient.EXPECT().ForkData(
 		gomock.Any(), // ctx
@@ -320,77 +175,24 @@ func TestProposeBlock_PendingAttestationsFailure(t *testing.T) {
 		PreviousVersion: 0,
 	}, nil /*err*/)
 
-	m.proposerClient.EXPECT().PendingAttestations(
-		gomock.Any(), // ctx
-		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
-	).Return(nil, errors.New("failed"))
-
-	validator.ProposeBlock(context.Background(), 55)
-	testutil.AssertLogsContain(t, hook, "Failed to fetch pending attestations")
-}
-
-func TestProposeBlock_ComputeStateFailure(t *testing.T) {
-	hook := logTest.NewGlobal()
-	validator, m, finish := setup(t)
-	defer finish()
-
-	m.beaconClient.EXPECT().CanonicalHead(
-		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
-	).Return(&pbp2p.BeaconBlock{}, nil /*err*/)
-
-	m.beaconClient.EXPECT().PendingDeposits(
-		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
-	).Return(&pb.PendingDepositsResponse{}, nil /*err*/)
-
-	m.beaconClient.EXPECT().Eth1Data(
-		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
-	).Return(&pb.Eth1DataResponse{}, nil /*err*/)
-
-	m.beaconClient.EXPECT().ForkData(
+	m.proposerClient.EXPECT().ProduceBlock(
 		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
-	).Return(&pbp2p.Fork{
-		Epoch:           params.BeaconConfig().GenesisEpoch,
-		CurrentVersion:  0,
-		PreviousVersion: 0,
-	}, nil /*err*/)
+		gomock.AssignableToTypeOf(&pb.ProduceBlockRequest{}),
+	).Return(&pbp2p.BeaconBlock{Slot: 55, Body: &pbp2p.BeaconBlockBody{}}, nil /*err*/)
 
-	m.proposerClient.EXPECT().PendingAttestations(
+	m.proposerClient.EXPECT().ProposeBlock(
 		gomock.Any(), // ctx
-		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
-	).Return(&pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil)
-
-	m.proposerClient.EXPECT().ComputeStateRoot(
-		gomock.Any(), // context
 		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
-	).Return(nil /*response*/, errors.New("something bad happened"))
+	).Times(0)
 
-	validator.ProposeBlock(context.Background(), 55)
-	testutil.AssertLogsContain(t, hook, "something bad happened")
+	validator.ProposeBlock(context.Background(), 55, validatorPubKey)
+	testutil.AssertLogsContain(t, hook, "Not proposing! Block would be slashable")
 }
 
-func TestProposeBlock_UsesComputedState(t *testing.T) {
+func TestProposeBlock_BroadcastsProducedBlock(t *testing.T) {
 	validator, m, finish := setup(t)
 	defer finish()
 
-	m.beaconClient.EXPECT().CanonicalHead(
-		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
-	).Return(&pbp2p.BeaconBlock{}, nil /*err*/)
-
-	m.beaconClient.EXPECT().PendingDeposits(
-		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
-	).Return(&pb.PendingDepositsResponse{}, nil /*err*/)
-
-	m.beaconClient.EXPECT().Eth1Data(
-		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
-	).Return(&pb.Eth1DataResponse{}, nil /*err*/)
-
 	m.beaconClient.EXPECT().ForkData(
 		gomock.Any(), // ctx
 		gomock.Eq(&ptypes.Empty{}),
@@ -400,10 +202,19 @@ func TestProposeBlock_UsesComputedState(t *testing.T) {
 		PreviousVersion: 0,
 	}, nil /*err*/)
 
-	m.proposerClient.EXPECT().PendingAttestations(
+	produced := &pbp2p.BeaconBlock{
+		Slot:            55,
+		StateRootHash32: []byte{'T', 'E', 'S', 'T'},
+		Eth1Data:        &pbp2p.Eth1Data{BlockHash32: []byte{'B', 'L', 'O', 'C', 'K'}},
+		Body: &pbp2p.BeaconBlockBody{
+			Deposits:       []*pbp2p.Deposit{{DepositData: []byte{'D', 'A', 'T', 'A'}}},
+			VoluntaryExits: []*pbp2p.VoluntaryExit{{ValidatorIndex: 1}},
+		},
+	}
+	m.proposerClient.EXPECT().ProduceBlock(
 		gomock.Any(), // ctx
-		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
-	).Return(&pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil)
+		gomock.AssignableToTypeOf(&pb.ProduceBlockRequest{}),
+	).Return(proto.Clone(produced).(*pbp2p.BeaconBlock), nil /*err*/)
 
 	var broadcastedBlock *pbp2p.BeaconBlock
 	m.proposerClient.EXPECT().ProposeBlock(
@@ -413,68 +224,56 @@ func TestProposeBlock_UsesComputedState(t *testing.T) {
 		broadcastedBlock = blk
 	}).Return(&pb.ProposeResponse{}, nil /*error*/)
 
-	computedStateRoot := []byte{'T', 'E', 'S', 'T'}
-	m.proposerClient.EXPECT().ComputeStateRoot(
-		gomock.Any(), // context
-		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
-	).Return(
-		&pb.StateRootResponse{
-			StateRoot: computedStateRoot,
-		},
-		nil, // err
-	)
-
-	validator.ProposeBlock(context.Background(), 55)
+	validator.ProposeBlock(context.Background(), 55, validatorPubKey)
 
-	if !bytes.Equal(broadcastedBlock.StateRootHash32, computedStateRoot) {
-		t.Errorf("Unexpected state root hash. want=%#x got=%#x", computedStateRoot, broadcastedBlock.StateRootHash32)
+	// Only the signature is filled in by the validator client.
+	broadcastedBlock.Signature = nil
+	if !proto.Equal(broadcastedBlock, produced) {
+		t.Errorf("Unexpected broadcasted block. want=%v got=%v", produced, broadcastedBlock)
 	}
 }
 
-func TestProposeBlock_BroadcastsABlock(t *testing.T) {
+func TestProposeBlock_SignsBlock(t *testing.T) {
 	validator, m, finish := setup(t)
 	defer finish()
 
-	m.beaconClient.EXPECT().CanonicalHead(
-		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
-	).Return(&pbp2p.BeaconBlock{}, nil /*err*/)
-
-	m.beaconClient.EXPECT().PendingDeposits(
-		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
-	).Return(&pb.PendingDepositsResponse{}, nil /*err*/)
-
-	m.beaconClient.EXPECT().Eth1Data(
-		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
-	).Return(&pb.Eth1DataResponse{}, nil /*err*/)
-
+	fork := &pbp2p.Fork{
+		Epoch:           params.BeaconConfig().GenesisEpoch,
+		CurrentVersion:  1,
+		PreviousVersion: 0,
+	}
 	m.beaconClient.EXPECT().ForkData(
 		gomock.Any(), // ctx
 		gomock.Eq(&ptypes.Empty{}),
-	).Return(&pbp2p.Fork{
-		Epoch:           params.BeaconConfig().GenesisEpoch,
-		CurrentVersion:  0,
-		PreviousVersion: 0,
-	}, nil /*err*/)
+	).Return(fork, nil /*err*/)
 
-	m.proposerClient.EXPECT().PendingAttestations(
+	slot := params.BeaconConfig().GenesisSlot + 55
+	m.proposerClient.EXPECT().ProduceBlock(
 		gomock.Any(), // ctx
-		gomock.AssignableToTypeOf(&pb.PendingAttestationsRequest{}),
-	).Return(&pb.PendingAttestationsResponse{PendingAttestations: []*pbp2p.Attestation{}}, nil)
-
-	m.proposerClient.EXPECT().ComputeStateRoot(
-		gomock.Any(), // context
-		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
-	).Return(&pb.StateRootResponse{
-		StateRoot: []byte{'F'},
-	}, nil /*err*/)
+		gomock.AssignableToTypeOf(&pb.ProduceBlockRequest{}),
+	).Return(&pbp2p.BeaconBlock{Slot: slot, Body: &pbp2p.BeaconBlockBody{}}, nil /*err*/)
 
+	var broadcastedBlock *pbp2p.BeaconBlock
 	m.proposerClient.EXPECT().ProposeBlock(
 		gomock.Any(), // ctx
 		gomock.AssignableToTypeOf(&pbp2p.BeaconBlock{}),
-	).Return(&pb.ProposeResponse{}, nil /*error*/)
+	).Do(func(_ context.Context, blk *pbp2p.BeaconBlock) {
+		broadcastedBlock = blk
+	}).Return(&pb.ProposeResponse{}, nil /*error*/)
+
+	validator.ProposeBlock(context.Background(), slot, validatorPubKey)
 
-	validator.ProposeBlock(context.Background(), 55)
+	sig, err := bls.SignatureFromBytes(broadcastedBlock.Signature)
+	if err != nil {
+		t.Fatalf("Could not deserialize block signature: %v", err)
+	}
+	proposal, err := hashutil.HashProposal(broadcastedBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	epoch := slot / params.BeaconConfig().SlotsPerEpoch
+	domain := forkutil.DomainVersion(fork, epoch, params.BeaconConfig().DomainProposal)
+	if !sig.Verify(proposal[:], validatorKey.PublicKey, domain) {
+		t.Error("Block signature did not verify against the proposer's public key")
+	}
 }
@@ -2,6 +2,8 @@ package client
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"io/ioutil"
 	"strings"
@@ -12,6 +14,7 @@ import (
 	"github.com/golang/mock/gomock"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/validator/internal"
@@ -32,7 +35,8 @@ func TestWaitForChainStart_SetsChainStartGenesisTime(t *testing.T) {
 	client := internal.NewMockBeaconServiceClient(ctrl)
 
 	v := validator{
-		key:          validatorKey,
+		signer:       NewKeystoreSigner(keyMap),
+		pubkeys:      [][]byte{validatorKey.PublicKey.Marshal()},
 		beaconClient: client,
 	}
 	genesis := uint64(time.Unix(0, 0).Unix())
@@ -65,7 +69,8 @@ func TestWaitForChainStart_ContextCanceled(t *testing.T) {
 	client := internal.NewMockBeaconServiceClient(ctrl)
 
 	v := validator{
-		key:          validatorKey,
+		signer:       NewKeystoreSigner(keyMap),
+		pubkeys:      [][]byte{validatorKey.PublicKey.Marshal()},
 		beaconClient: client,
 	}
 	genesis := uint64(time.Unix(0, 0).Unix())
@@ -96,7 +101,8 @@ func TestWaitForChainStart_StreamSetupFails(t *testing.T) {
 	client := internal.NewMockBeaconServiceClient(ctrl)
 
 	v := validator{
-		key:          validatorKey,
+		signer:       NewKeystoreSigner(keyMap),
+		pubkeys:      [][]byte{validatorKey.PublicKey.Marshal()},
 		beaconClient: client,
 	}
 	clientStream := internal.NewMockBeaconService_WaitForChainStartClient(ctrl)
@@ -117,7 +123,8 @@ func TestWaitForChainStart_ReceiveErrorFromStream(t *testing.T) {
 	client := internal.NewMockBeaconServiceClient(ctrl)
 
 	v := validator{
-		key:          validatorKey,
+		signer:       NewKeystoreSigner(keyMap),
+		pubkeys:      [][]byte{validatorKey.PublicKey.Marshal()},
 		beaconClient: client,
 	}
 	clientStream := internal.NewMockBeaconService_WaitForChainStartClient(ctrl)
@@ -142,14 +149,15 @@ func TestWaitActivation_ContextCanceled(t *testing.T) {
 	client := internal.NewMockValidatorServiceClient(ctrl)
 
 	v := validator{
-		key:             validatorKey,
+		signer:          NewKeystoreSigner(keyMap),
+		pubkeys:         [][]byte{validatorKey.PublicKey.Marshal()},
 		validatorClient: client,
 	}
 	clientStream := internal.NewMockValidatorService_WaitForActivationClient(ctrl)
 	client.EXPECT().WaitForActivation(
 		gomock.Any(),
 		&pb.ValidatorActivationRequest{
-			Pubkey: v.key.PublicKey.Marshal(),
+			Pubkey: validatorKey.PublicKey.Marshal(),
 		},
 	).Return(clientStream, nil)
 	clientStream.EXPECT().Recv().Return(
@@ -175,14 +183,15 @@ func TestWaitActivation_StreamSetupFails(t *testing.T) {
 	client := internal.NewMockValidatorServiceClient(ctrl)
 
 	v := validator{
-		key:             validatorKey,
+		signer:          NewKeystoreSigner(keyMap),
+		pubkeys:         [][]byte{validatorKey.PublicKey.Marshal()},
 		validatorClient: client,
 	}
 	clientStream := internal.NewMockValidatorService_WaitForActivationClient(ctrl)
 	client.EXPECT().WaitForActivation(
 		gomock.Any(),
 		&pb.ValidatorActivationRequest{
-			Pubkey: v.key.PublicKey.Marshal(),
+			Pubkey: validatorKey.PublicKey.Marshal(),
 		},
 	).Return(clientStream, errors.New("failed stream"))
 	err := v.WaitForActivation(context.Background())
@@ -198,14 +207,15 @@ func TestWaitActivation_ReceiveErrorFromStream(t *testing.T) {
 	client := internal.NewMockValidatorServiceClient(ctrl)
 
 	v := validator{
-		key:             validatorKey,
+		signer:          NewKeystoreSigner(keyMap),
+		pubkeys:         [][]byte{validatorKey.PublicKey.Marshal()},
 		validatorClient: client,
 	}
 	clientStream := internal.NewMockValidatorService_WaitForActivationClient(ctrl)
 	client.EXPECT().WaitForActivation(
 		gomock.Any(),
 		&pb.ValidatorActivationRequest{
-			Pubkey: v.key.PublicKey.Marshal(),
+			Pubkey: validatorKey.PublicKey.Marshal(),
 		},
 	).Return(clientStream, nil)
 	clientStream.EXPECT().Recv().Return(
@@ -226,14 +236,15 @@ func TestWaitActivation_LogsActivationEpochOK(t *testing.T) {
 	client := internal.NewMockValidatorServiceClient(ctrl)
 
 	v := validator{
-		key:             validatorKey,
+		signer:          NewKeystoreSigner(keyMap),
+		pubkeys:         [][]byte{validatorKey.PublicKey.Marshal()},
 		validatorClient: client,
 	}
 	clientStream := internal.NewMockValidatorService_WaitForActivationClient(ctrl)
 	client.EXPECT().WaitForActivation(
 		gomock.Any(),
 		&pb.ValidatorActivationRequest{
-			Pubkey: v.key.PublicKey.Marshal(),
+			Pubkey: validatorKey.PublicKey.Marshal(),
 		},
 	).Return(clientStream, nil)
 	clientStream.EXPECT().Recv().Return(
@@ -255,7 +266,8 @@ func TestCanonicalHeadSlot_FailedRPC(t *testing.T) {
 	defer ctrl.Finish()
 	client := internal.NewMockBeaconServiceClient(ctrl)
 	v := validator{
-		key:          validatorKey,
+		signer:       NewKeystoreSigner(keyMap),
+		pubkeys:      [][]byte{validatorKey.PublicKey.Marshal()},
 		beaconClient: client,
 	}
 	client.EXPECT().CanonicalHead(
@@ -272,7 +284,8 @@ func TestCanonicalHeadSlot_OK(t *testing.T) {
 	defer ctrl.Finish()
 	client := internal.NewMockBeaconServiceClient(ctrl)
 	v := validator{
-		key:          validatorKey,
+		signer:       NewKeystoreSigner(keyMap),
+		pubkeys:      [][]byte{validatorKey.PublicKey.Marshal()},
 		beaconClient: client,
 	}
 	client.EXPECT().CanonicalHead(
@@ -297,9 +310,10 @@ func TestUpdateAssignments_DoesNothingWhenNotEpochStartAndAlreadyExistingAssignm
 
 	slot := uint64(1)
 	v := validator{
-		key:             validatorKey,
+		signer:          NewKeystoreSigner(keyMap),
+		pubkeys:         [][]byte{validatorKey.PublicKey.Marshal()},
 		validatorClient: client,
-		assignment: &pb.CommitteeAssignmentResponse{
+		assignments: &pb.CommitteeAssignmentResponse{
 			Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
 				{
 					Committee: []uint64{},
@@ -325,9 +339,10 @@ func TestUpdateAssignments_ReturnsError(t *testing.T) {
 	client := internal.NewMockValidatorServiceClient(ctrl)
 
 	v := validator{
-		key:             validatorKey,
+		signer:          NewKeystoreSigner(keyMap),
+		pubkeys:         [][]byte{validatorKey.PublicKey.Marshal()},
 		validatorClient: client,
-		assignment: &pb.CommitteeAssignmentResponse{
+		assignments: &pb.CommitteeAssignmentResponse{
 			Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
 				{
 					Shard: 1,
@@ -346,7 +361,7 @@ func TestUpdateAssignments_ReturnsError(t *testing.T) {
 	if err := v.UpdateAssignments(context.Background(), params.BeaconConfig().SlotsPerEpoch); err != expected {
 		t.Errorf("Bad error; want=%v got=%v", expected, err)
 	}
-	if v.assignment != nil {
+	if v.assignments != nil {
 		t.Error("Assignments should have been cleared on failure")
 	}
 }
@@ -368,7 +383,8 @@ func TestUpdateAssignments_OK(t *testing.T) {
 		},
 	}
 	v := validator{
-		key:             validatorKey,
+		signer:          NewKeystoreSigner(keyMap),
+		pubkeys:         [][]byte{validatorKey.PublicKey.Marshal()},
 		validatorClient: client,
 	}
 	client.EXPECT().CommitteeAssignment(
@@ -380,13 +396,101 @@ func TestUpdateAssignments_OK(t *testing.T) {
 		t.Fatalf("Could not update assignments: %v", err)
 	}
 
-	if v.assignment.Assignment[0].Slot != params.BeaconConfig().SlotsPerEpoch {
-		t.Errorf("Unexpected validator assignments. want=%v got=%v", params.BeaconConfig().SlotsPerEpoch, v.assignment.Assignment[0].Slot)
+	if v.assignments.Assignment[0].Slot != params.BeaconConfig().SlotsPerEpoch {
+		t.Errorf("Unexpected validator assignments. want=%v got=%v", params.BeaconConfig().SlotsPerEpoch, v.assignments.Assignment[0].Slot)
 	}
-	if v.assignment.Assignment[0].Shard != resp.Assignment[0].Shard {
-		t.Errorf("Unexpected validator assignments. want=%v got=%v", resp.Assignment[0].Shard, v.assignment.Assignment[0].Slot)
+	if v.assignments.Assignment[0].Shard != resp.Assignment[0].Shard {
+		t.Errorf("Unexpected validator assignments. want=%v got=%v", resp.Assignment[0].Shard, v.assignments.Assignment[0].Slot)
 	}
-	if !v.assignment.Assignment[0].IsProposer {
+	if !v.assignments.Assignment[0].IsProposer {
 		t.Errorf("Unexpected validator assignments. want: proposer=true")
 	}
 }
+
+func TestUpdateAssignments_RequestsAllKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := internal.NewMockValidatorServiceClient(ctrl)
+
+	otherKey, err := keystore.NewKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubkeys := [][]byte{validatorKey.PublicKey.Marshal(), otherKey.PublicKey.Marshal()}
+	v := validator{
+		pubkeys:         pubkeys,
+		validatorClient: client,
+	}
+	slot := params.BeaconConfig().SlotsPerEpoch
+	client.EXPECT().CommitteeAssignment(
+		gomock.Any(),
+		&pb.CommitteeAssignmentsRequest{
+			EpochStart: slot,
+			PublicKeys: pubkeys,
+		},
+	).Return(&pb.CommitteeAssignmentResponse{}, nil)
+
+	if err := v.UpdateAssignments(context.Background(), slot); err != nil {
+		t.Fatalf("Could not update assignments: %v", err)
+	}
+}
+
+func TestRolesAt_OK(t *testing.T) {
+	otherKey, err := keystore.NewKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	idleKey, err := keystore.NewKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherPubKey := hex.EncodeToString(otherKey.PublicKey.Marshal())
+	idlePubKey := hex.EncodeToString(idleKey.PublicKey.Marshal())
+	v := validator{
+		pubkeys: [][]byte{
+			validatorKey.PublicKey.Marshal(),
+			otherKey.PublicKey.Marshal(),
+			idleKey.PublicKey.Marshal(),
+		},
+		assignments: &pb.CommitteeAssignmentResponse{
+			Assignment: []*pb.CommitteeAssignmentResponse_CommitteeAssignment{
+				{
+					PublicKey:  validatorKey.PublicKey.Marshal(),
+					Slot:       10,
+					IsProposer: true,
+				},
+				{
+					PublicKey: otherKey.PublicKey.Marshal(),
+					Slot:      10,
+				},
+				{
+					PublicKey: idleKey.PublicKey.Marshal(),
+					Slot:      11,
+				},
+			},
+		},
+	}
+	roles := v.RolesAt(10)
+	if len(roles) != 3 {
+		t.Fatalf("Expected a role for each of the 3 keys, received %d", len(roles))
+	}
+	if roles[validatorPubKey] != pb.ValidatorRole_PROPOSER {
+		t.Errorf("Unexpected role, want=%v got=%v", pb.ValidatorRole_PROPOSER, roles[validatorPubKey])
+	}
+	if roles[otherPubKey] != pb.ValidatorRole_ATTESTER {
+		t.Errorf("Unexpected role, want=%v got=%v", pb.ValidatorRole_ATTESTER, roles[otherPubKey])
+	}
+	if roles[idlePubKey] != pb.ValidatorRole_UNKNOWN {
+		t.Errorf("Unexpected role, want=%v got=%v", pb.ValidatorRole_UNKNOWN, roles[idlePubKey])
+	}
+}
+
+func TestRolesAt_NoAssignments(t *testing.T) {
+	v := validator{
+		pubkeys: [][]byte{validatorKey.PublicKey.Marshal()},
+	}
+	roles := v.RolesAt(10)
+	if roles[validatorPubKey] != pb.ValidatorRole_UNKNOWN {
+		t.Errorf("Unexpected role, want=%v got=%v", pb.ValidatorRole_UNKNOWN, roles[validatorPubKey])
+	}
+}
@@ -0,0 +1,70 @@
+package db
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/boltdb/bolt"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+var (
+	// ErrDoubleVote is returned when a validator key has already signed a
+	// different attestation with the same target epoch.
+	ErrDoubleVote = errors.New("a different attestation was already signed for this target epoch")
+	// ErrSurroundVote is returned when an attestation would surround, or be
+	// surrounded by, an attestation previously signed by the same key.
+	ErrSurroundVote = errors.New("attestation would surround or be surrounded by a previously signed attestation")
+)
+
+// CheckAndSaveAttestation records that the validator identified by pubKey signs
+// the given attestation data. It refuses to do so if the vote would be slashable
+// against the key's signing history, that is a double vote for the same target
+// epoch or a surround vote as defined in the Casper FFG slashing conditions.
+// The source epoch is the data's justified epoch and the target epoch is the
+// epoch of the data's slot. Signing the exact same data again is allowed.
+func (db *ValidatorDB) CheckAndSaveAttestation(pubKey []byte, data *pbp2p.AttestationData) error {
+	signingRoot, err := hashutil.HashProto(data)
+	if err != nil {
+		return fmt.Errorf("could not hash attestation data: %v", err)
+	}
+	sourceEpoch := data.JustifiedEpoch
+	targetEpoch := data.Slot / params.BeaconConfig().SlotsPerEpoch
+
+	return db.update(func(tx *bolt.Tx) error {
+		bucket, err := tx.Bucket(attestationHistoryBucket).CreateBucketIfNotExists(pubKey)
+		if err != nil {
+			return fmt.Errorf("could not create attestation history for key: %v", err)
+		}
+		key := encodeUint64(targetEpoch)
+		if existing := bucket.Get(key); existing != nil {
+			existingSource, existingRoot := decodeAttestationRecord(existing)
+			if existingSource == sourceEpoch && bytes.Equal(existingRoot, signingRoot[:]) {
+				return nil
+			}
+			return ErrDoubleVote
+		}
+		if err := bucket.ForEach(func(k, v []byte) error {
+			prevTarget := decodeUint64(k)
+			prevSource, _ := decodeAttestationRecord(v)
+			if isSurroundVote(sourceEpoch, targetEpoch, prevSource, prevTarget) ||
+				isSurroundVote(prevSource, prevTarget, sourceEpoch, targetEpoch) {
+				return ErrSurroundVote
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		return bucket.Put(key, encodeAttestationRecord(sourceEpoch, signingRoot[:]))
+	})
+}
+
+// isSurroundVote checks if the vote (source1, target1) surrounds the vote
+// (source2, target2), mirroring the check done by the beacon chain when
+// processing attester slashings.
+func isSurroundVote(source1 uint64, target1 uint64, source2 uint64, target2 uint64) bool {
+	return source1 < source2 && target2 < target1
+}
@@ -0,0 +1,78 @@
+package db
+
+import (
+	"testing"
+
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func attestationData(sourceEpoch uint64, targetEpoch uint64, root string) *pbp2p.AttestationData {
+	return &pbp2p.AttestationData{
+		Slot:                  targetEpoch * params.BeaconConfig().SlotsPerEpoch,
+		JustifiedEpoch:        sourceEpoch,
+		BeaconBlockRootHash32: []byte(root),
+	}
+}
+
+func TestCheckAndSaveAttestation_RefusesDoubleVote(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	pubKey := []byte("A")
+
+	if err := db.CheckAndSaveAttestation(pubKey, attestationData(1, 2, "a")); err != nil {
+		t.Fatalf("Could not save first attestation: %v", err)
+	}
+	if err := db.CheckAndSaveAttestation(pubKey, attestationData(1, 2, "a")); err != nil {
+		t.Errorf("Expected re-signing the same attestation to be allowed, received %v", err)
+	}
+	if err := db.CheckAndSaveAttestation(pubKey, attestationData(1, 2, "b")); err != ErrDoubleVote {
+		t.Errorf("Expected %v, received %v", ErrDoubleVote, err)
+	}
+	if err := db.CheckAndSaveAttestation(pubKey, attestationData(0, 2, "a")); err != ErrDoubleVote {
+		t.Errorf("Expected %v, received %v", ErrDoubleVote, err)
+	}
+}
+
+func TestCheckAndSaveAttestation_RefusesSurroundVote(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous [2]uint64
+		next     [2]uint64
+		err      error
+	}{
+		{name: "surrounding", previous: [2]uint64{3, 4}, next: [2]uint64{2, 5}, err: ErrSurroundVote},
+		{name: "surrounded", previous: [2]uint64{2, 5}, next: [2]uint64{3, 4}, err: ErrSurroundVote},
+		{name: "consecutive", previous: [2]uint64{2, 3}, next: [2]uint64{3, 4}, err: nil},
+		{name: "same source", previous: [2]uint64{2, 3}, next: [2]uint64{2, 5}, err: nil},
+		{name: "older target", previous: [2]uint64{4, 5}, next: [2]uint64{1, 2}, err: nil},
+	}
+	for _, tt := range tests {
+		db := setupDB(t)
+		pubKey := []byte("A")
+		if err := db.CheckAndSaveAttestation(pubKey, attestationData(tt.previous[0], tt.previous[1], "a")); err != nil {
+			t.Fatalf("%s: could not save first attestation: %v", tt.name, err)
+		}
+		if err := db.CheckAndSaveAttestation(pubKey, attestationData(tt.next[0], tt.next[1], "b")); err != tt.err {
+			t.Errorf("%s: expected %v, received %v", tt.name, tt.err, err)
+		}
+		teardownDB(t, db)
+	}
+}
+
+func TestCheckAndSaveAttestation_RefusedVoteNotRecorded(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	pubKey := []byte("A")
+
+	if err := db.CheckAndSaveAttestation(pubKey, attestationData(3, 4, "a")); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CheckAndSaveAttestation(pubKey, attestationData(2, 5, "b")); err != ErrSurroundVote {
+		t.Fatalf("Expected %v, received %v", ErrSurroundVote, err)
+	}
+	// The refused attestation must not be used as history for later votes.
+	if err := db.CheckAndSaveAttestation(pubKey, attestationData(4, 5, "c")); err != nil {
+		t.Errorf("Expected attestation to be allowed, received %v", err)
+	}
+}
@@ -0,0 +1,78 @@
+// Package db defines the slashing protection store of the validator client.
+// Every block and attestation signed by a validator key is recorded here so the
+// client can refuse to produce a signature which would get the key slashed.
+package db
+
+import (
+	"errors"
+	"os"
+	"path"
+	"time"
+
+	"github.com/boltdb/bolt"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "validatordb")
+
+// ValidatorDB manages the signing history of the keys held by a validator client.
+type ValidatorDB struct {
+	db           *bolt.DB
+	DatabasePath string
+}
+
+// Close closes the underlying boltdb database.
+func (db *ValidatorDB) Close() error {
+	return db.db.Close()
+}
+
+func (db *ValidatorDB) update(fn func(*bolt.Tx) error) error {
+	return db.db.Update(fn)
+}
+
+func (db *ValidatorDB) view(fn func(*bolt.Tx) error) error {
+	return db.db.View(fn)
+}
+
+func createBuckets(tx *bolt.Tx, buckets ...[]byte) error {
+	for _, bucket := range buckets {
+		if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NewDB initializes a new validator DB at the given directory.
+func NewDB(dirPath string) (*ValidatorDB, error) {
+	if err := os.MkdirAll(dirPath, 0700); err != nil {
+		return nil, err
+	}
+	datafile := path.Join(dirPath, "validator.db")
+	boltDB, err := bolt.Open(datafile, 0600, &bolt.Options{Timeout: 1 * time.Second})
+	if err != nil {
+		if err == bolt.ErrTimeout {
+			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
+		}
+		return nil, err
+	}
+
+	db := &ValidatorDB{db: boltDB, DatabasePath: dirPath}
+
+	if err := db.update(func(tx *bolt.Tx) error {
+		return createBuckets(tx, proposalHistoryBucket, attestationHistoryBucket)
+	}); err != nil {
+		return nil, err
+	}
+
+	return db, err
+}
+
+// ClearDB removes the previously stored directory at the data directory.
+func ClearDB(dirPath string) error {
+	if _, err := os.Stat(dirPath); os.IsNotExist(err) {
+		return nil
+	}
+	return os.RemoveAll(dirPath)
+}
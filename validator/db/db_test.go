@@ -0,0 +1,57 @@
+package db
+
+import (
+	"os"
+	"testing"
+)
+
+// setupDB instantiates and returns a ValidatorDB instance.
+func setupDB(t testing.TB) *ValidatorDB {
+	db, err := SetupDB()
+	if err != nil {
+		t.Fatalf("Failed to instantiate DB: %v", err)
+	}
+	return db
+}
+
+// teardownDB cleans up a test ValidatorDB instance.
+func teardownDB(t testing.TB, db *ValidatorDB) {
+	if err := db.Close(); err != nil {
+		t.Fatalf("Failed to close database: %v", err)
+	}
+	if err := os.RemoveAll(db.DatabasePath); err != nil {
+		t.Fatalf("Failed to remove directory: %v", err)
+	}
+}
+
+func TestClearDB(t *testing.T) {
+	validatorDB := setupDB(t)
+	if err := ClearDB(validatorDB.DatabasePath); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := os.Stat(validatorDB.DatabasePath); !os.IsNotExist(err) {
+		t.Fatalf("db wasnt cleared %v", err)
+	}
+}
+
+func TestNewDB_ReopensHistory(t *testing.T) {
+	validatorDB := setupDB(t)
+	dirPath := validatorDB.DatabasePath
+	pubKey := []byte("A")
+	if err := validatorDB.CheckAndSaveProposal(pubKey, 10, []byte("root1")); err != nil {
+		t.Fatal(err)
+	}
+	if err := validatorDB.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	reopened, err := NewDB(dirPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardownDB(t, reopened)
+	if err := reopened.CheckAndSaveProposal(pubKey, 10, []byte("root2")); err != ErrDoubleProposal {
+		t.Errorf("Expected double proposal to be refused after restart, received %v", err)
+	}
+}
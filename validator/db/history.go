@@ -0,0 +1,155 @@
+package db
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/boltdb/bolt"
+)
+
+// SignedBlock is an exported record of a block signed by a validator key.
+type SignedBlock struct {
+	Slot        uint64 `json:"slot"`
+	SigningRoot string `json:"signing_root"`
+}
+
+// SignedAttestation is an exported record of an attestation signed by a validator key.
+type SignedAttestation struct {
+	SourceEpoch uint64 `json:"source_epoch"`
+	TargetEpoch uint64 `json:"target_epoch"`
+	SigningRoot string `json:"signing_root"`
+}
+
+// History is the complete signing history of a single validator key, used to
+// move slashing protection data along with the key to another validator client.
+type History struct {
+	PublicKey          string              `json:"pubkey"`
+	SignedBlocks       []SignedBlock       `json:"signed_blocks"`
+	SignedAttestations []SignedAttestation `json:"signed_attestations"`
+}
+
+// ExportHistory writes the signing history of every key in the database to w
+// as JSON. Keys and records are sorted so the output is deterministic.
+func (db *ValidatorDB) ExportHistory(w io.Writer) error {
+	histories := make(map[string]*History)
+	historyFor := func(pubKey []byte) *History {
+		id := hex.EncodeToString(pubKey)
+		if _, ok := histories[id]; !ok {
+			histories[id] = &History{
+				PublicKey:          id,
+				SignedBlocks:       []SignedBlock{},
+				SignedAttestations: []SignedAttestation{},
+			}
+		}
+		return histories[id]
+	}
+
+	if err := db.view(func(tx *bolt.Tx) error {
+		proposals := tx.Bucket(proposalHistoryBucket)
+		if err := proposals.ForEach(func(pubKey, _ []byte) error {
+			h := historyFor(pubKey)
+			return proposals.Bucket(pubKey).ForEach(func(k, v []byte) error {
+				h.SignedBlocks = append(h.SignedBlocks, SignedBlock{
+					Slot:        decodeUint64(k),
+					SigningRoot: hex.EncodeToString(v),
+				})
+				return nil
+			})
+		}); err != nil {
+			return err
+		}
+		attestations := tx.Bucket(attestationHistoryBucket)
+		return attestations.ForEach(func(pubKey, _ []byte) error {
+			h := historyFor(pubKey)
+			return attestations.Bucket(pubKey).ForEach(func(k, v []byte) error {
+				source, root := decodeAttestationRecord(v)
+				h.SignedAttestations = append(h.SignedAttestations, SignedAttestation{
+					SourceEpoch: source,
+					TargetEpoch: decodeUint64(k),
+					SigningRoot: hex.EncodeToString(root),
+				})
+				return nil
+			})
+		})
+	}); err != nil {
+		return fmt.Errorf("could not read signing history: %v", err)
+	}
+
+	ids := make([]string, 0, len(histories))
+	for id := range histories {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	exported := make([]*History, 0, len(ids))
+	for _, id := range ids {
+		h := histories[id]
+		sort.Slice(h.SignedBlocks, func(i, j int) bool {
+			return h.SignedBlocks[i].Slot < h.SignedBlocks[j].Slot
+		})
+		sort.Slice(h.SignedAttestations, func(i, j int) bool {
+			return h.SignedAttestations[i].TargetEpoch < h.SignedAttestations[j].TargetEpoch
+		})
+		exported = append(exported, h)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(exported)
+}
+
+// ImportHistory reads signing histories in the format written by ExportHistory
+// from r and merges them into the database. Existing records are never
+// overwritten, so importing can only make the slashing protection stricter.
+func (db *ValidatorDB) ImportHistory(r io.Reader) error {
+	var histories []*History
+	if err := json.NewDecoder(r).Decode(&histories); err != nil {
+		return fmt.Errorf("could not decode signing history: %v", err)
+	}
+
+	return db.update(func(tx *bolt.Tx) error {
+		for _, h := range histories {
+			pubKey, err := hex.DecodeString(h.PublicKey)
+			if err != nil {
+				return fmt.Errorf("could not decode public key %s: %v", h.PublicKey, err)
+			}
+			proposals, err := tx.Bucket(proposalHistoryBucket).CreateBucketIfNotExists(pubKey)
+			if err != nil {
+				return fmt.Errorf("could not create proposal history for key: %v", err)
+			}
+			for _, b := range h.SignedBlocks {
+				root, err := hex.DecodeString(b.SigningRoot)
+				if err != nil {
+					return fmt.Errorf("could not decode block signing root at slot %d: %v", b.Slot, err)
+				}
+				if err := putIfAbsent(proposals, encodeUint64(b.Slot), root); err != nil {
+					return err
+				}
+			}
+			attestations, err := tx.Bucket(attestationHistoryBucket).CreateBucketIfNotExists(pubKey)
+			if err != nil {
+				return fmt.Errorf("could not create attestation history for key: %v", err)
+			}
+			for _, a := range h.SignedAttestations {
+				root, err := hex.DecodeString(a.SigningRoot)
+				if err != nil {
+					return fmt.Errorf("could not decode attestation signing root at epoch %d: %v", a.TargetEpoch, err)
+				}
+				record := encodeAttestationRecord(a.SourceEpoch, root)
+				if err := putIfAbsent(attestations, encodeUint64(a.TargetEpoch), record); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func putIfAbsent(bucket *bolt.Bucket, key []byte, value []byte) error {
+	if bucket.Get(key) != nil {
+		return nil
+	}
+	return bucket.Put(key, value)
+}
@@ -0,0 +1,73 @@
+package db
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestExportImportHistory_RoundTrip(t *testing.T) {
+	source := setupDB(t)
+	defer teardownDB(t, source)
+	pubKey := []byte("A")
+	if err := source.CheckAndSaveProposal(pubKey, 7, []byte("block")); err != nil {
+		t.Fatal(err)
+	}
+	if err := source.CheckAndSaveAttestation(pubKey, attestationData(3, 4, "a")); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := new(bytes.Buffer)
+	if err := source.ExportHistory(buf); err != nil {
+		t.Fatalf("Could not export history: %v", err)
+	}
+
+	target := setupDB(t)
+	defer teardownDB(t, target)
+	if err := target.ImportHistory(bytes.NewReader(buf.Bytes())); err != nil {
+		t.Fatalf("Could not import history: %v", err)
+	}
+	if err := target.CheckAndSaveProposal(pubKey, 7, []byte("other block")); err != ErrDoubleProposal {
+		t.Errorf("Expected %v, received %v", ErrDoubleProposal, err)
+	}
+	if err := target.CheckAndSaveAttestation(pubKey, attestationData(2, 5, "b")); err != ErrSurroundVote {
+		t.Errorf("Expected %v, received %v", ErrSurroundVote, err)
+	}
+
+	reexported := new(bytes.Buffer)
+	if err := target.ExportHistory(reexported); err != nil {
+		t.Fatal(err)
+	}
+	if reexported.String() != buf.String() {
+		t.Errorf("Expected export %s, received %s", buf.String(), reexported.String())
+	}
+}
+
+func TestImportHistory_DoesNotOverwrite(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	if err := db.CheckAndSaveProposal([]byte("A"), 7, []byte("block")); err != nil {
+		t.Fatal(err)
+	}
+
+	history := `[{"pubkey": "41", "signed_blocks": [{"slot": 7, "signing_root": "ffff"}], "signed_attestations": []}]`
+	if err := db.ImportHistory(strings.NewReader(history)); err != nil {
+		t.Fatalf("Could not import history: %v", err)
+	}
+	if err := db.CheckAndSaveProposal([]byte("A"), 7, []byte("block")); err != nil {
+		t.Errorf("Expected existing record to be kept, received %v", err)
+	}
+}
+
+func TestImportHistory_BadInput(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	if err := db.ImportHistory(strings.NewReader("not json")); err == nil {
+		t.Error("Expected error decoding invalid history")
+	}
+	history := `[{"pubkey": "zz", "signed_blocks": [], "signed_attestations": []}]`
+	if err := db.ImportHistory(strings.NewReader(history)); err == nil {
+		t.Error("Expected error decoding invalid public key")
+	}
+}
@@ -0,0 +1,34 @@
+package db
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/boltdb/bolt"
+)
+
+// ErrDoubleProposal is returned when a validator key has already signed a
+// different block at the same slot.
+var ErrDoubleProposal = errors.New("a different block was already signed for this slot")
+
+// CheckAndSaveProposal records that the validator identified by pubKey signs the
+// block with the given signing root at slot. It refuses to do so if a different
+// block was already signed for the same slot, as that would produce a proposer
+// slashing. Signing the exact same block again is allowed.
+func (db *ValidatorDB) CheckAndSaveProposal(pubKey []byte, slot uint64, signingRoot []byte) error {
+	return db.update(func(tx *bolt.Tx) error {
+		bucket, err := tx.Bucket(proposalHistoryBucket).CreateBucketIfNotExists(pubKey)
+		if err != nil {
+			return fmt.Errorf("could not create proposal history for key: %v", err)
+		}
+		key := encodeUint64(slot)
+		if existing := bucket.Get(key); existing != nil {
+			if bytes.Equal(existing, signingRoot) {
+				return nil
+			}
+			return ErrDoubleProposal
+		}
+		return bucket.Put(key, signingRoot)
+	})
+}
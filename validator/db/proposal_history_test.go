@@ -0,0 +1,36 @@
+package db
+
+import (
+	"testing"
+)
+
+func TestCheckAndSaveProposal_RefusesDoubleProposal(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	pubKey := []byte("A")
+
+	if err := db.CheckAndSaveProposal(pubKey, 5, []byte("root1")); err != nil {
+		t.Fatalf("Could not save first proposal: %v", err)
+	}
+	if err := db.CheckAndSaveProposal(pubKey, 5, []byte("root1")); err != nil {
+		t.Errorf("Expected re-signing the same block to be allowed, received %v", err)
+	}
+	if err := db.CheckAndSaveProposal(pubKey, 5, []byte("root2")); err != ErrDoubleProposal {
+		t.Errorf("Expected %v, received %v", ErrDoubleProposal, err)
+	}
+	if err := db.CheckAndSaveProposal(pubKey, 6, []byte("root2")); err != nil {
+		t.Errorf("Expected proposal at a new slot to be allowed, received %v", err)
+	}
+}
+
+func TestCheckAndSaveProposal_KeysAreIndependent(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	if err := db.CheckAndSaveProposal([]byte("A"), 5, []byte("root1")); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CheckAndSaveProposal([]byte("B"), 5, []byte("root2")); err != nil {
+		t.Errorf("Expected proposal by another key to be allowed, received %v", err)
+	}
+}
@@ -0,0 +1,43 @@
+package db
+
+import (
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// The Schema will define how to store and retrieve data from the db.
+// Each history bucket holds one nested bucket per validator public key.
+//
+// Signed blocks are keyed by slot and store the signing root of the block.
+// `proposal-history` -> pubkey -> slot -> signing root
+//
+// Signed attestations are keyed by target epoch and store the source epoch
+// followed by the signing root of the attestation data.
+// `attestation-history` -> pubkey -> target epoch -> source epoch + signing root
+
+// The fields below define the suffix of keys in the db.
+var (
+	proposalHistoryBucket    = []byte("proposal-history-bucket")
+	attestationHistoryBucket = []byte("attestation-history-bucket")
+)
+
+// encodeUint64 encodes a slot or epoch number as little-endian uint64.
+func encodeUint64(number uint64) []byte {
+	return bytesutil.Bytes8(number)
+}
+
+// decodeUint64 returns a slot or epoch number which has been
+// encoded as a little-endian uint64 in the byte array.
+func decodeUint64(bytearray []byte) uint64 {
+	return bytesutil.FromBytes8(bytearray)
+}
+
+// encodeAttestationRecord packs the source epoch and signing root of a signed
+// attestation into a single bucket value.
+func encodeAttestationRecord(sourceEpoch uint64, signingRoot []byte) []byte {
+	return append(encodeUint64(sourceEpoch), signingRoot...)
+}
+
+// decodeAttestationRecord unpacks a value written by encodeAttestationRecord.
+func decodeAttestationRecord(enc []byte) (uint64, []byte) {
+	return decodeUint64(enc[:8]), enc[8:]
+}
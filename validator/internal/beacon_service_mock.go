@@ -139,6 +139,26 @@ func (mr *MockBeaconServiceClientMockRecorder) PendingDeposits(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingDeposits", reflect.TypeOf((*MockBeaconServiceClient)(nil).PendingDeposits), varargs...)
 }
 
+// SyncStatus mocks base method
+func (m *MockBeaconServiceClient) SyncStatus(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.SyncStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SyncStatus", varargs...)
+	ret0, _ := ret[0].(*v10.SyncStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncStatus indicates an expected call of SyncStatus
+func (mr *MockBeaconServiceClientMockRecorder) SyncStatus(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncStatus", reflect.TypeOf((*MockBeaconServiceClient)(nil).SyncStatus), varargs...)
+}
+
 // WaitForChainStart mocks base method
 func (m *MockBeaconServiceClient) WaitForChainStart(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (v10.BeaconService_WaitForChainStartClient, error) {
 	m.ctrl.T.Helper()
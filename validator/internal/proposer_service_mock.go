@@ -77,6 +77,46 @@ func (mr *MockProposerServiceClientMockRecorder) PendingAttestations(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingAttestations", reflect.TypeOf((*MockProposerServiceClient)(nil).PendingAttestations), varargs...)
 }
 
+// PendingBlockOperations mocks base method
+func (m *MockProposerServiceClient) PendingBlockOperations(arg0 context.Context, arg1 *v10.PendingBlockOperationsRequest, arg2 ...grpc.CallOption) (*v10.PendingBlockOperationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PendingBlockOperations", varargs...)
+	ret0, _ := ret[0].(*v10.PendingBlockOperationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PendingBlockOperations indicates an expected call of PendingBlockOperations
+func (mr *MockProposerServiceClientMockRecorder) PendingBlockOperations(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingBlockOperations", reflect.TypeOf((*MockProposerServiceClient)(nil).PendingBlockOperations), varargs...)
+}
+
+// ProduceBlock mocks base method
+func (m *MockProposerServiceClient) ProduceBlock(arg0 context.Context, arg1 *v10.ProduceBlockRequest, arg2 ...grpc.CallOption) (*v1.BeaconBlock, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ProduceBlock", varargs...)
+	ret0, _ := ret[0].(*v1.BeaconBlock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProduceBlock indicates an expected call of ProduceBlock
+func (mr *MockProposerServiceClientMockRecorder) ProduceBlock(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProduceBlock", reflect.TypeOf((*MockProposerServiceClient)(nil).ProduceBlock), varargs...)
+}
+
 // ProposeBlock mocks base method
 func (m *MockProposerServiceClient) ProposeBlock(arg0 context.Context, arg1 *v1.BeaconBlock, arg2 ...grpc.CallOption) (*v10.ProposeResponse, error) {
 	m.ctrl.T.Helper()
@@ -1,10 +1,13 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
+	"path"
 	"runtime"
+	"strings"
 
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
@@ -19,10 +22,13 @@ import (
 )
 
 func startNode(ctx *cli.Context) error {
-	keystoreDirectory := ctx.String(types.KeystorePathFlag.Name)
-	keystorePassword := ctx.String(types.PasswordFlag.Name)
-	if err := accounts.VerifyAccountNotExists(keystoreDirectory, keystorePassword); err == nil {
-		return errors.New("no account found, use `validator accounts create` to generate a new keystore")
+	// Validator keys are held by the remote signer rather than a local keystore when one is used.
+	if ctx.String(types.RemoteSignerFlag.Name) == "" {
+		keystoreDirectory := ctx.String(types.KeystorePathFlag.Name)
+		keystorePassword := ctx.String(types.PasswordFlag.Name)
+		if err := accounts.VerifyAccountNotExists(keystoreDirectory, keystorePassword); err == nil {
+			return errors.New("no account found, use `validator accounts create` to generate a new keystore")
+		}
 	}
 
 	verbosity := ctx.GlobalString(cmd.VerbosityFlag.Name)
@@ -50,6 +56,39 @@ func createValidatorAccount(ctx *cli.Context) error {
 	return nil
 }
 
+func exitValidator(ctx *cli.Context) error {
+	key, err := accounts.ValidatorKey(
+		ctx.String(types.KeystorePathFlag.Name),
+		ctx.String(types.PasswordFlag.Name),
+		ctx.String(types.PublicKeyFlag.Name),
+	)
+	if err != nil {
+		return err
+	}
+	// Only the preferred beacon node is used when several are configured.
+	endpoint := strings.TrimSpace(strings.Split(ctx.String(types.BeaconRPCProviderFlag.Name), ",")[0])
+	if err := accounts.Exit(context.Background(), endpoint, ctx.String(types.CertFlag.Name), key); err != nil {
+		return fmt.Errorf("could not exit validator: %v", err)
+	}
+	return nil
+}
+
+func exportSigningHistory(ctx *cli.Context) error {
+	dbPath := path.Join(ctx.String(cmd.DataDirFlag.Name), node.ValidatorDBName)
+	if err := accounts.ExportSigningHistory(dbPath, ctx.String(types.HistoryFileFlag.Name)); err != nil {
+		return fmt.Errorf("could not export signing history: %v", err)
+	}
+	return nil
+}
+
+func importSigningHistory(ctx *cli.Context) error {
+	dbPath := path.Join(ctx.String(cmd.DataDirFlag.Name), node.ValidatorDBName)
+	if err := accounts.ImportSigningHistory(dbPath, ctx.String(types.HistoryFileFlag.Name)); err != nil {
+		return fmt.Errorf("could not import signing history: %v", err)
+	}
+	return nil
+}
+
 func main() {
 	customFormatter := new(prefixed.TextFormatter)
 	customFormatter.TimestampFormat = "2006-01-02 15:04:05"
@@ -79,6 +118,40 @@ contract in order to activate the validator client`,
 					},
 					Action: createValidatorAccount,
 				},
+				cli.Command{
+					Name: "exit",
+					Description: `signs a voluntary exit for a validator key in the keystore and submits it to the beacon
+node, which broadcasts it to the network. Once included in the beacon chain the validator stops performing
+duties, and the exit cannot be undone`,
+					Flags: []cli.Flag{
+						types.KeystorePathFlag,
+						types.PasswordFlag,
+						types.PublicKeyFlag,
+						types.BeaconRPCProviderFlag,
+						types.CertFlag,
+					},
+					Action: exitValidator,
+				},
+				cli.Command{
+					Name: "export-history",
+					Description: `exports the slashing protection history of every validator key from the data directory
+to a JSON file, so the history can be moved along with the keys to another validator client`,
+					Flags: []cli.Flag{
+						cmd.DataDirFlag,
+						types.HistoryFileFlag,
+					},
+					Action: exportSigningHistory,
+				},
+				cli.Command{
+					Name: "import-history",
+					Description: `imports the slashing protection history of validator keys from a JSON file into the
+data directory, records already present in the data directory are kept`,
+					Flags: []cli.Flag{
+						cmd.DataDirFlag,
+						types.HistoryFileFlag,
+					},
+					Action: importSigningHistory,
+				},
 			},
 		},
 	}
@@ -87,6 +160,9 @@ contract in order to activate the validator client`,
 		types.BeaconRPCProviderFlag,
 		types.KeystorePathFlag,
 		types.PasswordFlag,
+		types.RemoteSignerFlag,
+		types.RemoteSignerCertFlag,
+		types.DoppelgangerEpochsFlag,
 		cmd.VerbosityFlag,
 		cmd.DataDirFlag,
 		cmd.EnableTracingFlag,
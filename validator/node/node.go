@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"path"
+	"strings"
 	"sync"
 	"syscall"
 
@@ -19,6 +21,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/tracing"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/types"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
@@ -26,6 +29,10 @@ import (
 
 var log = logrus.WithField("prefix", "node")
 
+// ValidatorDBName is the directory within the data directory holding the
+// slashing protection database of the validator client.
+const ValidatorDBName = "validatordata"
+
 // ValidatorClient defines an instance of a sharding validator that manages
 // the entire lifecycle of services attached to it participating in
 // Ethereum Serenity.
@@ -34,6 +41,7 @@ type ValidatorClient struct {
 	services *shared.ServiceRegistry // Lifecycle and service store.
 	lock     sync.RWMutex
 	stop     chan struct{} // Channel to wait for termination notifications.
+	db       *db.ValidatorDB
 }
 
 // NewValidatorClient creates a new, Ethereum Serenity validator client.
@@ -61,6 +69,10 @@ func NewValidatorClient(ctx *cli.Context) (*ValidatorClient, error) {
 
 	featureconfig.ConfigureBeaconFeatures(ctx)
 
+	if err := ValidatorClient.startDB(ctx); err != nil {
+		return nil, err
+	}
+
 	if err := ValidatorClient.registerPrometheusService(ctx); err != nil {
 		return nil, err
 	}
@@ -113,10 +125,26 @@ func (s *ValidatorClient) Close() {
 
 	s.services.StopAll()
 	log.Info("Stopping sharding validator")
+	if err := s.db.Close(); err != nil {
+		log.Errorf("Failed to close database: %v", err)
+	}
 
 	close(s.stop)
 }
 
+func (s *ValidatorClient) startDB(ctx *cli.Context) error {
+	baseDir := ctx.GlobalString(cmd.DataDirFlag.Name)
+	dbPath := path.Join(baseDir, ValidatorDBName)
+	db, err := db.NewDB(dbPath)
+	if err != nil {
+		return fmt.Errorf("could not open slashing protection database: %v", err)
+	}
+
+	log.Infof("Checking db at %s", dbPath)
+	s.db = db
+	return nil
+}
+
 func (s *ValidatorClient) registerPrometheusService(ctx *cli.Context) error {
 	service := prometheus.NewPrometheusService(
 		fmt.Sprintf(":%d", ctx.GlobalInt64(cmd.MonitoringPortFlag.Name)),
@@ -127,13 +155,22 @@ func (s *ValidatorClient) registerPrometheusService(ctx *cli.Context) error {
 }
 
 func (s *ValidatorClient) registerClientService(ctx *cli.Context) error {
-	endpoint := ctx.GlobalString(types.BeaconRPCProviderFlag.Name)
+	var endpoints []string
+	for _, endpoint := range strings.Split(ctx.GlobalString(types.BeaconRPCProviderFlag.Name), ",") {
+		if endpoint = strings.TrimSpace(endpoint); endpoint != "" {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
 	keystoreDirectory := ctx.GlobalString(types.KeystorePathFlag.Name)
 	keystorePassword := ctx.String(types.PasswordFlag.Name)
 	v, err := client.NewValidatorService(context.Background(), &client.Config{
-		Endpoint:     endpoint,
-		KeystorePath: keystoreDirectory,
-		Password:     keystorePassword,
+		Endpoints:            endpoints,
+		KeystorePath:         keystoreDirectory,
+		Password:             keystorePassword,
+		RemoteSignerEndpoint: ctx.GlobalString(types.RemoteSignerFlag.Name),
+		RemoteSignerCertFlag: ctx.GlobalString(types.RemoteSignerCertFlag.Name),
+		DoppelgangerEpochs:   ctx.GlobalUint64(types.DoppelgangerEpochsFlag.Name),
+		ValidatorDB:          s.db,
 	})
 	if err != nil {
 		return fmt.Errorf("could not initialize client service: %v", err)
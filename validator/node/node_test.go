@@ -25,8 +25,11 @@ func TestNode_Builds(t *testing.T) {
 	if err := accounts.NewValidatorAccount(dir, "1234"); err != nil {
 		t.Fatalf("Could not create validator account: %v", err)
 	}
-	_, err := NewValidatorClient(context)
+	valClient, err := NewValidatorClient(context)
 	if err != nil {
 		t.Fatalf("Failed to create ValidatorClient: %v", err)
 	}
+	if err := valClient.db.Close(); err != nil {
+		t.Fatalf("Failed to close database: %v", err)
+	}
 }
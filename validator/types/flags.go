@@ -10,10 +10,10 @@ var (
 		Name:  "no-custom-config",
 		Usage: "Run the beacon chain with the real parameters from phase 0.",
 	}
-	// BeaconRPCProviderFlag defines a beacon node RPC endpoint.
+	// BeaconRPCProviderFlag defines one or more beacon node RPC endpoints.
 	BeaconRPCProviderFlag = cli.StringFlag{
 		Name:  "beacon-rpc-provider",
-		Usage: "Beacon node RPC provider endpoint",
+		Usage: "Beacon node RPC provider endpoint. Pass a comma separated list of endpoints, in order of preference, to fail over to the next healthy beacon node",
 		Value: "localhost:4000",
 	}
 	// CertFlag defines a flag for the node's TLS certificate.
@@ -31,4 +31,30 @@ var (
 		Name:  "password",
 		Usage: "string value of the password for your validator private keys",
 	}
+	// HistoryFileFlag defines the location of the file used to import or export a validator's signing history.
+	HistoryFileFlag = cli.StringFlag{
+		Name:  "history-file",
+		Usage: "path to the JSON file holding the slashing protection history of the validator keys",
+	}
+	// PublicKeyFlag defines the public key of the validator key a command applies to.
+	PublicKeyFlag = cli.StringFlag{
+		Name:  "public-key",
+		Usage: "Hex encoded public key of the validator key to use, may be omitted when the keystore holds a single validator key",
+	}
+	// RemoteSignerFlag defines the endpoint of a remote signer holding the validator keys.
+	RemoteSignerFlag = cli.StringFlag{
+		Name:  "remote-signer",
+		Usage: "Remote signer endpoint to sign with instead of the local keystore, the keys never have to be stored on this host",
+	}
+	// RemoteSignerCertFlag defines a flag for the remote signer's TLS certificate.
+	RemoteSignerCertFlag = cli.StringFlag{
+		Name:  "remote-signer-tls-cert",
+		Usage: "Certificate for a secure gRPC connection to the remote signer",
+	}
+	// DoppelgangerEpochsFlag defines how long to watch for another instance of the keys before performing duties.
+	DoppelgangerEpochsFlag = cli.Uint64Flag{
+		Name:  "doppelganger-detection-epochs",
+		Usage: "Number of epochs to watch the network for attestations from the same keys before performing duties, refusing to start if any are found. Set to 0 to disable",
+		Value: 2,
+	}
 )
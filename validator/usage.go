@@ -71,6 +71,9 @@ var appHelpFlagGroups = []flagGroup{
 			types.BeaconRPCProviderFlag,
 			types.KeystorePathFlag,
 			types.PasswordFlag,
+			types.RemoteSignerFlag,
+			types.RemoteSignerCertFlag,
+			types.DoppelgangerEpochsFlag,
 		},
 	},
 	{